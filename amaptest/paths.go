@@ -0,0 +1,154 @@
+package amaptest
+
+import (
+	"embed"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+)
+
+// 假服务器实现的接口路径（与 amap.Endpoints 中的路径一致）
+const (
+	PathGeocode          = "/v3/geocode/geo"
+	PathRegeocode        = "/v3/geocode/regeo"
+	PathWalking          = "/v3/direction/walking"
+	PathDriving          = "/v3/direction/driving"
+	PathBicycling        = "/v3/direction/bicycling"
+	PathTransit          = "/v3/direction/transit/integrated"
+	PathWalkingV2        = "/v3/direction/v2/walking"
+	PathDrivingV2        = "/v3/direction/v2/driving"
+	PathBicyclingV2      = "/v3/direction/v2/bicycling"
+	PathTransitV2        = "/v3/direction/v2/transit/integrated"
+	PathElectricV2       = "/v3/direction/v2/electric"
+	PathDrivingV5        = "/v5/direction/driving"
+	PathWalkingV5        = "/v5/direction/walking"
+	PathBicyclingV5      = "/v5/direction/bicycling"
+	PathElectrobikeV5    = "/v5/direction/electrobike"
+	PathTransitV5        = "/v5/direction/transit/integrated"
+	PathETDDrivingV4     = "/v4/etd/driving"
+	PathDistance         = "/v3/direction/distance"
+	PathDistrict         = "/v3/config/district"
+	PathTrafficIncident  = "/v3/traffic/status"
+	PathTrafficRoad      = "/v3/traffic/status/road"
+	PathTrafficCircle    = "/v3/traffic/status/circle"
+	PathTrafficRectangle = "/v3/traffic/status/rectangle"
+	PathIP               = "/v3/ip"
+	PathIPV5             = "/v5/ip"
+	PathConvert          = "/v3/convert"
+	PathGraspRoad        = "/v3/grasproad"
+	PathPlaceDetail      = "/v3/place/detail"
+	PathPlaceText        = "/v3/place/text"
+	PathPlaceAround      = "/v3/place/around"
+	PathPlacePolygon     = "/v3/place/polygon"
+	PathPlaceAOI         = "/v3/place/aoi"
+	PathPlaceV5Detail    = "/v5/place/detail"
+	PathPlaceV5Text      = "/v5/place/text"
+	PathPlaceV5Around    = "/v5/place/around"
+	PathPlaceV5Polygon   = "/v5/place/polygon"
+	PathPlaceV5AOI       = "/v5/place/aoi"
+	PathInputtips        = "/v3/assistant/inputtips"
+	PathWeather          = "/v3/weather/weatherInfo"
+	PathPosition         = "/v3/position/v1/hardware"
+	PathPositionV5       = "/v5/position/hardware"
+	PathBusLineName      = "/v3/bus/linename"
+	PathBusStationSearch = "/v3/bus/station/search"
+	PathBusLineID        = "/v3/bus/lineid"
+	PathBusLineSearch    = "/v3/bus/line/search"
+)
+
+//go:embed fixtures/*.json
+var fixtureFS embed.FS
+
+// defaultFixtures 接口路径 → 默认响应文件
+var defaultFixtures = map[string]string{
+	PathGeocode:          "geocode.json",
+	PathRegeocode:        "regeocode.json",
+	PathWalking:          "walking.json",
+	PathDriving:          "driving.json",
+	PathBicycling:        "bicycling.json",
+	PathTransit:          "transit.json",
+	PathWalkingV2:        "walking_v2.json",
+	PathDrivingV2:        "driving_v2.json",
+	PathBicyclingV2:      "bicycling_v2.json",
+	PathTransitV2:        "transit_v2.json",
+	PathElectricV2:       "electric_v2.json",
+	PathDrivingV5:        "driving_v5.json",
+	PathWalkingV5:        "walking_v5.json",
+	PathBicyclingV5:      "bicycling_v5.json",
+	PathElectrobikeV5:    "electrobike_v5.json",
+	PathTransitV5:        "transit_v5.json",
+	PathETDDrivingV4:     "etd_driving_v4.json",
+	PathDistance:         "distance.json",
+	PathDistrict:         "district.json",
+	PathTrafficIncident:  "traffic_incident.json",
+	PathTrafficRoad:      "traffic_status.json",
+	PathTrafficCircle:    "traffic_status.json",
+	PathTrafficRectangle: "traffic_status.json",
+	PathIP:               "ip.json",
+	PathIPV5:             "ip_v5.json",
+	PathGraspRoad:        "grasproad.json",
+	PathPlaceDetail:      "place_list.json",
+	PathPlaceText:        "place_list.json",
+	PathPlaceAround:      "place_list.json",
+	PathPlacePolygon:     "place_list.json",
+	PathPlaceAOI:         "place_list.json",
+	PathPlaceV5Detail:    "place_v5_list.json",
+	PathPlaceV5Text:      "place_v5_list.json",
+	PathPlaceV5Around:    "place_v5_list.json",
+	PathPlaceV5Polygon:   "place_v5_list.json",
+	PathPlaceV5AOI:       "place_v5_list.json",
+	PathInputtips:        "inputtips.json",
+	PathWeather:          "weather.json",
+	PathPosition:         "position.json",
+	PathPositionV5:       "position_v5.json",
+	PathBusLineName:      "bus_line_name.json",
+	PathBusStationSearch: "bus_station_search.json",
+	PathBusLineID:        "bus_line_id.json",
+	PathBusLineSearch:    "bus_line_search.json",
+}
+
+// defaultFixtureFuncs 接口路径 → 根据请求参数生成的默认响应
+var defaultFixtureFuncs = map[string]FixtureFunc{
+	PathConvert: convertFixture,
+}
+
+// Paths 返回假服务器实现的全部接口路径（已排序）
+func Paths() []string {
+	paths := make([]string, 0, len(defaultFixtures)+len(defaultFixtureFuncs))
+	for p := range defaultFixtures {
+		paths = append(paths, p)
+	}
+	for p := range defaultFixtureFuncs {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// loadFixture 读取内置的默认响应
+func loadFixture(name string) string {
+	data, err := fixtureFS.ReadFile(path.Join("fixtures", name))
+	if err != nil {
+		panic("amaptest: 缺少内置响应 " + name)
+	}
+	return string(data)
+}
+
+// convertFixture 坐标转换：校验 locations 以 | 分隔（最多 40 对），原样返回以 ; 分隔的坐标
+// 与高德一致，坐标格式错误（如使用 ; 分隔）时返回 20000 INVALID_PARAMS
+func convertFixture(params url.Values) string {
+	pairs := strings.Split(params.Get("locations"), "|")
+	if len(pairs) > 40 {
+		return errorBody(amapErr.InfoCodeInvalidParams)
+	}
+	for _, pair := range pairs {
+		if !amapType.IsLngLat(pair) {
+			return errorBody(amapErr.InfoCodeInvalidParams)
+		}
+	}
+	return `{"status":"1","info":"ok","infocode":"10000","locations":"` + strings.Join(pairs, ";") + `"}`
+}
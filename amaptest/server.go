@@ -0,0 +1,281 @@
+// Package amaptest 提供本地的高德 API 假服务器，用于在无网络、无真实 Key 的环境下测试 amap.Client
+//
+// 假服务器实现了 Client 调用的全部接口（见 Paths），默认返回内置的成功响应；
+// 测试中可以替换响应、注入 infocode 错误和延迟，并按配置的安全密钥校验签名：
+//
+//	srv := amaptest.NewServer(amaptest.WithSecurityKey("secret"))
+//	defer srv.Close()
+//	srv.InjectError(amaptest.PathGeocode, amapErr.InfoCodeCUQPSHasExceededTheLimit, 1)
+//
+//	config := amap.NewConfig("test_key")
+//	config.SecurityKey = "secret"
+//	config.BaseURL = srv.URL
+package amaptest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	amapErr "github.com/enneket/amap/errors"
+	"github.com/enneket/amap/utils"
+)
+
+// FixtureFunc 根据请求参数生成响应 JSON
+type FixtureFunc func(params url.Values) string
+
+// Request 假服务器收到的请求
+type Request struct {
+	Method string
+	Path   string
+	Params url.Values // 查询参数与表单参数（含 key、sig 等）
+}
+
+// Option 假服务器配置项
+type Option func(*Server)
+
+// WithSecurityKey 校验请求签名，签名缺失或错误时返回 10007 INVALID_USER_SIGNATURE
+func WithSecurityKey(securityKey string) Option {
+	return func(s *Server) {
+		s.securityKey = securityKey
+	}
+}
+
+// WithKeys 限定有效的 Key，其余 Key 返回 10001 INVALID_USER_KEY（默认接受任意非空 Key）
+func WithKeys(keys ...string) Option {
+	return func(s *Server) {
+		s.keys = make(map[string]bool, len(keys))
+		for _, key := range keys {
+			s.keys[key] = true
+		}
+	}
+}
+
+// WithDailyQuota 每个 Key 的日配额，用尽后返回 10044 USER_DAILY_QUERY_OVER_LIMIT（<=0 表示不限）
+func WithDailyQuota(quota int) Option {
+	return func(s *Server) {
+		s.dailyQuota = quota
+	}
+}
+
+// Server 有状态的高德 API 假服务器（并发安全）
+type Server struct {
+	*httptest.Server
+
+	securityKey string
+	keys        map[string]bool
+	dailyQuota  int
+
+	mu       sync.Mutex
+	fixtures map[string]FixtureFunc   // 路径 → 自定义响应
+	faults   map[string][]fault       // 路径 → 待注入的错误（"" 作用于所有路径）
+	latency  map[string]time.Duration // 路径 → 响应延迟（"" 作用于所有路径）
+	used     map[string]int           // Key → 已用次数
+	requests []Request
+}
+
+// fault 注入的错误（remaining<=0 表示一直生效）
+type fault struct {
+	code      amapErr.InfoCode
+	remaining int
+}
+
+// NewServer 启动假服务器，使用完毕后需调用 Close
+func NewServer(opts ...Option) *Server {
+	s := &Server{
+		fixtures: map[string]FixtureFunc{},
+		faults:   map[string][]fault{},
+		latency:  map[string]time.Duration{},
+		used:     map[string]int{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// SetFixture 替换指定路径的响应
+func (s *Server) SetFixture(path, body string) {
+	s.SetFixtureFunc(path, func(url.Values) string { return body })
+}
+
+// SetFixtureFunc 使用函数根据请求参数生成指定路径的响应
+func (s *Server) SetFixtureFunc(path string, fn FixtureFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixtures[path] = fn
+}
+
+// InjectError 令接下来 times 次发往 path 的请求返回指定 infocode 错误
+// path 为空表示所有路径，times<=0 表示一直返回直到 Reset
+func (s *Server) InjectError(path string, code amapErr.InfoCode, times int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults[path] = append(s.faults[path], fault{code: code, remaining: times})
+}
+
+// SetLatency 设置指定路径的响应延迟（path 为空表示所有路径）
+func (s *Server) SetLatency(path string, d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.latency[path] = d
+}
+
+// Requests 返回已收到的请求（按到达顺序）
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Count 返回发往指定路径的请求数（path 为空表示全部）
+func (s *Server) Count(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	count := 0
+	for _, req := range s.requests {
+		if path == "" || req.Path == path {
+			count++
+		}
+	}
+	return count
+}
+
+// Reset 清除自定义响应、注入的错误、延迟、配额用量和请求记录
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixtures = map[string]FixtureFunc{}
+	s.faults = map[string][]fault{}
+	s.latency = map[string]time.Duration{}
+	s.used = map[string]int{}
+	s.requests = nil
+}
+
+// serve 处理请求：记录 → 延迟 → 校验 Key/签名/配额 → 注入错误 → 返回响应
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeError(w, amapErr.InfoCodeInvalidParams)
+		return
+	}
+	params := r.Form
+	// 1. 记录请求并读取延迟
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path, Params: params})
+	delay := s.latency[""] + s.latency[r.URL.Path]
+	s.mu.Unlock()
+	if delay > 0 {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+	// 2. 未实现的接口
+	fixture, ok := s.fixture(r.URL.Path)
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		writeError(w, amapErr.InfoCodeUnknownError)
+		return
+	}
+	// 3. 校验 Key、签名和配额
+	if code, ok := s.check(params); !ok {
+		writeError(w, code)
+		return
+	}
+	// 4. 注入的错误
+	if code, ok := s.nextFault(r.URL.Path); ok {
+		writeError(w, code)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	_, _ = w.Write([]byte(fixture(params)))
+}
+
+// fixture 查找路径对应的响应（自定义优先，其次内置）
+func (s *Server) fixture(path string) (FixtureFunc, bool) {
+	s.mu.Lock()
+	fn, ok := s.fixtures[path]
+	s.mu.Unlock()
+	if ok {
+		return fn, true
+	}
+	if fn, ok := defaultFixtureFuncs[path]; ok {
+		return fn, true
+	}
+	name, ok := defaultFixtures[path]
+	if !ok {
+		return nil, false
+	}
+	body := loadFixture(name)
+	return func(url.Values) string { return body }, true
+}
+
+// check 校验 Key、签名和日配额，失败时返回对应的 infocode
+func (s *Server) check(params url.Values) (amapErr.InfoCode, bool) {
+	key := params.Get("key")
+	if key == "" || (s.keys != nil && !s.keys[key]) {
+		return amapErr.InfoCodeInvalidUserKey, false
+	}
+	if s.securityKey != "" {
+		signed := make(map[string]string, len(params))
+		for k := range params {
+			if k != "sig" {
+				signed[k] = params.Get(k)
+			}
+		}
+		if params.Get("sig") != utils.Sign(signed, s.securityKey) {
+			return amapErr.InfoCodeInvalidUserSignature, false
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.dailyQuota > 0 && s.used[key] >= s.dailyQuota {
+		return amapErr.InfoCodeUserDailyQueryOverLimit, false
+	}
+	s.used[key]++
+	return "", true
+}
+
+// nextFault 取出路径上待注入的错误（路径专属的优先于全局的）
+func (s *Server) nextFault(path string) (amapErr.InfoCode, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, p := range []string{path, ""} {
+		queue := s.faults[p]
+		if len(queue) == 0 {
+			continue
+		}
+		f := queue[0]
+		if f.remaining > 0 {
+			f.remaining--
+			if f.remaining == 0 {
+				s.faults[p] = queue[1:]
+			} else {
+				queue[0] = f
+			}
+		}
+		return f.code, true
+	}
+	return "", false
+}
+
+// writeError 写入高德格式的错误响应
+func writeError(w http.ResponseWriter, code amapErr.InfoCode) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	_, _ = w.Write([]byte(errorBody(code)))
+}
+
+// errorBody 生成高德格式的错误响应 JSON
+func errorBody(code amapErr.InfoCode) string {
+	info := string(code)
+	if meta, ok := amapErr.LookupInfoCode(string(code)); ok {
+		info = meta.Name
+	}
+	body, _ := json.Marshal(map[string]string{"status": "0", "info": info, "infocode": string(code)})
+	return string(body)
+}
@@ -0,0 +1,92 @@
+package amaptest
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	amapErr "github.com/enneket/amap/errors"
+)
+
+// get 请求假服务器并返回 infocode 和 HTTP 状态码
+func get(t *testing.T, s *Server, path, query string) (string, int) {
+	t.Helper()
+	resp, err := http.Get(s.URL + path + "?" + query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var body struct {
+		InfoCode string `json:"infocode"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatal(err)
+	}
+	return body.InfoCode, resp.StatusCode
+}
+
+// 测试注入错误的次数和优先级
+func TestInjectError(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	s.InjectError("", amapErr.InfoCodeServerIsBusy, 1)
+	s.InjectError(PathGeocode, amapErr.InfoCodeCUQPSHasExceededTheLimit, 2)
+
+	var codes []string
+	for i := 0; i < 4; i++ {
+		code, _ := get(t, s, PathGeocode, "key=k")
+		codes = append(codes, code)
+	}
+	expected := []string{"10021", "10021", "10016", "10000"}
+	for i := range expected {
+		if codes[i] != expected[i] {
+			t.Fatalf("注入错误顺序错误，期望：%v，实际：%v", expected, codes)
+		}
+	}
+}
+
+// 测试Key校验、日配额和Reset
+func TestKeysAndQuota(t *testing.T) {
+	s := NewServer(WithKeys("a"), WithDailyQuota(1))
+	defer s.Close()
+
+	if code, _ := get(t, s, PathIP, "key=b"); code != "10001" {
+		t.Errorf("无效Key应返回10001，实际：%s", code)
+	}
+	if code, _ := get(t, s, PathIP, "key=a"); code != "10000" {
+		t.Errorf("首次请求应成功，实际：%s", code)
+	}
+	if code, _ := get(t, s, PathIP, "key=a"); code != "10044" {
+		t.Errorf("配额用尽应返回10044，实际：%s", code)
+	}
+	if s.Count(PathIP) != 3 || s.Count("") != 3 {
+		t.Errorf("请求计数错误：%d", s.Count(PathIP))
+	}
+
+	s.Reset()
+	if code, _ := get(t, s, PathIP, "key=a"); code != "10000" || s.Count("") != 1 {
+		t.Errorf("Reset 后配额应恢复，实际：%s", code)
+	}
+}
+
+// 测试未实现的接口返回404
+func TestUnknownPath(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	if code, status := get(t, s, "/v3/unknown", "key=k"); status != http.StatusNotFound || code != "20003" {
+		t.Errorf("未知接口应返回404，实际：%d %s", status, code)
+	}
+}
+
+// 测试坐标转换的默认响应校验 locations 分隔符
+func TestConvertSeparator(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	if code, _ := get(t, s, PathConvert, "key=k&locations=116.48,39.98|116.30,39.95"); code != "10000" {
+		t.Errorf("以 | 分隔应成功，实际：%s", code)
+	}
+	if code, _ := get(t, s, PathConvert, "key=k&locations=116.48,39.98;116.30,39.95"); code != "20000" {
+		t.Errorf("以 ; 分隔应返回20000，实际：%s", code)
+	}
+}
@@ -0,0 +1,357 @@
+package amap
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/enneket/amap/amaptest"
+	busLineID "github.com/enneket/amap/api/bus/line_id"
+	busLineKeyword "github.com/enneket/amap/api/bus/line_keyword"
+	busStationID "github.com/enneket/amap/api/bus/station_id"
+	busStationKeyword "github.com/enneket/amap/api/bus/station_keyword"
+	convert "github.com/enneket/amap/api/convert"
+	bicycling "github.com/enneket/amap/api/direction/v1/bicycling"
+	busV1 "github.com/enneket/amap/api/direction/v1/bus"
+	driving "github.com/enneket/amap/api/direction/v1/driving"
+	walking "github.com/enneket/amap/api/direction/v1/walking"
+	bicyclingV2 "github.com/enneket/amap/api/direction/v2/bicycling"
+	busV2 "github.com/enneket/amap/api/direction/v2/bus"
+	drivingV2 "github.com/enneket/amap/api/direction/v2/driving"
+	electricV2 "github.com/enneket/amap/api/direction/v2/electric"
+	walkingV2 "github.com/enneket/amap/api/direction/v2/walking"
+	bicyclingV5 "github.com/enneket/amap/api/direction/v5/bicycling"
+	drivingV5 "github.com/enneket/amap/api/direction/v5/driving"
+	electrobikeV5 "github.com/enneket/amap/api/direction/v5/electrobike"
+	transitV5 "github.com/enneket/amap/api/direction/v5/transit"
+	walkingV5 "github.com/enneket/amap/api/direction/v5/walking"
+	distance "github.com/enneket/amap/api/distance"
+	district "github.com/enneket/amap/api/district"
+	etdDrivingV4 "github.com/enneket/amap/api/etd/v4/driving"
+	geoCode "github.com/enneket/amap/api/geo_code"
+	grasproad "github.com/enneket/amap/api/grasproad"
+	inputtips "github.com/enneket/amap/api/input_tips"
+	ipV3 "github.com/enneket/amap/api/ip/v3"
+	ipV5 "github.com/enneket/amap/api/ip/v5"
+	placev3aoi "github.com/enneket/amap/api/place/v3/aoi"
+	placev3around "github.com/enneket/amap/api/place/v3/around"
+	placev3id "github.com/enneket/amap/api/place/v3/id"
+	placev3polygon "github.com/enneket/amap/api/place/v3/polygon"
+	placev3text "github.com/enneket/amap/api/place/v3/text"
+	placev5aoi "github.com/enneket/amap/api/place/v5/aoi"
+	placev5around "github.com/enneket/amap/api/place/v5/around"
+	placev5id "github.com/enneket/amap/api/place/v5/id"
+	placev5polygon "github.com/enneket/amap/api/place/v5/polygon"
+	placev5text "github.com/enneket/amap/api/place/v5/text"
+	positionV1 "github.com/enneket/amap/api/position/v1"
+	positionV5 "github.com/enneket/amap/api/position/v5"
+	reGeoCode "github.com/enneket/amap/api/re_geo_code"
+	trafficIncident "github.com/enneket/amap/api/traffic_incident"
+	circle "github.com/enneket/amap/api/traffic_situation/circle"
+	line "github.com/enneket/amap/api/traffic_situation/line"
+	rectangle "github.com/enneket/amap/api/traffic_situation/rectangle"
+	"github.com/enneket/amap/api/weatherinfo"
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeClient 创建连接假服务器的 Client（Key 为 test_key，安全密钥为 test_secret）
+func newFakeClient(t *testing.T, opts ...amaptest.Option) (*Client, *amaptest.Server) {
+	t.Helper()
+	srv := amaptest.NewServer(append([]amaptest.Option{amaptest.WithSecurityKey("test_secret")}, opts...)...)
+	t.Cleanup(srv.Close)
+	config := NewConfig("test_key")
+	config.SecurityKey = "test_secret"
+	config.BaseURL = srv.URL
+	client, err := NewClient(config)
+	require.NoError(t, err)
+	return client, srv
+}
+
+// TestFakeServer_AllEndpoints 测试假服务器覆盖 Client 的全部接口，且内置响应可正确解析
+func TestFakeServer_AllEndpoints(t *testing.T) {
+	// 1. 创建假服务器和Client
+	client, srv := newFakeClient(t)
+	from, to := amapType.LngLat{Lng: 116.351147, Lat: 39.936871}, amapType.LngLat{Lng: 116.410001, Lat: 39.910113}
+
+	// 2. 依次调用全部接口
+	calls := map[string]func() error{
+		amaptest.PathGeocode: func() error {
+			_, err := client.GeoCode(&geoCode.GeocodeRequest{Address: "北京市朝阳区望京SOHO", City: "北京"})
+			return err
+		},
+		amaptest.PathRegeocode: func() error {
+			_, err := client.ReGeocode(&reGeoCode.ReGeocodeRequest{Location: amapType.LngLat{Lng: 116.48649, Lat: 39.99947}})
+			return err
+		},
+		amaptest.PathWalking: func() error {
+			_, err := client.Walking(&walking.WalkingRequest{Origin: from, Destination: to})
+			return err
+		},
+		amaptest.PathDriving: func() error {
+			_, err := client.Driving(&driving.DrivingRequest{Origin: from, Destination: to})
+			return err
+		},
+		amaptest.PathBicycling: func() error {
+			_, err := client.Bicycling(&bicycling.BicyclingRequest{Origin: from, Destination: to})
+			return err
+		},
+		amaptest.PathTransit: func() error {
+			_, err := client.Bus(&busV1.BusRequest{Origin: from, Destination: to, City: "北京"})
+			return err
+		},
+		amaptest.PathWalkingV2: func() error {
+			_, err := client.WalkingV2(&walkingV2.WalkingRequestV2{Origin: from, Destination: to})
+			return err
+		},
+		amaptest.PathDrivingV2: func() error {
+			_, err := client.DrivingV2(&drivingV2.DrivingRequestV2{Origin: from, Destination: to})
+			return err
+		},
+		amaptest.PathBicyclingV2: func() error {
+			_, err := client.BicyclingV2(&bicyclingV2.BicyclingRequestV2{Origin: from, Destination: to})
+			return err
+		},
+		amaptest.PathTransitV2: func() error {
+			_, err := client.BusV2(&busV2.BusRequestV2{Origin: from, Destination: to})
+			return err
+		},
+		amaptest.PathElectricV2: func() error {
+			_, err := client.ElectricV2(&electricV2.ElectricRequestV2{Origin: from, Destination: to})
+			return err
+		},
+		amaptest.PathDrivingV5: func() error {
+			_, err := client.DrivingV5(&drivingV5.DrivingRequestV5{Origin: from, Destination: to})
+			return err
+		},
+		amaptest.PathWalkingV5: func() error {
+			_, err := client.WalkingV5(&walkingV5.WalkingRequestV5{Origin: from, Destination: to})
+			return err
+		},
+		amaptest.PathBicyclingV5: func() error {
+			_, err := client.BicyclingV5(&bicyclingV5.BicyclingRequestV5{Origin: from, Destination: to})
+			return err
+		},
+		amaptest.PathElectrobikeV5: func() error {
+			_, err := client.ElectrobikeV5(&electrobikeV5.ElectrobikeRequestV5{Origin: from, Destination: to})
+			return err
+		},
+		amaptest.PathTransitV5: func() error {
+			_, err := client.TransitV5(&transitV5.TransitRequestV5{Origin: from, Destination: to, City1: "010", City2: "010"})
+			return err
+		},
+		amaptest.PathETDDrivingV4: func() error {
+			_, err := client.ETDDrivingV4(&etdDrivingV4.ETDDrivingRequestV4{Origin: from, Destination: to, DepartureTime: "2025-01-01 08:00"})
+			return err
+		},
+		amaptest.PathDistance: func() error {
+			_, err := client.Distance(&distance.DistanceRequest{Origins: amapType.LngLatList{from}, Destination: to, Type: 1})
+			return err
+		},
+		amaptest.PathDistrict: func() error {
+			_, err := client.District(&district.DistrictRequest{Keywords: "北京市", Subdistrict: "1"})
+			return err
+		},
+		amaptest.PathTrafficIncident: func() error {
+			_, err := client.TrafficIncident(&trafficIncident.TrafficIncidentRequest{Level: "1", Type: "1|3", Rectangle: amapType.Bounds{SouthWest: amapType.LngLat{Lng: 116.3, Lat: 39.9}, NorthEast: amapType.LngLat{Lng: 116.4, Lat: 39.95}}})
+			return err
+		},
+		amaptest.PathTrafficRoad: func() error {
+			_, err := client.LineTrafficStatus(&line.LineTrafficRequest{Path: amapType.LngLatList{{Lng: 116.481028, Lat: 39.989643}, {Lng: 116.489028, Lat: 39.999643}}})
+			return err
+		},
+		amaptest.PathTrafficCircle: func() error {
+			_, err := client.CircleTrafficStatus(&circle.CircleTrafficRequest{Center: amapType.LngLat{Lng: 116.481028, Lat: 39.989643}, Radius: "1000"})
+			return err
+		},
+		amaptest.PathTrafficRectangle: func() error {
+			_, err := client.RectangleTrafficStatus(&rectangle.RectangleTrafficRequest{Rectangle: amapType.Bounds{SouthWest: amapType.LngLat{Lng: 116.481028, Lat: 39.989643}, NorthEast: amapType.LngLat{Lng: 116.489028, Lat: 39.999643}}})
+			return err
+		},
+		amaptest.PathIP: func() error {
+			_, err := client.IPConfig(&ipV3.IPConfigRequest{IP: "114.114.114.114"})
+			return err
+		},
+		amaptest.PathIPV5: func() error {
+			_, err := client.IPV5Config(&ipV5.IPConfigRequest{IP: "114.114.114.114"})
+			return err
+		},
+		amaptest.PathConvert: func() error {
+			_, err := client.Convert(&convert.ConvertRequest{Locations: "116.480656,39.989610", CoordSys: "gps"})
+			return err
+		},
+		amaptest.PathGraspRoad: func() error {
+			_, err := client.GraspRoad(&grasproad.GraspRoadRequest{SID: "test_sid", Points: "116.480656,39.989610,1600000000,30.5;116.481656,39.990610,1600000100,32.0", CoordTypeInput: "gps"})
+			return err
+		},
+		amaptest.PathPlaceDetail: func() error {
+			_, err := client.PlaceV3ID(&placev3id.IDRequest{ID: "B000A83M61"})
+			return err
+		},
+		amaptest.PathPlaceText: func() error {
+			_, err := client.PlaceV3Text(&placev3text.TextSearchRequest{})
+			return err
+		},
+		amaptest.PathPlaceAround: func() error {
+			_, err := client.PlaceV3Around(&placev3around.AroundSearchRequest{Location: amapType.LngLat{Lng: 116.397428, Lat: 39.90923}})
+			return err
+		},
+		amaptest.PathPlacePolygon: func() error {
+			_, err := client.PlaceV3Polygon(&placev3polygon.PolygonSearchRequest{Polygon: amapType.LngLatList{from, to}})
+			return err
+		},
+		amaptest.PathPlaceAOI: func() error {
+			_, err := client.PlaceV3AOI(&placev3aoi.AOISearchRequest{})
+			return err
+		},
+		amaptest.PathPlaceV5Detail: func() error {
+			_, err := client.PlaceV5ID(&placev5id.IDRequest{ID: "B000A83M61"})
+			return err
+		},
+		amaptest.PathPlaceV5Text: func() error {
+			_, err := client.PlaceV5Text(&placev5text.TextSearchRequest{Keyword: "测试"})
+			return err
+		},
+		amaptest.PathPlaceV5Around: func() error {
+			_, err := client.PlaceV5Around(&placev5around.AroundSearchRequest{Location: amapType.LngLat{Lng: 116.397428, Lat: 39.90923}})
+			return err
+		},
+		amaptest.PathPlaceV5Polygon: func() error {
+			_, err := client.PlaceV5Polygon(&placev5polygon.PolygonSearchRequest{Polygon: amapType.LngLatList{{Lng: 116.397428, Lat: 39.90923}, {Lng: 116.407428, Lat: 39.90923}}})
+			return err
+		},
+		amaptest.PathPlaceV5AOI: func() error {
+			_, err := client.PlaceV5AOI(&placev5aoi.AOISearchRequest{ID: "B000A83M61"})
+			return err
+		},
+		amaptest.PathInputtips: func() error {
+			_, err := client.Inputtips(&inputtips.InputtipsRequest{Keywords: "望京SOHO", City: "北京"})
+			return err
+		},
+		amaptest.PathWeather: func() error {
+			_, err := client.Weatherinfo(&weatherinfo.WeatherinfoRequest{City: "北京", Extensions: "all"})
+			return err
+		},
+		amaptest.PathPosition: func() error {
+			_, err := client.HardwarePosition(&positionV1.HardwarePositionRequest{DeviceID: "test_device", GPS: "39.908722,116.397496,0,0,1696900800,5", Output: "JSON"})
+			return err
+		},
+		amaptest.PathPositionV5: func() error {
+			_, err := client.HardwarePositionV5(&positionV5.HardwarePositionRequest{DeviceID: "test_device", GPS: "39.908722,116.397496,0,0,1696900800,3", Output: "JSON"})
+			return err
+		},
+		amaptest.PathBusLineName: func() error {
+			_, err := client.BusStationID(&busStationID.StationIDRequest{ID: "123456", City: "北京"})
+			return err
+		},
+		amaptest.PathBusStationSearch: func() error {
+			_, err := client.BusStationKeyword(&busStationKeyword.StationKeywordRequest{Keywords: "望京", City: "北京"})
+			return err
+		},
+		amaptest.PathBusLineID: func() error {
+			_, err := client.BusLineID(&busLineID.LineIDRequest{ID: "789012", City: "北京"})
+			return err
+		},
+		amaptest.PathBusLineSearch: func() error {
+			_, err := client.BusLineKeyword(&busLineKeyword.LineKeywordRequest{Keywords: "1号线", City: "北京"})
+			return err
+		},
+	}
+
+	// 3. 验证结果：每个接口都能调用成功，且请求到达对应路径
+	assert.Len(t, calls, len(amaptest.Paths()))
+	for _, path := range amaptest.Paths() {
+		call, ok := calls[path]
+		if !assert.True(t, ok, "缺少接口调用：%s", path) {
+			continue
+		}
+		assert.NoError(t, call(), path)
+		assert.Equal(t, 1, srv.Count(path), path)
+	}
+}
+
+// TestFakeServer_InjectError 测试注入infocode错误，配合重试策略恢复
+func TestFakeServer_InjectError(t *testing.T) {
+	// 1. 创建假服务器，注入一次QPS超限
+	srv := amaptest.NewServer()
+	defer srv.Close()
+	srv.InjectError(amaptest.PathGeocode, amapErr.InfoCodeCUQPSHasExceededTheLimit, 1)
+
+	// 2. 创建带重试的Client
+	config := NewConfig("test_key")
+	config.BaseURL = srv.URL
+	config.Retry = &RetryPolicy{MaxAttempts: 2, BaseDelay: time.Millisecond, RetryableInfoCodes: amapErr.RetryableInfoCodes()}
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	// 3. 执行请求：第一次失败，重试成功
+	resp, err := client.GeoCode(&geoCode.GeocodeRequest{Address: "北京"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.Geocodes)
+	assert.Equal(t, 2, srv.Count(amaptest.PathGeocode))
+
+	// 4. 持续注入Key无效，不重试
+	srv.InjectError("", amapErr.InfoCodeInvalidUserKey, 0)
+	_, err = client.GeoCode(&geoCode.GeocodeRequest{Address: "北京"})
+	assert.True(t, amapErr.IsInvalidKey(err))
+}
+
+// TestFakeServer_Signature 测试假服务器校验签名
+func TestFakeServer_Signature(t *testing.T) {
+	srv := amaptest.NewServer(amaptest.WithSecurityKey("test_secret"))
+	defer srv.Close()
+
+	config := NewConfig("test_key")
+	config.SecurityKey = "wrong_secret"
+	config.BaseURL = srv.URL
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	_, err = client.GeoCode(&geoCode.GeocodeRequest{Address: "北京"})
+	assert.True(t, amapErr.IsAuthError(err))
+	assert.Equal(t, "test_key", srv.Requests()[0].Params.Get("key"))
+}
+
+// TestFakeServer_Latency 测试注入延迟触发ctx超时
+func TestFakeServer_Latency(t *testing.T) {
+	client, srv := newFakeClient(t)
+	srv.SetLatency(amaptest.PathGeocode, time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := client.GeoCodeCtx(ctx, &geoCode.GeocodeRequest{Address: "北京"})
+	assert.IsType(t, &amapErr.CanceledError{}, err)
+}
+
+// TestFakeServer_FixtureFunc 测试按请求参数生成响应
+func TestFakeServer_FixtureFunc(t *testing.T) {
+	client, srv := newFakeClient(t)
+	srv.SetFixtureFunc(amaptest.PathGeocode, func(params url.Values) string {
+		return `{"status":"1","info":"OK","infocode":"10000","count":"1","geocodes":[{"formatted_address":"` + params.Get("address") + `"}]}`
+	})
+
+	resp, err := client.GeoCode(&geoCode.GeocodeRequest{Address: "上海市"})
+	require.NoError(t, err)
+	assert.EqualValues(t, "上海市", resp.Geocodes[0].FormattedAddress)
+	assert.Equal(t, http.MethodGet, srv.Requests()[0].Method)
+}
+
+// TestFakeServer_ConvertSeparator 测试坐标转换的 locations 以 | 分隔，以 ; 分隔时报参数错误
+func TestFakeServer_ConvertSeparator(t *testing.T) {
+	client, _ := newFakeClient(t)
+
+	// 1. 以 | 分隔，响应以 ; 分隔
+	resp, err := client.Convert(&convert.ConvertRequest{Locations: "116.480656,39.989610|116.30815,39.95965", CoordSys: "gps"})
+	require.NoError(t, err)
+	assert.EqualValues(t, "116.480656,39.989610;116.30815,39.95965", resp.Locations)
+
+	// 2. 以 ; 分隔返回参数错误
+	_, err = client.Convert(&convert.ConvertRequest{Locations: "116.480656,39.989610;116.30815,39.95965", CoordSys: "gps"})
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "20000", apiErr.Code)
+}
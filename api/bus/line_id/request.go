@@ -1,5 +1,9 @@
 package line_id
 
+import (
+	amapErr "github.com/enneket/amap/errors"
+)
+
 // LineIDRequest 公交路线ID查询请求参数
 type LineIDRequest struct {
 	ID   string `json:"id"`   // 公交线路ID（必填）
@@ -13,3 +17,19 @@ func (req *LineIDRequest) ToParams() map[string]string {
 	params["city"] = req.City
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 bus.lineid）
+func (req *LineIDRequest) Endpoint() string {
+	return "bus.lineid"
+}
+
+// Validate 校验必填参数
+func (req *LineIDRequest) Validate() error {
+	if req.ID == "" {
+		return amapErr.NewInvalidConfigError("公交路线ID查询：id参数不能为空")
+	}
+	if req.City == "" {
+		return amapErr.NewInvalidConfigError("公交路线ID查询：city参数不能为空")
+	}
+	return nil
+}
@@ -1,23 +1,38 @@
 package line_id
 
+import (
+	"github.com/enneket/amap/geo"
+	amapType "github.com/enneket/amap/types"
+)
+
 // StationInfo 站点信息
 type StationInfo struct {
-	ID       string `json:"id"`       // 站点ID
-	Name     string `json:"name"`     // 站点名称
-	Location string `json:"location"` // 站点坐标
+	ID       amapType.FlexString `json:"id"`       // 站点ID
+	Name     amapType.FlexString `json:"name"`     // 站点名称
+	Location amapType.FlexString `json:"location"` // 站点坐标
 }
 
 // LineIDResponse 公交路线ID查询响应结果
 type LineIDResponse struct {
-	Status    string        `json:"status"`    // 返回结果状态值，0表示失败，1表示成功
-	Info      string        `json:"info"`      // 返回状态说明
-	InfoCode  string        `json:"infocode"`  // 返回状态码
-	LineID    string        `json:"lineid"`    // 线路ID
-	Name      string        `json:"name"`      // 线路名称
-	Type      string        `json:"type"`      // 线路类型
-	FirstTime string        `json:"start_time"` // 首班车时间
-	LastTime  string        `json:"end_time"` // 末班车时间
-	Distance  string        `json:"distance"`  // 线路距离（米）
-	Polyline  string        `json:"polyline"`  // 线路坐标集合
+	Status    amapType.FlexString        `json:"status"`    // 返回结果状态值，0表示失败，1表示成功
+	Info      amapType.FlexString        `json:"info"`      // 返回状态说明
+	InfoCode  amapType.FlexString        `json:"infocode"`  // 返回状态码
+	LineID    amapType.FlexString        `json:"lineid"`    // 线路ID
+	Name      amapType.FlexString        `json:"name"`      // 线路名称
+	Type      amapType.FlexString        `json:"type"`      // 线路类型
+	FirstTime amapType.FlexString        `json:"start_time"` // 首班车时间
+	LastTime  amapType.FlexString        `json:"end_time"` // 末班车时间
+	Distance  amapType.FlexString        `json:"distance"`  // 线路距离（米）
+	Polyline  amapType.FlexString        `json:"polyline"`  // 线路坐标集合
 	Stations  []StationInfo `json:"stations"`  // 站点列表
 }
+
+// LngLat 解析站点坐标（未返回坐标或格式错误时返回错误）
+func (item *StationInfo) LngLat() (amapType.LngLat, error) {
+	return amapType.ParseLngLat(item.Location.String())
+}
+
+// Points 解析线路坐标
+func (resp *LineIDResponse) Points() (amapType.LngLatList, error) {
+	return geo.DecodePolyline(resp.Polyline.String())
+}
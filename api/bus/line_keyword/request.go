@@ -1,5 +1,9 @@
 package line_keyword
 
+import (
+	amapErr "github.com/enneket/amap/errors"
+)
+
 // LineKeywordRequest 公交路线关键字查询请求参数
 type LineKeywordRequest struct {
 	Keywords string `json:"keywords"` // 公交线路名称关键字（必填）
@@ -17,3 +21,19 @@ func (req *LineKeywordRequest) ToParams() map[string]string {
 	params["page"] = req.Page
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 bus.line_search）
+func (req *LineKeywordRequest) Endpoint() string {
+	return "bus.line_search"
+}
+
+// Validate 校验必填参数
+func (req *LineKeywordRequest) Validate() error {
+	if req.Keywords == "" {
+		return amapErr.NewInvalidConfigError("公交路线关键字查询：keywords参数不能为空")
+	}
+	if req.City == "" {
+		return amapErr.NewInvalidConfigError("公交路线关键字查询：city参数不能为空")
+	}
+	return nil
+}
@@ -1,5 +1,9 @@
 package station_id
 
+import (
+	amapErr "github.com/enneket/amap/errors"
+)
+
 // StationIDRequest 公交站ID查询请求参数
 type StationIDRequest struct {
 	ID   string `json:"id"`   // 公交站点ID（必填）
@@ -13,3 +17,19 @@ func (req *StationIDRequest) ToParams() map[string]string {
 	params["city"] = req.City
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 bus.linename）
+func (req *StationIDRequest) Endpoint() string {
+	return "bus.linename"
+}
+
+// Validate 校验必填参数
+func (req *StationIDRequest) Validate() error {
+	if req.ID == "" {
+		return amapErr.NewInvalidConfigError("公交站ID查询：id参数不能为空")
+	}
+	if req.City == "" {
+		return amapErr.NewInvalidConfigError("公交站ID查询：city参数不能为空")
+	}
+	return nil
+}
@@ -1,29 +1,43 @@
 package station_id
 
+import (
+	amapType "github.com/enneket/amap/types"
+)
+
 // StationLine 公交线路信息
 type StationLine struct {
-	LineID    string        `json:"lineid"` // 线路ID
-	LineName  string        `json:"name"`   // 线路名称
-	FirstTime string        `json:"start_time"` // 首班车时间
-	LastTime  string        `json:"end_time"` // 末班车时间
-	Distance  string        `json:"distance"` // 线路距离（米）
+	LineID    amapType.FlexString        `json:"lineid"` // 线路ID
+	LineName  amapType.FlexString        `json:"name"`   // 线路名称
+	FirstTime amapType.FlexString        `json:"start_time"` // 首班车时间
+	LastTime  amapType.FlexString        `json:"end_time"` // 末班车时间
+	Distance  amapType.FlexString        `json:"distance"` // 线路距离（米）
 	Stations  []StationInfo `json:"stations"` // 站点列表
 }
 
 // StationInfo 站点信息
 type StationInfo struct {
-	ID       string `json:"id"`       // 站点ID
-	Name     string `json:"name"`     // 站点名称
-	Location string `json:"location"` // 站点坐标
+	ID       amapType.FlexString `json:"id"`       // 站点ID
+	Name     amapType.FlexString `json:"name"`     // 站点名称
+	Location amapType.FlexString `json:"location"` // 站点坐标
 }
 
 // StationIDResponse 公交站ID查询响应结果
 type StationIDResponse struct {
-	Status    string        `json:"status"`    // 返回结果状态值，0表示失败，1表示成功
-	Info      string        `json:"info"`      // 返回状态说明
-	InfoCode  string        `json:"infocode"`  // 返回状态码
-	StationID string        `json:"stationid"` // 公交站点ID
-	Name      string        `json:"name"`      // 公交站点名称
-	Location  string        `json:"location"`  // 公交站点坐标
+	Status    amapType.FlexString        `json:"status"`    // 返回结果状态值，0表示失败，1表示成功
+	Info      amapType.FlexString        `json:"info"`      // 返回状态说明
+	InfoCode  amapType.FlexString        `json:"infocode"`  // 返回状态码
+	StationID amapType.FlexString        `json:"stationid"` // 公交站点ID
+	Name      amapType.FlexString        `json:"name"`      // 公交站点名称
+	Location  amapType.FlexString        `json:"location"`  // 公交站点坐标
 	Lines     []StationLine `json:"lines"`     // 经过该站点的公交线路列表
 }
+
+// LngLat 解析站点坐标（未返回坐标或格式错误时返回错误）
+func (item *StationInfo) LngLat() (amapType.LngLat, error) {
+	return amapType.ParseLngLat(item.Location.String())
+}
+
+// LngLat 解析公交站点坐标（未返回坐标或格式错误时返回错误）
+func (resp *StationIDResponse) LngLat() (amapType.LngLat, error) {
+	return amapType.ParseLngLat(resp.Location.String())
+}
@@ -1,5 +1,9 @@
 package station_keyword
 
+import (
+	amapErr "github.com/enneket/amap/errors"
+)
+
 // StationKeywordRequest 公交站关键字查询请求参数
 type StationKeywordRequest struct {
 	Keywords string `json:"keywords"` // 公交站点名称关键字（必填）
@@ -17,3 +21,19 @@ func (req *StationKeywordRequest) ToParams() map[string]string {
 	params["page"] = req.Page
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 bus.station_search）
+func (req *StationKeywordRequest) Endpoint() string {
+	return "bus.station_search"
+}
+
+// Validate 校验必填参数
+func (req *StationKeywordRequest) Validate() error {
+	if req.Keywords == "" {
+		return amapErr.NewInvalidConfigError("公交站关键字查询：keywords参数不能为空")
+	}
+	if req.City == "" {
+		return amapErr.NewInvalidConfigError("公交站关键字查询：city参数不能为空")
+	}
+	return nil
+}
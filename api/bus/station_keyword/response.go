@@ -1,27 +1,36 @@
 package station_keyword
 
+import (
+	amapType "github.com/enneket/amap/types"
+)
+
 // Suggestion 搜索建议
 type Suggestion struct {
-	Keywords []string `json:"keywords"` // 关键词建议
-	Cities   []string `json:"cities"`   // 城市建议
+	Keywords amapType.FlexStringList `json:"keywords"` // 关键词建议
+	Cities   amapType.FlexStringList `json:"cities"`   // 城市建议
 }
 
 // StationDetail 公交站点详细信息
 type StationDetail struct {
-	ID       string `json:"id"`       // 站点ID
-	Name     string `json:"name"`     // 站点名称
-	Location string `json:"location"` // 站点坐标
-	CityID   string `json:"cityid"`   // 城市ID
-	CityName string `json:"cityname"` // 城市名称
-	Address  string `json:"address"`  // 站点地址
+	ID       amapType.FlexString `json:"id"`       // 站点ID
+	Name     amapType.FlexString `json:"name"`     // 站点名称
+	Location amapType.FlexString `json:"location"` // 站点坐标
+	CityID   amapType.FlexString `json:"cityid"`   // 城市ID
+	CityName amapType.FlexString `json:"cityname"` // 城市名称
+	Address  amapType.FlexString `json:"address"`  // 站点地址
 }
 
 // StationKeywordResponse 公交站关键字查询响应结果
 type StationKeywordResponse struct {
-	Status     string          `json:"status"`     // 返回结果状态值，0表示失败，1表示成功
-	Info       string          `json:"info"`       // 返回状态说明
-	InfoCode   string          `json:"infocode"`   // 返回状态码
-	Count      string          `json:"count"`      // 匹配的公交站点总数
-	Suggestion Suggestion      `json:"suggestion"` // 搜索建议
-	Stations   []StationDetail `json:"stations"`   // 公交站点列表
+	Status     amapType.FlexString `json:"status"`     // 返回结果状态值，0表示失败，1表示成功
+	Info       amapType.FlexString `json:"info"`       // 返回状态说明
+	InfoCode   amapType.FlexString `json:"infocode"`   // 返回状态码
+	Count      amapType.FlexString `json:"count"`      // 匹配的公交站点总数
+	Suggestion Suggestion          `json:"suggestion"` // 搜索建议
+	Stations   []StationDetail     `json:"stations"`   // 公交站点列表
+}
+
+// LngLat 解析站点坐标（未返回坐标或格式错误时返回错误）
+func (item *StationDetail) LngLat() (amapType.LngLat, error) {
+	return amapType.ParseLngLat(item.Location.String())
 }
@@ -1,12 +1,37 @@
 package convert
 
+import (
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+)
+
+// MaxLocations 单次请求最多转换的坐标数
+const MaxLocations = 40
+
+// 原坐标系（coordsys 参数可选值）
+const (
+	CoordSysGPS      = "gps"      // GPS 坐标（WGS84）
+	CoordSysMapbar   = "mapbar"   // 图吧坐标
+	CoordSysBaidu    = "baidu"    // 百度坐标（BD09）
+	CoordSysAutonavi = "autonavi" // 高德坐标（不转换）
+)
+
+// Fallback 批量转换的本地计算策略（仅 gps、baidu、autonavi 可在本地计算，mapbar 只能调用接口）
+type Fallback int
+
+const (
+	FallbackNone    Fallback = iota // 只调用接口（默认）
+	FallbackOnError                 // 接口因网络、配额或服务端问题不可用时改为本地计算
+	FallbackLocal                   // 直接本地计算，不调用接口
+)
+
 // ConvertRequest 坐标转换请求参数
 // 文档：https://lbs.amap.com/api/webservice/guide/api/convert
 // 支持将其他坐标系的坐标转换为高德坐标系（GCJ02）
 // 支持批量转换，一次最多转换40对坐标
 
 type ConvertRequest struct {
-	Locations string `json:"locations"`  // 待转换的坐标列表（必填，格式："经度,纬度;经度,纬度"，最多40对）
+	Locations string `json:"locations"`  // 待转换的坐标列表（必填，格式："经度,纬度|经度,纬度"，最多40对；响应中以 ; 分隔）
 	CoordSys  string `json:"coordsys"`   // 原坐标系（必填，可选值：gps, mapbar, baidu, autonavi）
 	Output    string `json:"output,omitempty"`  // 输出格式（可选，默认JSON）
 	Callback  string `json:"callback,omitempty"` // 回调函数（可选，用于JSONP跨域）
@@ -25,3 +50,26 @@ func (req *ConvertRequest) ToParams() map[string]string {
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 convert）
+func (req *ConvertRequest) Endpoint() string {
+	return "convert"
+}
+
+// Validate 校验必填参数
+func (req *ConvertRequest) Validate() error {
+	if req.Locations == "" {
+		return amapErr.NewInvalidConfigError("坐标转换：locations参数不能为空")
+	}
+	if req.CoordSys == "" {
+		return amapErr.NewInvalidConfigError("坐标转换：coordsys参数不能为空")
+	}
+	return nil
+}
+
+// BatchConvertRequest 批量坐标转换请求（数量不限，按每批 40 个坐标分块请求）
+type BatchConvertRequest struct {
+	Locations []amapType.LngLat // 待转换的坐标（必填）
+	CoordSys  string            // 原坐标系（必填，可选值：gps, mapbar, baidu, autonavi）
+	Fallback  Fallback          // 本地计算策略（可选，默认只调用接口）
+}
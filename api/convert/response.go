@@ -14,5 +14,26 @@ import (
 
 type ConvertResponse struct {
 	amapType.BaseResponse // 继承基础响应（Status/Info/InfoCode）
-	Locations string      `json:"locations"` // 转换后的坐标列表，格式："经度,纬度;经度,纬度"
+	Locations amapType.FlexString      `json:"locations"` // 转换后的坐标列表，格式："经度,纬度;经度,纬度"
+}
+
+// LngLats 解析转换后的坐标列表
+func (resp *ConvertResponse) LngLats() (amapType.LngLatList, error) {
+	return amapType.ParseLngLatList(resp.Locations.String(), ";")
+}
+
+// Source 批量转换结果的来源
+type Source string
+
+const (
+	SourceAPI   Source = "api"   // 高德坐标转换接口
+	SourceLocal Source = "local" // 本地算法（utils 包）
+)
+
+// BatchResult 批量坐标转换单个坐标的结果
+type BatchResult struct {
+	Input    amapType.LngLat // 输入坐标
+	Location amapType.LngLat // 转换后的高德坐标（失败时为零值）
+	Source   Source          // 结果来源（失败时为空）
+	Err      error           // 所在批次请求失败时的错误
 }
@@ -0,0 +1,26 @@
+package direction
+
+import "strings"
+
+// ShowField 路径规划2.0（v5）可选返回的扩展信息（show_fields 参数的取值）
+type ShowField string
+
+const (
+	ShowFieldCost     ShowField = "cost"     // 耗时、过路费、红绿灯等费用信息
+	ShowFieldTmcs     ShowField = "tmcs"     // 路况信息（仅驾车）
+	ShowFieldNavi     ShowField = "navi"     // 导航动作
+	ShowFieldCities   ShowField = "cities"   // 途经城市（仅驾车）
+	ShowFieldPolyline ShowField = "polyline" // 路线坐标
+)
+
+// ShowFields show_fields 参数（未设置时高德只返回距离等基础信息）
+type ShowFields []ShowField
+
+// String 格式化为 show_fields 参数（以 "," 分隔）
+func (f ShowFields) String() string {
+	parts := make([]string, len(f))
+	for i, field := range f {
+		parts[i] = string(field)
+	}
+	return strings.Join(parts, ",")
+}
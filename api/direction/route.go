@@ -0,0 +1,125 @@
+// Package direction 定义各版本路径规划（驾车、步行、骑行、电动车、公交）共用的路线接口
+// 各接口的路线方案实现 Plan，响应实现 Route，便于同一套代码比较、排序不同出行方式的结果
+package direction
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+)
+
+// Plan 单个路线方案（可类型断言为各接口的方案类型，如 *driving.PathV5）
+type Plan interface {
+	Metrics() Metrics // 距离、耗时、费用等数值字段
+}
+
+// Route 路径规划响应
+type Route interface {
+	Plans() []Plan // 全部路线方案（按高德返回的顺序）
+}
+
+// Metrics 路线、方案或路段的数值字段（高德返回的原始字符串，接口未返回的字段为空）；
+// 各响应类型的 Metrics 方法负责字段映射，访问方法解析并检查格式：字段为空时返回 0，格式错误时返回 ParseError
+type Metrics struct {
+	Distance        amapType.FlexString // 距离（米）
+	Duration        amapType.FlexString // 耗时（秒）
+	Tolls           amapType.FlexString // 过路费（元，驾车）
+	TollDistance    amapType.FlexString // 收费路段距离（米，驾车）
+	TrafficLights   amapType.FlexString // 红绿灯个数（驾车）
+	Fare            amapType.FlexString // 票价（元，公交）
+	WalkingDistance amapType.FlexString // 步行距离（米，公交）
+	TaxiCost        amapType.FlexString // 预计打车费用（元）
+}
+
+// DistanceMeters 距离（米）
+func (m Metrics) DistanceMeters() (float64, error) {
+	return ParseMeters(m.Distance)
+}
+
+// TravelTime 预计耗时
+func (m Metrics) TravelTime() (time.Duration, error) {
+	return ParseSeconds(m.Duration)
+}
+
+// TollsFen 过路费（分）
+func (m Metrics) TollsFen() (int64, error) {
+	return ParseFen(m.Tolls)
+}
+
+// TollDistanceMeters 收费路段距离（米）
+func (m Metrics) TollDistanceMeters() (float64, error) {
+	return ParseMeters(m.TollDistance)
+}
+
+// TrafficLightCount 红绿灯个数
+func (m Metrics) TrafficLightCount() (int, error) {
+	return ParseCount(m.TrafficLights)
+}
+
+// FareFen 票价（分）
+func (m Metrics) FareFen() (int64, error) {
+	return ParseFen(m.Fare)
+}
+
+// WalkingDistanceMeters 步行距离（米）
+func (m Metrics) WalkingDistanceMeters() (float64, error) {
+	return ParseMeters(m.WalkingDistance)
+}
+
+// TaxiCostFen 预计打车费用（分）
+func (m Metrics) TaxiCostFen() (int64, error) {
+	return ParseFen(m.TaxiCost)
+}
+
+// CostFen 方案费用（分）：驾车为过路费，公交为票价，步行、骑行等为 0
+func (m Metrics) CostFen() (int64, error) {
+	if m.Tolls != "" {
+		return m.TollsFen()
+	}
+	return m.FareFen()
+}
+
+// 以下 Parse 系列函数解析高德的数值字段，字段为空时返回 0，格式错误时返回 ParseError
+
+// ParseMeters 解析距离字段（米）
+func ParseMeters(s amapType.FlexString) (float64, error) {
+	return s.Float64()
+}
+
+// ParseSeconds 解析时长字段（高德单位为秒）
+func ParseSeconds(s amapType.FlexString) (time.Duration, error) {
+	v, err := s.Float64()
+	return time.Duration(v * float64(time.Second)), err
+}
+
+// ParseFen 解析费用字段：高德以元为单位返回（如 "15.5"），按十进制换算为整数分，分以下四舍五入，避免浮点误差
+func ParseFen(s amapType.FlexString) (int64, error) {
+	text := strings.TrimSpace(s.String())
+	if text == "" {
+		return 0, nil
+	}
+	yuan, fraction, _ := strings.Cut(strings.TrimPrefix(text, "-"), ".")
+	fraction += "000"
+	if yuan == "" || strings.Trim(yuan, "0123456789") != "" || strings.Trim(fraction, "0123456789") != "" {
+		return 0, amapErr.NewParseError("金额格式错误：" + strconv.Quote(text))
+	}
+	fen, err := strconv.ParseInt(yuan+fraction[:2], 10, 64)
+	if err != nil {
+		return 0, amapErr.NewParseError("金额格式错误：" + strconv.Quote(text))
+	}
+	if fraction[2] >= '5' {
+		fen++
+	}
+	if strings.HasPrefix(text, "-") {
+		fen = -fen
+	}
+	return fen, nil
+}
+
+// ParseCount 解析计数字段（如红绿灯个数）
+func ParseCount(s amapType.FlexString) (int, error) {
+	return s.Int()
+}
@@ -1,13 +1,16 @@
 package bicycling
 
 import (
+	"fmt"
+
+	amapErr "github.com/enneket/amap/errors"
 	amapType "github.com/enneket/amap/types"
 )
 
 // BicyclingRequest 骑行路径规划请求参数
 type BicyclingRequest struct {
-	Origin          string                  `json:"origin"`                   // 起点坐标（必填，格式：经度,纬度）
-	Destination     string                  `json:"destination"`              // 终点坐标（必填，格式：经度,纬度）
+	Origin          amapType.LngLat         `json:"origin"`                   // 起点坐标（必填）
+	Destination     amapType.LngLat         `json:"destination"`              // 终点坐标（必填）
 	CoordinateType  amapType.CoordinateType `json:"coordinate_type,omitempty"` // 输入/输出坐标系（可选，默认gcj02）
 	Output          amapType.OutputType     `json:"output,omitempty"`          // 输出格式（可选，默认JSON）
 	Language        amapType.LanguageType   `json:"language,omitempty"`        // 语言（可选，默认中文）
@@ -17,8 +20,8 @@ type BicyclingRequest struct {
 // ToParams 将请求参数转换为map[string]string格式
 func (req *BicyclingRequest) ToParams() map[string]string {
 	params := make(map[string]string)
-	params["origin"] = req.Origin     // 起点坐标为必填项
-	params["destination"] = req.Destination // 终点坐标为必填项
+	params["origin"] = req.Origin.String()     // 起点坐标为必填项
+	params["destination"] = req.Destination.String() // 终点坐标为必填项
 	if req.CoordinateType != "" {
 		params["coordinate_type"] = string(req.CoordinateType)
 	}
@@ -33,3 +36,25 @@ func (req *BicyclingRequest) ToParams() map[string]string {
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 direction.bicycling）
+func (req *BicyclingRequest) Endpoint() string {
+	return "direction.bicycling"
+}
+
+// Validate 校验必填参数
+func (req *BicyclingRequest) Validate() error {
+	if req.Origin.IsZero() {
+		return amapErr.NewInvalidConfigError("骑行路径规划：origin参数不能为空")
+	}
+	if req.Destination.IsZero() {
+		return amapErr.NewInvalidConfigError("骑行路径规划：destination参数不能为空")
+	}
+	// 校验经纬度范围
+	for _, p := range []amapType.LngLat{req.Origin, req.Destination} {
+		if err := p.Validate(); err != nil {
+			return amapErr.NewInvalidConfigError(fmt.Sprintf("骑行路径规划：坐标错误：%v", err))
+		}
+	}
+	return nil
+}
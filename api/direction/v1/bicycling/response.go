@@ -1,6 +1,9 @@
 package bicycling
 
 import (
+	"github.com/enneket/amap/api/direction"
+	"github.com/enneket/amap/geo"
+	"github.com/enneket/amap/geojson"
 	amapType "github.com/enneket/amap/types"
 )
 
@@ -12,29 +15,85 @@ type BicyclingResponse struct {
 
 // Route 路线信息
 type Route struct {
-	Origin      string   `json:"origin"`       // 起点坐标
-	Destination string   `json:"destination"`  // 终点坐标
+	Origin      amapType.FlexString   `json:"origin"`       // 起点坐标
+	Destination amapType.FlexString   `json:"destination"`  // 终点坐标
 	Paths       []Path   `json:"paths"`        // 路径列表
-	Distance    string   `json:"distance"`     // 总距离（米）
-	Duration    string   `json:"duration"`     // 总时间（秒）
+	Distance    amapType.FlexString   `json:"distance"`     // 总距离（米）
+	Duration    amapType.FlexString   `json:"duration"`     // 总时间（秒）
 }
 
 // Path 路径信息
 type Path struct {
-	Distance    string  `json:"distance"`     // 路径距离（米）
-	Duration    string  `json:"duration"`     // 路径时间（秒）
+	Distance    amapType.FlexString  `json:"distance"`     // 路径距离（米）
+	Duration    amapType.FlexString  `json:"duration"`     // 路径时间（秒）
 	Steps       []Step  `json:"steps"`        // 骑行步骤
-	Polyline    string  `json:"polyline"`     // 路径坐标集合
+	Polyline    amapType.FlexString  `json:"polyline"`     // 路径坐标集合
 }
 
 // Step 骑行步骤信息
 type Step struct {
-	Instruction string `json:"instruction"`   // 骑行指示
-	Orientation string `json:"orientation"`   // 方向
-	Road        string `json:"road"`          // 道路名称
-	Distance    string `json:"distance"`      // 距离（米）
-	Duration    string `json:"duration"`      // 时间（秒）
-	Polyline    string `json:"polyline"`      // 坐标集合
-	Action      string `json:"action"`        // 主要动作
-	AssistantAction string `json:"assistant_action"` // 辅助动作
+	Instruction amapType.FlexString `json:"instruction"`   // 骑行指示
+	Orientation amapType.FlexString `json:"orientation"`   // 方向
+	Road        amapType.FlexString `json:"road"`          // 道路名称
+	Distance    amapType.FlexString `json:"distance"`      // 距离（米）
+	Duration    amapType.FlexString `json:"duration"`      // 时间（秒）
+	Polyline    amapType.FlexString `json:"polyline"`      // 坐标集合
+	Action      amapType.FlexString `json:"action"`        // 主要动作
+	AssistantAction amapType.FlexString `json:"assistant_action"` // 辅助动作
+}
+
+// Points 路径坐标（优先使用整条路径的 polyline，未返回时拼接各路段的 polyline）
+func (p *Path) Points() (amapType.LngLatList, error) {
+	steps := make([]string, len(p.Steps))
+	for i, step := range p.Steps {
+		steps[i] = step.Polyline.String()
+	}
+	return geo.PathPoints(p.Polyline.String(), steps...)
+}
+
+// ToGeoJSON 导出路径为 GeoJSON 线要素（属性含 distance/duration，单位为米和秒）
+func (p *Path) ToGeoJSON(opts ...geojson.Option) (*geojson.Feature, error) {
+	points, err := p.Points()
+	if err != nil {
+		return nil, err
+	}
+	return geojson.RouteFeature(points, p.Distance.String(), p.Duration.String(), opts...), nil
+}
+
+// ToGeoJSON 导出所有路径为 GeoJSON 要素集合（属性 index 为路径序号）
+func (resp *BicyclingResponse) ToGeoJSON(opts ...geojson.Option) (*geojson.FeatureCollection, error) {
+	fc := geojson.NewFeatureCollection()
+	for i := range resp.Route.Paths {
+		feature, err := resp.Route.Paths[i].ToGeoJSON(opts...)
+		if err != nil {
+			return nil, err
+		}
+		feature.Properties["index"] = i
+		fc.Add(feature)
+	}
+	return fc, nil
+}
+
+// Metrics 数值字段
+func (r *Route) Metrics() direction.Metrics {
+	return direction.Metrics{Distance: r.Distance, Duration: r.Duration}
+}
+
+// Metrics 数值字段（实现 direction.Plan）
+func (p *Path) Metrics() direction.Metrics {
+	return direction.Metrics{Distance: p.Distance, Duration: p.Duration}
+}
+
+// Metrics 数值字段
+func (s *Step) Metrics() direction.Metrics {
+	return direction.Metrics{Distance: s.Distance, Duration: s.Duration}
+}
+
+// Plans 全部路线方案（实现 direction.Route）
+func (resp *BicyclingResponse) Plans() []direction.Plan {
+	plans := make([]direction.Plan, len(resp.Route.Paths))
+	for i := range resp.Route.Paths {
+		plans[i] = &resp.Route.Paths[i]
+	}
+	return plans
 }
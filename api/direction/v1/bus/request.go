@@ -1,25 +1,32 @@
 package bus
 
+import (
+	"fmt"
+
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+)
+
 // BusRequest 公交路线查询请求参数
 // 文档：https://lbs.amap.com/api/webservice/guide/api/direction#t5
 type BusRequest struct {
-	Origin      string `json:"origin"`               // 起点坐标（必填）
-	Destination string `json:"destination"`          // 终点坐标（必填）
-	City        string `json:"city,omitempty"`       // 城市（可选，默认根据坐标判断）
-	CityD       string `json:"cityd,omitempty"`      // 城市（可选，默认根据坐标判断）
-	Extensions  string `json:"extensions,omitempty"` // 返回结果类型（可选，base/all，默认base）
-	Strategy    string `json:"strategy,omitempty"`   // 策略（可选，默认0）
-	NightFlag   string `json:"nightflag,omitempty"`  // 是否计算夜班车（可选，默认0）
-	Date        string `json:"date,omitempty"`       // 日期（可选，默认当前日期）
-	Time        string `json:"time,omitempty"`       // 时间（可选，默认当前时间）
-	Callback    string `json:"callback,omitempty"`   // 回调函数名（可选，JSONP格式）
+	Origin      amapType.LngLat `json:"origin"`               // 起点坐标（必填）
+	Destination amapType.LngLat `json:"destination"`          // 终点坐标（必填）
+	City        string          `json:"city,omitempty"`       // 城市（可选，默认根据坐标判断）
+	CityD       string          `json:"cityd,omitempty"`      // 城市（可选，默认根据坐标判断）
+	Extensions  string          `json:"extensions,omitempty"` // 返回结果类型（可选，base/all，默认base）
+	Strategy    string          `json:"strategy,omitempty"`   // 策略（可选，默认0）
+	NightFlag   string          `json:"nightflag,omitempty"`  // 是否计算夜班车（可选，默认0）
+	Date        string          `json:"date,omitempty"`       // 日期（可选，默认当前日期）
+	Time        string          `json:"time,omitempty"`       // 时间（可选，默认当前时间）
+	Callback    string          `json:"callback,omitempty"`   // 回调函数名（可选，JSONP格式）
 }
 
 // ToParams 将请求参数转换为map[string]string格式
 func (req *BusRequest) ToParams() map[string]string {
 	params := make(map[string]string)
-	params["origin"] = req.Origin           // 起点坐标为必填项
-	params["destination"] = req.Destination // 终点坐标为必填项
+	params["origin"] = req.Origin.String()           // 起点坐标为必填项
+	params["destination"] = req.Destination.String() // 终点坐标为必填项
 	if req.City != "" {
 		params["city"] = req.City
 	}
@@ -46,3 +53,25 @@ func (req *BusRequest) ToParams() map[string]string {
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 direction.transit）
+func (req *BusRequest) Endpoint() string {
+	return "direction.transit"
+}
+
+// Validate 校验必填参数
+func (req *BusRequest) Validate() error {
+	if req.Origin.IsZero() {
+		return amapErr.NewInvalidConfigError("公交路径规划：origin参数不能为空")
+	}
+	if req.Destination.IsZero() {
+		return amapErr.NewInvalidConfigError("公交路径规划：destination参数不能为空")
+	}
+	// 校验经纬度范围
+	for _, p := range []amapType.LngLat{req.Origin, req.Destination} {
+		if err := p.Validate(); err != nil {
+			return amapErr.NewInvalidConfigError(fmt.Sprintf("公交路径规划：坐标错误：%v", err))
+		}
+	}
+	return nil
+}
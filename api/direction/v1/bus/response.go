@@ -1,6 +1,11 @@
 package bus
 
 import (
+	"strconv"
+
+	"github.com/enneket/amap/api/direction"
+	"github.com/enneket/amap/geo"
+	"github.com/enneket/amap/geojson"
 	amapType "github.com/enneket/amap/types"
 )
 
@@ -13,71 +18,128 @@ type BusResponse struct {
 
 // Route 路线信息
 type Route struct {
-	Origin      string    `json:"origin"`      // 起点坐标
-	Destination string    `json:"destination"` // 终点坐标
-	Distance    string    `json:"distance"`    // 总距离（米）
-	TaxiCost    string    `json:"taxi_cost"`   // 打车费（元）
-	Transits    []Transit `json:"transits"`    // 换乘次数
+	Origin      amapType.FlexString `json:"origin"`      // 起点坐标
+	Destination amapType.FlexString `json:"destination"` // 终点坐标
+	Distance    amapType.FlexString `json:"distance"`    // 总距离（米）
+	TaxiCost    amapType.FlexString `json:"taxi_cost"`   // 打车费（元）
+	Transits    []Transit           `json:"transits"`    // 换乘次数
 }
 
 type Transit struct {
-	Cost          string      `json:"cost"`           // 换乘费用（元）
-	Duration      string      `json:"duration"`       // 换乘时间（秒）
-	BusLineName   string      `json:"busline_name"`   // 公交线路名称
-	BusLineID     string      `json:"busline_id"`     // 公交线路ID
-	DepartureStop BusStopInfo `json:"departure_stop"` // 上车站点
-	ArrivalStop   BusStopInfo `json:"arrival_stop"`   // 下车站点
+	Cost          amapType.FlexString `json:"cost"`           // 换乘费用（元）
+	Distance      amapType.FlexString `json:"distance"`       // 换乘方案总距离（米）
+	Duration      amapType.FlexString `json:"duration"`       // 换乘时间（秒）
+	BusLineName   amapType.FlexString `json:"busline_name"`   // 公交线路名称
+	BusLineID     amapType.FlexString `json:"busline_id"`     // 公交线路ID
+	DepartureStop BusStopInfo         `json:"departure_stop"` // 上车站点
+	ArrivalStop   BusStopInfo         `json:"arrival_stop"`   // 下车站点
 }
 
 // Path 路径信息
 type Path struct {
-	Distance     string `json:"distance"`      // 路径总距离（米）
-	Duration     string `json:"duration"`      // 路径总时间（秒）
-	Steps        []Step `json:"steps"`         // 导航路段列表
-	Polyline     string `json:"polyline"`      // 路径坐标集合
-	Transits     int    `json:"transits"`      // 换乘次数
-	Cost         int    `json:"cost"`          // 票价（元）
-	WalkDistance string `json:"walk_distance"` // 步行距离（米）
+	Distance     amapType.FlexString `json:"distance"`      // 路径总距离（米）
+	Duration     amapType.FlexString `json:"duration"`      // 路径总时间（秒）
+	Steps        []Step              `json:"steps"`         // 导航路段列表
+	Polyline     amapType.FlexString `json:"polyline"`      // 路径坐标集合
+	Transits     amapType.FlexInt    `json:"transits"`      // 换乘次数
+	Cost         amapType.FlexInt    `json:"cost"`          // 票价（元）
+	WalkDistance amapType.FlexString `json:"walk_distance"` // 步行距离（米）
 }
 
 // Step 导航路段信息
 type Step struct {
-	Instruction     string       `json:"instruction"`             // 路段指示
-	Orientation     string       `json:"orientation"`             // 方向
-	Road            string       `json:"road"`                    // 道路名称
-	Distance        string       `json:"distance"`                // 距离（米）
-	Duration        string       `json:"duration"`                // 时间（秒）
-	Polyline        string       `json:"polyline"`                // 坐标集合
-	Type            int          `json:"type"`                    // 路段类型（0:步行, 1:公交）
-	Action          string       `json:"action"`                  // 主要动作
-	AssistantAction string       `json:"assistant_action"`        // 辅助动作
-	BusLineInfo     *BusLineInfo `json:"bus_line_info,omitempty"` // 公交线信息（仅当type=1时返回）
-	WalkDetail      *WalkDetail  `json:"walk_detail,omitempty"`   // 步行详细信息（仅当type=0时返回）
+	Instruction     amapType.FlexString `json:"instruction"`             // 路段指示
+	Orientation     amapType.FlexString `json:"orientation"`             // 方向
+	Road            amapType.FlexString `json:"road"`                    // 道路名称
+	Distance        amapType.FlexString `json:"distance"`                // 距离（米）
+	Duration        amapType.FlexString `json:"duration"`                // 时间（秒）
+	Polyline        amapType.FlexString `json:"polyline"`                // 坐标集合
+	Type            amapType.FlexInt    `json:"type"`                    // 路段类型（0:步行, 1:公交）
+	Action          amapType.FlexString `json:"action"`                  // 主要动作
+	AssistantAction amapType.FlexString `json:"assistant_action"`        // 辅助动作
+	BusLineInfo     *BusLineInfo        `json:"bus_line_info,omitempty"` // 公交线信息（仅当type=1时返回）
+	WalkDetail      *WalkDetail         `json:"walk_detail,omitempty"`   // 步行详细信息（仅当type=0时返回）
 }
 
 // BusLineInfo 公交线信息
 type BusLineInfo struct {
-	BusLineName   string        `json:"busline_name"`   // 公交线路名称
-	BusLineID     string        `json:"busline_id"`     // 公交线路ID
-	BusLineType   int           `json:"busline_type"`   // 公交类型（0:未知, 1:公交, 2:地铁）
-	DepartureStop BusStopInfo   `json:"departure_stop"` // 上车站点
-	ArrivalStop   BusStopInfo   `json:"arrival_stop"`   // 下车站点
-	PassStopList  []BusStopInfo `json:"pass_stop_list"` // 途经站点列表
-	BusNumber     string        `json:"busnumber"`      // 公交车辆数
+	BusLineName   amapType.FlexString `json:"busline_name"`   // 公交线路名称
+	BusLineID     amapType.FlexString `json:"busline_id"`     // 公交线路ID
+	BusLineType   amapType.FlexInt    `json:"busline_type"`   // 公交类型（0:未知, 1:公交, 2:地铁）
+	DepartureStop BusStopInfo         `json:"departure_stop"` // 上车站点
+	ArrivalStop   BusStopInfo         `json:"arrival_stop"`   // 下车站点
+	PassStopList  []BusStopInfo       `json:"pass_stop_list"` // 途经站点列表
+	BusNumber     amapType.FlexString `json:"busnumber"`      // 公交车辆数
 }
 
 // BusStopInfo 公交站点信息
 type BusStopInfo struct {
-	Name     string `json:"name"`     // 站点名称
-	Location string `json:"location"` // 站点坐标
+	Name     amapType.FlexString `json:"name"`     // 站点名称
+	Location amapType.FlexString `json:"location"` // 站点坐标
 }
 
 // WalkDetail 步行详细信息
 type WalkDetail struct {
-	Instruction string `json:"instruction"` // 步行指示
-	Orientation string `json:"orientation"` // 方向
-	Road        string `json:"road"`        // 道路名称
-	Distance    string `json:"distance"`    // 距离（米）
-	Duration    string `json:"duration"`    // 时间（秒）
-	Polyline    string `json:"polyline"`    // 坐标集合
+	Instruction amapType.FlexString `json:"instruction"` // 步行指示
+	Orientation amapType.FlexString `json:"orientation"` // 方向
+	Road        amapType.FlexString `json:"road"`        // 道路名称
+	Distance    amapType.FlexString `json:"distance"`    // 距离（米）
+	Duration    amapType.FlexString `json:"duration"`    // 时间（秒）
+	Polyline    amapType.FlexString `json:"polyline"`    // 坐标集合
+}
+
+// Points 路径坐标（优先使用整条路径的 polyline，未返回时拼接各路段的 polyline）
+func (p *Path) Points() (amapType.LngLatList, error) {
+	steps := make([]string, len(p.Steps))
+	for i, step := range p.Steps {
+		steps[i] = step.Polyline.String()
+	}
+	return geo.PathPoints(p.Polyline.String(), steps...)
+}
+
+// ToGeoJSON 导出路径为 GeoJSON 线要素（属性含 distance/duration，单位为米和秒）
+func (p *Path) ToGeoJSON(opts ...geojson.Option) (*geojson.Feature, error) {
+	points, err := p.Points()
+	if err != nil {
+		return nil, err
+	}
+	return geojson.RouteFeature(points, p.Distance.String(), p.Duration.String(), opts...), nil
+}
+
+// Metrics 数值字段
+func (r *Route) Metrics() direction.Metrics {
+	return direction.Metrics{Distance: r.Distance, TaxiCost: r.TaxiCost}
+}
+
+// Metrics 数值字段（实现 direction.Plan）
+func (t *Transit) Metrics() direction.Metrics {
+	return direction.Metrics{
+		Distance: t.Distance,
+		Duration: t.Duration,
+		Fare:     t.Cost,
+	}
+}
+
+// Metrics 数值字段
+func (p *Path) Metrics() direction.Metrics {
+	return direction.Metrics{
+		Distance:        p.Distance,
+		Duration:        p.Duration,
+		Fare:            amapType.FlexString(strconv.Itoa(int(p.Cost))),
+		WalkingDistance: p.WalkDistance,
+	}
+}
+
+// Metrics 数值字段
+func (s *Step) Metrics() direction.Metrics {
+	return direction.Metrics{Distance: s.Distance, Duration: s.Duration}
+}
+
+// Plans 全部路线方案（实现 direction.Route）
+func (resp *BusResponse) Plans() []direction.Plan {
+	plans := make([]direction.Plan, len(resp.Route.Transits))
+	for i := range resp.Route.Transits {
+		plans[i] = &resp.Route.Transits[i]
+	}
+	return plans
 }
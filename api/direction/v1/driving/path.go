@@ -2,5 +2,8 @@ package driving
 
 // 文档： https://lbs.amap.com/api/webservice/guide/api/direction#t6
 const (
+	// API_PATH 接口完整地址
+	//
+	// Deprecated: 接口地址统一由 amap.Endpoints 维护，该常量仅为兼容保留。
 	API_PATH = "https://restapi.amap.com/v3/direction/driving"
 )
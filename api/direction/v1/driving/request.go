@@ -1,29 +1,40 @@
 package driving
 
+import (
+	"fmt"
+	"strings"
+
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+)
+
+// MaxWaypoints 驾车路径规划最多途经点数
+const MaxWaypoints = 16
+
 // DrivingRequest 驾车路径规划请求参数
 type DrivingRequest struct {
-	Origin          string `json:"origin"`                    // 出发点
-	Destination     string `json:"destination"`               // 目的地
-	OriginID        string `json:"originid,omitempty"`        // 出发点 poiid
-	DestinationID   string `json:"destinationid,omitempty"`   // 目的地 poiid
-	DestinationType string `json:"destinationtype,omitempty"` // 终点的 poi 类别
-	Strategy        string `json:"strategy,omitempty"`        // 驾车选择策略
-	Waypoints       string `json:"waypoints,omitempty"`       // 途经点
-	AvoidPolygons   string `json:"avoidpolygons,omitempty"`   // 避让区域
-	Province        string `json:"province,omitempty"`        // 用汉字填入车牌省份缩写，用于判断是否限行
-	Number          string `json:"number,omitempty"`          // 填入除省份及标点之外，车牌的字母和数字（需大写）。用于判断限行相关。
-	CarType         string `json:"cartype,omitempty"`         // 车辆类型
-	Ferry           string `json:"ferry,omitempty"`           // 在路径规划中，是否使用轮渡
-	RoadAggregation string `json:"roadaggregation,omitempty"` // 是否返回路径聚合信息
-	NoSteps         string `json:"nosteps,omitempty"`         // 是否返回步骤信息
-	Callback        string `json:"callback,omitempty"`        // 回调函数名，用于 JSONP 回调
+	Origin          amapType.LngLat       `json:"origin"`                    // 出发点
+	Destination     amapType.LngLat       `json:"destination"`               // 目的地
+	OriginID        string                `json:"originid,omitempty"`        // 出发点 poiid
+	DestinationID   string                `json:"destinationid,omitempty"`   // 目的地 poiid
+	DestinationType string                `json:"destinationtype,omitempty"` // 终点的 poi 类别
+	Strategy        string                `json:"strategy,omitempty"`        // 驾车选择策略
+	Waypoints       amapType.LngLatList   `json:"waypoints,omitempty"`       // 途经点（以 ; 分隔发送，最多16个）
+	AvoidPolygons   []amapType.LngLatList `json:"avoidpolygons,omitempty"`   // 避让区域（每个区域为多边形顶点列表）
+	Province        string                `json:"province,omitempty"`        // 用汉字填入车牌省份缩写，用于判断是否限行
+	Number          string                `json:"number,omitempty"`          // 填入除省份及标点之外，车牌的字母和数字（需大写）。用于判断限行相关。
+	CarType         string                `json:"cartype,omitempty"`         // 车辆类型
+	Ferry           string                `json:"ferry,omitempty"`           // 在路径规划中，是否使用轮渡
+	RoadAggregation string                `json:"roadaggregation,omitempty"` // 是否返回路径聚合信息
+	NoSteps         string                `json:"nosteps,omitempty"`         // 是否返回步骤信息
+	Callback        string                `json:"callback,omitempty"`        // 回调函数名，用于 JSONP 回调
 }
 
 // ToParams 将请求参数转换为map[string]string格式
 func (req *DrivingRequest) ToParams() map[string]string {
 	params := make(map[string]string)
-	params["origin"] = req.Origin           // 起点坐标为必填项
-	params["destination"] = req.Destination // 终点坐标为必填项
+	params["origin"] = req.Origin.String()           // 起点坐标为必填项
+	params["destination"] = req.Destination.String() // 终点坐标为必填项
 	if req.OriginID != "" {
 		params["originid"] = req.OriginID
 	}
@@ -36,11 +47,16 @@ func (req *DrivingRequest) ToParams() map[string]string {
 	if req.Strategy != "" {
 		params["strategy"] = req.Strategy
 	}
-	if req.Waypoints != "" {
-		params["waypoints"] = req.Waypoints
+	if len(req.Waypoints) > 0 {
+		params["waypoints"] = req.Waypoints.Join(";")
 	}
-	if req.AvoidPolygons != "" {
-		params["avoidpolygons"] = req.AvoidPolygons
+	if len(req.AvoidPolygons) > 0 {
+		// 区域间用 | 分隔，坐标间用 ; 分隔
+		polygons := make([]string, len(req.AvoidPolygons))
+		for i, polygon := range req.AvoidPolygons {
+			polygons[i] = polygon.Join(";")
+		}
+		params["avoidpolygons"] = strings.Join(polygons, "|")
 	}
 	if req.Province != "" {
 		params["province"] = req.Province
@@ -65,3 +81,32 @@ func (req *DrivingRequest) ToParams() map[string]string {
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 direction.driving）
+func (req *DrivingRequest) Endpoint() string {
+	return "direction.driving"
+}
+
+// Validate 校验必填参数
+func (req *DrivingRequest) Validate() error {
+	if req.Origin.IsZero() {
+		return amapErr.NewInvalidConfigError("驾车路径规划：origin参数不能为空")
+	}
+	if req.Destination.IsZero() {
+		return amapErr.NewInvalidConfigError("驾车路径规划：destination参数不能为空")
+	}
+	if len(req.Waypoints) > MaxWaypoints {
+		return amapErr.NewInvalidConfigError(fmt.Sprintf("驾车路径规划：途经点最多 %d 个", MaxWaypoints))
+	}
+	// 校验经纬度范围
+	points := append([]amapType.LngLat{req.Origin, req.Destination}, req.Waypoints...)
+	for _, polygon := range req.AvoidPolygons {
+		points = append(points, polygon...)
+	}
+	for _, p := range points {
+		if err := p.Validate(); err != nil {
+			return amapErr.NewInvalidConfigError(fmt.Sprintf("驾车路径规划：坐标错误：%v", err))
+		}
+	}
+	return nil
+}
@@ -1,6 +1,9 @@
 package driving
 
 import (
+	"github.com/enneket/amap/api/direction"
+	"github.com/enneket/amap/geo"
+	"github.com/enneket/amap/geojson"
 	amapType "github.com/enneket/amap/types"
 )
 
@@ -12,55 +15,121 @@ type DrivingResponse struct {
 
 // Route 路线信息
 type Route struct {
-	Origin      string `json:"origin"`      // 起点坐标
-	Destination string `json:"destination"` // 终点坐标
-	TaxiCost    string `json:"taxi_cost"`   // 打车费用（元）
-	Paths       []Path `json:"paths"`       // 驾车换乘方案
+	Origin      amapType.FlexString `json:"origin"`      // 起点坐标
+	Destination amapType.FlexString `json:"destination"` // 终点坐标
+	TaxiCost    amapType.FlexString `json:"taxi_cost"`   // 打车费用（元）
+	Paths       []Path              `json:"paths"`       // 驾车换乘方案
 }
 
 // Path 路径信息
 type Path struct {
-	Distance      string `json:"distance"`       // 路径距离（米）
-	Duration      string `json:"duration"`       // 预计行驶时间
-	Strategy      string `json:"strategy"`       // 导航策略
-	Tolls         string `json:"tolls"`          // 费用（元）
-	Restrictions  string `json:"restrictions"`   // 限行结果
-	TrafficLights string `json:"traffic_lights"` // 红绿灯个数
-	TollDistance  string `json:"toll_distance"`  // 收费路段距离（米）
-	Steps         []Step `json:"steps"`          // 导航路段
+	Distance      amapType.FlexString `json:"distance"`       // 路径距离（米）
+	Duration      amapType.FlexString `json:"duration"`       // 预计行驶时间
+	Strategy      amapType.FlexString `json:"strategy"`       // 导航策略
+	Tolls         amapType.FlexString `json:"tolls"`          // 费用（元）
+	Restrictions  amapType.FlexString `json:"restrictions"`   // 限行结果
+	TrafficLights amapType.FlexString `json:"traffic_lights"` // 红绿灯个数
+	TollDistance  amapType.FlexString `json:"toll_distance"`  // 收费路段距离（米）
+	Steps         []Step              `json:"steps"`          // 导航路段
 }
 
 // Step 导航路段信息
 type Step struct {
-	Instruction     string `json:"instruction"`      // 行驶指示
-	Orientation     string `json:"orientation"`      // 方向
-	Road            string `json:"road"`             // 道路名称
-	Distance        string `json:"distance"`         // 此路段距离
-	Tolls           string `json:"tolls"`            // 此段收费
-	TollDistance    string `json:"toll_distance"`    // 收费路段距离
-	TollRoad        string `json:"toll_road"`        // 主要收费道路
-	Polyline        string `json:"polyline"`         // 此路段坐标点串
-	Action          string `json:"action"`           // 导航主要动作
-	AssistantAction string `json:"assistant_action"` // 导航辅助动作
-	Tmcs            Tmcs   `json:"tmcs"`             // 驾车导航详细信息
+	Instruction     amapType.FlexString `json:"instruction"`      // 行驶指示
+	Orientation     amapType.FlexString `json:"orientation"`      // 方向
+	Road            amapType.FlexString `json:"road"`             // 道路名称
+	Distance        amapType.FlexString `json:"distance"`         // 此路段距离
+	Tolls           amapType.FlexString `json:"tolls"`            // 此段收费
+	TollDistance    amapType.FlexString `json:"toll_distance"`    // 收费路段距离
+	TollRoad        amapType.FlexString `json:"toll_road"`        // 主要收费道路
+	Polyline        amapType.FlexString `json:"polyline"`         // 此路段坐标点串
+	Action          amapType.FlexString `json:"action"`           // 导航主要动作
+	AssistantAction amapType.FlexString `json:"assistant_action"` // 导航辅助动作
+	Tmcs            Tmcs                `json:"tmcs"`             // 驾车导航详细信息
 
 }
 
 type Tmcs struct {
-	Distance string `json:"distance"` // 距此段路的长度（米）
-	Status   string `json:"status"`   // 此段路的交通情况
-	Polyline string `json:"polyline"` // 此段路的轨迹
-	Cities   []City `json:"cities"`   // 路线途经行政区划
+	Distance amapType.FlexString `json:"distance"` // 距此段路的长度（米）
+	Status   amapType.FlexString `json:"status"`   // 此段路的交通情况
+	Polyline amapType.FlexString `json:"polyline"` // 此段路的轨迹
+	Cities   []City              `json:"cities"`   // 路线途经行政区划
 }
 
 type City struct {
-	Name      string     `json:"name"`      // 城市名称
-	CityCode  string     `json:"citycode"`  // 城市编码
-	AdCode    string     `json:"adcode"`    // 区域编码
-	Districts []District `json:"districts"` // 区县信息
+	Name      amapType.FlexString `json:"name"`      // 城市名称
+	CityCode  amapType.FlexString `json:"citycode"`  // 城市编码
+	AdCode    amapType.FlexString `json:"adcode"`    // 区域编码
+	Districts []District          `json:"districts"` // 区县信息
 }
 
 type District struct {
-	Name   string `json:"name"`   // 区县名称
-	AdCode string `json:"adcode"` // 区域编码
+	Name   amapType.FlexString `json:"name"`   // 区县名称
+	AdCode amapType.FlexString `json:"adcode"` // 区域编码
+}
+
+// Points 路径坐标（拼接各路段的 polyline）
+func (p *Path) Points() (amapType.LngLatList, error) {
+	steps := make([]string, len(p.Steps))
+	for i, step := range p.Steps {
+		steps[i] = step.Polyline.String()
+	}
+	return geo.ConcatPolylines(steps...)
+}
+
+// ToGeoJSON 导出路径为 GeoJSON 线要素（属性含 distance/duration，单位为米和秒）
+func (p *Path) ToGeoJSON(opts ...geojson.Option) (*geojson.Feature, error) {
+	points, err := p.Points()
+	if err != nil {
+		return nil, err
+	}
+	return geojson.RouteFeature(points, p.Distance.String(), p.Duration.String(), opts...), nil
+}
+
+// ToGeoJSON 导出所有路径为 GeoJSON 要素集合（属性 index 为路径序号）
+func (resp *DrivingResponse) ToGeoJSON(opts ...geojson.Option) (*geojson.FeatureCollection, error) {
+	fc := geojson.NewFeatureCollection()
+	for i := range resp.Route.Paths {
+		feature, err := resp.Route.Paths[i].ToGeoJSON(opts...)
+		if err != nil {
+			return nil, err
+		}
+		feature.Properties["index"] = i
+		fc.Add(feature)
+	}
+	return fc, nil
+}
+
+// Metrics 数值字段
+func (r *Route) Metrics() direction.Metrics {
+	return direction.Metrics{TaxiCost: r.TaxiCost}
+}
+
+// Metrics 数值字段（实现 direction.Plan）
+func (p *Path) Metrics() direction.Metrics {
+	return direction.Metrics{
+		Distance:      p.Distance,
+		Duration:      p.Duration,
+		Tolls:         p.Tolls,
+		TollDistance:  p.TollDistance,
+		TrafficLights: p.TrafficLights,
+	}
+}
+
+// Metrics 数值字段
+func (s *Step) Metrics() direction.Metrics {
+	return direction.Metrics{
+		Distance:     s.Distance,
+		Tolls:        s.Tolls,
+		TollDistance: s.TollDistance,
+	}
+}
+
+// Plans 全部路线方案（实现 direction.Route）
+func (resp *DrivingResponse) Plans() []direction.Plan {
+	plans := make([]direction.Plan, len(resp.Route.Paths))
+	for i := range resp.Route.Paths {
+		plans[i] = &resp.Route.Paths[i]
+	}
+	return plans
 }
@@ -1,5 +1,8 @@
 package walking
 
 const (
+	// API_PATH 接口完整地址
+	//
+	// Deprecated: 接口地址统一由 amap.Endpoints 维护，该常量仅为兼容保留。
 	API_PATH = "https://restapi.amap.com/v3/direction/walking"
 )
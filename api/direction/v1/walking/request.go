@@ -1,20 +1,27 @@
 package walking
 
+import (
+	"fmt"
+
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+)
+
 // WalkingRequest 步行路径规划请求参数
 // 文档：https://lbs.amap.com/api/webservice/guide/api/direction#t4
 type WalkingRequest struct {
-	Origin        string `json:"origin"`                   // 起点坐标（必填，格式：经度,纬度）
-	Destination   string `json:"destination"`              // 终点坐标（必填，格式：经度,纬度）
-	OriginID      string `json:"origin_id,omitempty"`      // 起点 POI ID（可选）
-	DestinationID string `json:"destination_id,omitempty"` // 目的地 POI ID（可选）
-	Callback      string `json:"callback,omitempty"`       // 回调函数名（可选，JSONP格式）
+	Origin        amapType.LngLat `json:"origin"`                   // 起点坐标（必填）
+	Destination   amapType.LngLat `json:"destination"`              // 终点坐标（必填）
+	OriginID      string          `json:"origin_id,omitempty"`      // 起点 POI ID（可选）
+	DestinationID string          `json:"destination_id,omitempty"` // 目的地 POI ID（可选）
+	Callback      string          `json:"callback,omitempty"`       // 回调函数名（可选，JSONP格式）
 }
 
 // ToParams 将请求参数转换为map[string]string格式
 func (req *WalkingRequest) ToParams() map[string]string {
 	params := make(map[string]string)
-	params["origin"] = req.Origin           // 起点坐标为必填项
-	params["destination"] = req.Destination // 终点坐标为必填项
+	params["origin"] = req.Origin.String()           // 起点坐标为必填项
+	params["destination"] = req.Destination.String() // 终点坐标为必填项
 	if req.OriginID != "" {
 		params["origin_id"] = string(req.OriginID)
 	}
@@ -26,3 +33,25 @@ func (req *WalkingRequest) ToParams() map[string]string {
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 direction.walking）
+func (req *WalkingRequest) Endpoint() string {
+	return "direction.walking"
+}
+
+// Validate 校验必填参数
+func (req *WalkingRequest) Validate() error {
+	if req.Origin.IsZero() {
+		return amapErr.NewInvalidConfigError("步行路径规划：origin参数不能为空")
+	}
+	if req.Destination.IsZero() {
+		return amapErr.NewInvalidConfigError("步行路径规划：destination参数不能为空")
+	}
+	// 校验经纬度范围
+	for _, p := range []amapType.LngLat{req.Origin, req.Destination} {
+		if err := p.Validate(); err != nil {
+			return amapErr.NewInvalidConfigError(fmt.Sprintf("步行路径规划：坐标错误：%v", err))
+		}
+	}
+	return nil
+}
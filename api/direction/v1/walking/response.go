@@ -1,6 +1,9 @@
 package walking
 
 import (
+	"github.com/enneket/amap/api/direction"
+	"github.com/enneket/amap/geo"
+	"github.com/enneket/amap/geojson"
 	amapType "github.com/enneket/amap/types"
 )
 
@@ -13,30 +16,90 @@ type WalkingResponse struct {
 
 // Route 路线信息
 type Route struct {
-	Origin      string `json:"origin"`      // 起点坐标
-	Destination string `json:"destination"` // 终点坐标
-	Paths       []Path `json:"paths"`       // 路径列表
-	Distance    string `json:"distance"`    // 总距离（米）
-	Duration    string `json:"duration"`    // 总时间（秒）
-	Tolls       string `json:"tolls"`       // 总费用（元，步行无费用）
+	Origin      amapType.FlexString `json:"origin"`      // 起点坐标
+	Destination amapType.FlexString `json:"destination"` // 终点坐标
+	Paths       []Path              `json:"paths"`       // 路径列表
+	Distance    amapType.FlexString `json:"distance"`    // 总距离（米）
+	Duration    amapType.FlexString `json:"duration"`    // 总时间（秒）
+	Tolls       amapType.FlexString `json:"tolls"`       // 总费用（元，步行无费用）
 }
 
 // Path 路径信息
 type Path struct {
-	Distance string `json:"distance"` // 路径距离（米）
-	Duration string `json:"duration"` // 路径时间（秒）
-	Steps    []Step `json:"steps"`    // 步行步骤
+	Distance amapType.FlexString `json:"distance"` // 路径距离（米）
+	Duration amapType.FlexString `json:"duration"` // 路径时间（秒）
+	Steps    []Step              `json:"steps"`    // 步行步骤
 }
 
 // Step 步行步骤信息
 type Step struct {
-	Instruction     string `json:"instruction"`      // 步行指示
-	Road            any    `json:"road"`             // 道路名称
-	Distance        string `json:"distance"`         // 距离（米）
-	Orientation     any    `json:"orientation"`      // 方向
-	Duration        string `json:"duration"`         // 时间（秒）
-	Polyline        string `json:"polyline"`         // 坐标集合
-	Action          any    `json:"action"`           // 主要动作
-	AssistantAction any    `json:"assistant_action"` // 辅助动作
-	WalkType        string `json:"walk_type"`        // 这段路是否存在特殊的方式
+	Instruction     amapType.FlexString `json:"instruction"`      // 步行指示
+	Road            amapType.FlexString `json:"road"`             // 道路名称
+	Distance        amapType.FlexString `json:"distance"`         // 距离（米）
+	Orientation     amapType.FlexString `json:"orientation"`      // 方向
+	Duration        amapType.FlexString `json:"duration"`         // 时间（秒）
+	Polyline        amapType.FlexString `json:"polyline"`         // 坐标集合
+	Action          amapType.FlexString `json:"action"`           // 主要动作
+	AssistantAction amapType.FlexString `json:"assistant_action"` // 辅助动作
+	WalkType        amapType.FlexString `json:"walk_type"`        // 这段路是否存在特殊的方式
+}
+
+// Points 路径坐标（拼接各路段的 polyline）
+func (p *Path) Points() (amapType.LngLatList, error) {
+	steps := make([]string, len(p.Steps))
+	for i, step := range p.Steps {
+		steps[i] = step.Polyline.String()
+	}
+	return geo.ConcatPolylines(steps...)
+}
+
+// ToGeoJSON 导出路径为 GeoJSON 线要素（属性含 distance/duration，单位为米和秒）
+func (p *Path) ToGeoJSON(opts ...geojson.Option) (*geojson.Feature, error) {
+	points, err := p.Points()
+	if err != nil {
+		return nil, err
+	}
+	return geojson.RouteFeature(points, p.Distance.String(), p.Duration.String(), opts...), nil
+}
+
+// ToGeoJSON 导出所有路径为 GeoJSON 要素集合（属性 index 为路径序号）
+func (resp *WalkingResponse) ToGeoJSON(opts ...geojson.Option) (*geojson.FeatureCollection, error) {
+	fc := geojson.NewFeatureCollection()
+	for i := range resp.Route.Paths {
+		feature, err := resp.Route.Paths[i].ToGeoJSON(opts...)
+		if err != nil {
+			return nil, err
+		}
+		feature.Properties["index"] = i
+		fc.Add(feature)
+	}
+	return fc, nil
+}
+
+// Metrics 数值字段
+func (r *Route) Metrics() direction.Metrics {
+	return direction.Metrics{
+		Distance: r.Distance,
+		Duration: r.Duration,
+		Tolls:    r.Tolls,
+	}
+}
+
+// Metrics 数值字段（实现 direction.Plan）
+func (p *Path) Metrics() direction.Metrics {
+	return direction.Metrics{Distance: p.Distance, Duration: p.Duration}
+}
+
+// Metrics 数值字段
+func (s *Step) Metrics() direction.Metrics {
+	return direction.Metrics{Distance: s.Distance, Duration: s.Duration}
+}
+
+// Plans 全部路线方案（实现 direction.Route）
+func (resp *WalkingResponse) Plans() []direction.Plan {
+	plans := make([]direction.Plan, len(resp.Route.Paths))
+	for i := range resp.Route.Paths {
+		plans[i] = &resp.Route.Paths[i]
+	}
+	return plans
 }
@@ -1,13 +1,16 @@
 package bicycling
 
 import (
+	"fmt"
+
+	amapErr "github.com/enneket/amap/errors"
 	amapType "github.com/enneket/amap/types"
 )
 
 // BicyclingRequestV2 骑行路线规划v2请求参数
 type BicyclingRequestV2 struct {
-	Origin          string                  `json:"origin"`                   // 起点坐标（必填，格式：经度,纬度）
-	Destination     string                  `json:"destination"`              // 终点坐标（必填，格式：经度,纬度）
+	Origin          amapType.LngLat         `json:"origin"`                   // 起点坐标（必填）
+	Destination     amapType.LngLat         `json:"destination"`              // 终点坐标（必填）
 	Strategy        string                  `json:"strategy,omitempty"`       // 骑行策略（可选，默认0=最快捷）
 	CoordinateType  amapType.CoordinateType `json:"coordinate_type,omitempty"` // 输入/输出坐标系（可选，默认gcj02）
 	Output          amapType.OutputType     `json:"output,omitempty"`          // 输出格式（可选，默认JSON）
@@ -18,8 +21,8 @@ type BicyclingRequestV2 struct {
 // ToParams 将请求参数转换为map[string]string格式
 func (req *BicyclingRequestV2) ToParams() map[string]string {
 	params := make(map[string]string)
-	params["origin"] = req.Origin     // 起点坐标为必填项
-	params["destination"] = req.Destination // 终点坐标为必填项
+	params["origin"] = req.Origin.String()     // 起点坐标为必填项
+	params["destination"] = req.Destination.String() // 终点坐标为必填项
 	if req.Strategy != "" {
 		params["strategy"] = req.Strategy
 	}
@@ -37,3 +40,25 @@ func (req *BicyclingRequestV2) ToParams() map[string]string {
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 direction.v2.bicycling）
+func (req *BicyclingRequestV2) Endpoint() string {
+	return "direction.v2.bicycling"
+}
+
+// Validate 校验必填参数
+func (req *BicyclingRequestV2) Validate() error {
+	if req.Origin.IsZero() {
+		return amapErr.NewInvalidConfigError("骑行路径规划v2：origin参数不能为空")
+	}
+	if req.Destination.IsZero() {
+		return amapErr.NewInvalidConfigError("骑行路径规划v2：destination参数不能为空")
+	}
+	// 校验经纬度范围
+	for _, p := range []amapType.LngLat{req.Origin, req.Destination} {
+		if err := p.Validate(); err != nil {
+			return amapErr.NewInvalidConfigError(fmt.Sprintf("骑行路径规划v2：坐标错误：%v", err))
+		}
+	}
+	return nil
+}
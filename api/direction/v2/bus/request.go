@@ -1,13 +1,16 @@
 package bus
 
 import (
+	"fmt"
+
+	amapErr "github.com/enneket/amap/errors"
 	amapType "github.com/enneket/amap/types"
 )
 
 // BusRequestV2 公交路线规划v2请求参数
 type BusRequestV2 struct {
-	Origin          string                  `json:"origin"`                   // 起点坐标（必填，格式：经度,纬度）
-	Destination     string                  `json:"destination"`              // 终点坐标（必填，格式：经度,纬度）
+	Origin          amapType.LngLat         `json:"origin"`                   // 起点坐标（必填）
+	Destination     amapType.LngLat         `json:"destination"`              // 终点坐标（必填）
 	City            string                  `json:"city,omitempty"`           // 城市（可选，格式：城市名称/城市代码）
 	Strategy        string                  `json:"strategy,omitempty"`       // 公交策略（可选，默认0=最快捷）
 	Nightflag       string                  `json:"nightflag,omitempty"`      // 是否考虑夜班车（可选，0=不考虑，1=考虑）
@@ -22,8 +25,8 @@ type BusRequestV2 struct {
 // ToParams 将请求参数转换为map[string]string格式
 func (req *BusRequestV2) ToParams() map[string]string {
 	params := make(map[string]string)
-	params["origin"] = req.Origin     // 起点坐标为必填项
-	params["destination"] = req.Destination // 终点坐标为必填项
+	params["origin"] = req.Origin.String()     // 起点坐标为必填项
+	params["destination"] = req.Destination.String() // 终点坐标为必填项
 	if req.City != "" {
 		params["city"] = req.City
 	}
@@ -53,3 +56,25 @@ func (req *BusRequestV2) ToParams() map[string]string {
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 direction.v2.transit）
+func (req *BusRequestV2) Endpoint() string {
+	return "direction.v2.transit"
+}
+
+// Validate 校验必填参数
+func (req *BusRequestV2) Validate() error {
+	if req.Origin.IsZero() {
+		return amapErr.NewInvalidConfigError("公交路径规划v2：origin参数不能为空")
+	}
+	if req.Destination.IsZero() {
+		return amapErr.NewInvalidConfigError("公交路径规划v2：destination参数不能为空")
+	}
+	// 校验经纬度范围
+	for _, p := range []amapType.LngLat{req.Origin, req.Destination} {
+		if err := p.Validate(); err != nil {
+			return amapErr.NewInvalidConfigError(fmt.Sprintf("公交路径规划v2：坐标错误：%v", err))
+		}
+	}
+	return nil
+}
@@ -1,13 +1,16 @@
 package electric
 
 import (
+	"fmt"
+
+	amapErr "github.com/enneket/amap/errors"
 	amapType "github.com/enneket/amap/types"
 )
 
 // ElectricRequestV2 电动车路线规划v2请求参数
 type ElectricRequestV2 struct {
-	Origin          string                  `json:"origin"`                   // 起点坐标（必填，格式：经度,纬度）
-	Destination     string                  `json:"destination"`              // 终点坐标（必填，格式：经度,纬度）
+	Origin          amapType.LngLat         `json:"origin"`                   // 起点坐标（必填）
+	Destination     amapType.LngLat         `json:"destination"`              // 终点坐标（必填）
 	Strategy        string                  `json:"strategy,omitempty"`       // 电动车策略（可选，默认0=最快捷）
 	VehicleType     string                  `json:"vehicle_type,omitempty"`   // 车辆类型（可选，默认0=小型车）
 	DepartureTime   string                  `json:"departure_time,omitempty"` // 出发时间（可选，格式：YYYY-MM-DD HH:mm）
@@ -23,8 +26,8 @@ type ElectricRequestV2 struct {
 // ToParams 将请求参数转换为map[string]string格式
 func (req *ElectricRequestV2) ToParams() map[string]string {
 	params := make(map[string]string)
-	params["origin"] = req.Origin     // 起点坐标为必填项
-	params["destination"] = req.Destination // 终点坐标为必填项
+	params["origin"] = req.Origin.String()     // 起点坐标为必填项
+	params["destination"] = req.Destination.String() // 终点坐标为必填项
 	if req.Strategy != "" {
 		params["strategy"] = req.Strategy
 	}
@@ -57,3 +60,25 @@ func (req *ElectricRequestV2) ToParams() map[string]string {
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 direction.v2.electric）
+func (req *ElectricRequestV2) Endpoint() string {
+	return "direction.v2.electric"
+}
+
+// Validate 校验必填参数
+func (req *ElectricRequestV2) Validate() error {
+	if req.Origin.IsZero() {
+		return amapErr.NewInvalidConfigError("电动车路径规划v2：origin参数不能为空")
+	}
+	if req.Destination.IsZero() {
+		return amapErr.NewInvalidConfigError("电动车路径规划v2：destination参数不能为空")
+	}
+	// 校验经纬度范围
+	for _, p := range []amapType.LngLat{req.Origin, req.Destination} {
+		if err := p.Validate(); err != nil {
+			return amapErr.NewInvalidConfigError(fmt.Sprintf("电动车路径规划v2：坐标错误：%v", err))
+		}
+	}
+	return nil
+}
@@ -1,6 +1,9 @@
 package electric
 
 import (
+	"github.com/enneket/amap/api/direction"
+	"github.com/enneket/amap/geo"
+	"github.com/enneket/amap/geojson"
 	amapType "github.com/enneket/amap/types"
 )
 
@@ -12,52 +15,117 @@ type ElectricResponseV2 struct {
 
 // RouteV2 路线信息
 type RouteV2 struct {
-	Origin      string    `json:"origin"`       // 起点坐标
-	Destination string    `json:"destination"`  // 终点坐标
+	Origin      amapType.FlexString    `json:"origin"`       // 起点坐标
+	Destination amapType.FlexString    `json:"destination"`  // 终点坐标
 	Paths       []PathV2  `json:"paths"`        // 路径列表
-	Distance    string    `json:"distance"`     // 总距离（米）
-	Duration    string    `json:"duration"`     // 总时间（秒）
-	Tolls       string    `json:"tolls"`        // 总费用（元）
+	Distance    amapType.FlexString    `json:"distance"`     // 总距离（米）
+	Duration    amapType.FlexString    `json:"duration"`     // 总时间（秒）
+	Tolls       amapType.FlexString    `json:"tolls"`        // 总费用（元）
 }
 
 // PathV2 路径信息
 type PathV2 struct {
-	Distance     string       `json:"distance"`      // 路径距离（米）
-	Duration     string       `json:"duration"`      // 路径时间（秒）
+	Distance     amapType.FlexString       `json:"distance"`      // 路径距离（米）
+	Duration     amapType.FlexString       `json:"duration"`      // 路径时间（秒）
 	Steps        []StepV2     `json:"steps"`         // 导航路段
-	Polyline     string       `json:"polyline"`      // 路径坐标集合
-	Tolls        string       `json:"tolls"`         // 费用（元）
-	TollDistance string       `json:"toll_distance"` // 收费路段距离（米）
+	Polyline     amapType.FlexString       `json:"polyline"`      // 路径坐标集合
+	Tolls        amapType.FlexString       `json:"tolls"`         // 费用（元）
+	TollDistance amapType.FlexString       `json:"toll_distance"` // 收费路段距离（米）
 	ChargeInfo   ChargeInfoV2 `json:"charge_info"`   // 充电信息
 }
 
 // ChargeInfoV2 充电信息
 type ChargeInfoV2 struct {
-	BatteryUsage   string        `json:"battery_usage"`    // 电量消耗（kWh）
+	BatteryUsage   amapType.FlexString        `json:"battery_usage"`    // 电量消耗（kWh）
 	ChargeStations []ChargeStation `json:"charge_stations"` // 充电站点列表
-	TotalChargeFee string        `json:"total_charge_fee"` // 总充电费用（元）
+	TotalChargeFee amapType.FlexString        `json:"total_charge_fee"` // 总充电费用（元）
 }
 
 // ChargeStation 充电站点信息
 type ChargeStation struct {
-	Id           string  `json:"id"`            // 站点ID
-	Name         string  `json:"name"`          // 站点名称
-	Location     string  `json:"location"`      // 站点坐标
-	Distance     string  `json:"distance"`      // 距离（米）
-	ChargeFee    string  `json:"charge_fee"`    // 充电费用（元）
-	ChargeTime   string  `json:"charge_time"`   // 充电时间（分钟）
-	BatteryAfter string  `json:"battery_after"` // 充电后电量（%）
+	Id           amapType.FlexString  `json:"id"`            // 站点ID
+	Name         amapType.FlexString  `json:"name"`          // 站点名称
+	Location     amapType.FlexString  `json:"location"`      // 站点坐标
+	Distance     amapType.FlexString  `json:"distance"`      // 距离（米）
+	ChargeFee    amapType.FlexString  `json:"charge_fee"`    // 充电费用（元）
+	ChargeTime   amapType.FlexString  `json:"charge_time"`   // 充电时间（分钟）
+	BatteryAfter amapType.FlexString  `json:"battery_after"` // 充电后电量（%）
 }
 
 // StepV2 导航路段信息
 type StepV2 struct {
-	Instruction     string  `json:"instruction"`      // 驾驶指示
-	Orientation     string  `json:"orientation"`      // 方向
-	Road            string  `json:"road"`             // 道路名称
-	Distance        string  `json:"distance"`         // 距离（米）
-	Duration        string  `json:"duration"`         // 时间（秒）
-	Polyline        string  `json:"polyline"`         // 坐标集合
-	Action          string  `json:"action"`           // 主要动作
-	AssistantAction string  `json:"assistant_action"` // 辅助动作
+	Instruction     amapType.FlexString  `json:"instruction"`      // 驾驶指示
+	Orientation     amapType.FlexString  `json:"orientation"`      // 方向
+	Road            amapType.FlexString  `json:"road"`             // 道路名称
+	Distance        amapType.FlexString  `json:"distance"`         // 距离（米）
+	Duration        amapType.FlexString  `json:"duration"`         // 时间（秒）
+	Polyline        amapType.FlexString  `json:"polyline"`         // 坐标集合
+	Action          amapType.FlexString  `json:"action"`           // 主要动作
+	AssistantAction amapType.FlexString  `json:"assistant_action"` // 辅助动作
 	ChargeStation   *ChargeStation `json:"charge_station,omitempty"` // 充电站点（如果有）
 }
+
+// Points 路径坐标（优先使用整条路径的 polyline，未返回时拼接各路段的 polyline）
+func (p *PathV2) Points() (amapType.LngLatList, error) {
+	steps := make([]string, len(p.Steps))
+	for i, step := range p.Steps {
+		steps[i] = step.Polyline.String()
+	}
+	return geo.PathPoints(p.Polyline.String(), steps...)
+}
+
+// ToGeoJSON 导出路径为 GeoJSON 线要素（属性含 distance/duration，单位为米和秒）
+func (p *PathV2) ToGeoJSON(opts ...geojson.Option) (*geojson.Feature, error) {
+	points, err := p.Points()
+	if err != nil {
+		return nil, err
+	}
+	return geojson.RouteFeature(points, p.Distance.String(), p.Duration.String(), opts...), nil
+}
+
+// ToGeoJSON 导出所有路径为 GeoJSON 要素集合（属性 index 为路径序号）
+func (resp *ElectricResponseV2) ToGeoJSON(opts ...geojson.Option) (*geojson.FeatureCollection, error) {
+	fc := geojson.NewFeatureCollection()
+	for i := range resp.Route.Paths {
+		feature, err := resp.Route.Paths[i].ToGeoJSON(opts...)
+		if err != nil {
+			return nil, err
+		}
+		feature.Properties["index"] = i
+		fc.Add(feature)
+	}
+	return fc, nil
+}
+
+// Metrics 数值字段
+func (r *RouteV2) Metrics() direction.Metrics {
+	return direction.Metrics{
+		Distance: r.Distance,
+		Duration: r.Duration,
+		Tolls:    r.Tolls,
+	}
+}
+
+// Metrics 数值字段（实现 direction.Plan）
+func (p *PathV2) Metrics() direction.Metrics {
+	return direction.Metrics{
+		Distance:     p.Distance,
+		Duration:     p.Duration,
+		Tolls:        p.Tolls,
+		TollDistance: p.TollDistance,
+	}
+}
+
+// Metrics 数值字段
+func (s *StepV2) Metrics() direction.Metrics {
+	return direction.Metrics{Distance: s.Distance, Duration: s.Duration}
+}
+
+// Plans 全部路线方案（实现 direction.Route）
+func (resp *ElectricResponseV2) Plans() []direction.Plan {
+	plans := make([]direction.Plan, len(resp.Route.Paths))
+	for i := range resp.Route.Paths {
+		plans[i] = &resp.Route.Paths[i]
+	}
+	return plans
+}
@@ -0,0 +1,55 @@
+package bicycling
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/enneket/amap/api/direction"
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+)
+
+// BicyclingRequestV5 骑行路径规划2.0请求参数
+// 文档：https://lbs.amap.com/api/webservice/guide/api/newroute
+type BicyclingRequestV5 struct {
+	Origin           amapType.LngLat      `json:"origin"`                      // 起点坐标（必填）
+	Destination      amapType.LngLat      `json:"destination"`                 // 终点坐标（必填）
+	AlternativeRoute int                  `json:"alternative_route,omitempty"` // 返回的路线条数（可选，1-3，默认1）
+	ShowFields       direction.ShowFields `json:"show_fields,omitempty"`       // 返回的扩展信息（可选：cost、navi、polyline）
+}
+
+// ToParams 将请求参数转换为map[string]string格式
+func (req *BicyclingRequestV5) ToParams() map[string]string {
+	params := make(map[string]string)
+	params["origin"] = req.Origin.String()           // 起点坐标为必填项
+	params["destination"] = req.Destination.String() // 终点坐标为必填项
+	if req.AlternativeRoute > 0 {
+		params["alternative_route"] = strconv.Itoa(req.AlternativeRoute)
+	}
+	if len(req.ShowFields) > 0 {
+		params["show_fields"] = req.ShowFields.String()
+	}
+	return params
+}
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 direction.v5.bicycling）
+func (req *BicyclingRequestV5) Endpoint() string {
+	return "direction.v5.bicycling"
+}
+
+// Validate 校验必填参数
+func (req *BicyclingRequestV5) Validate() error {
+	if req.Origin.IsZero() {
+		return amapErr.NewInvalidConfigError("骑行路径规划v5：origin参数不能为空")
+	}
+	if req.Destination.IsZero() {
+		return amapErr.NewInvalidConfigError("骑行路径规划v5：destination参数不能为空")
+	}
+	// 校验经纬度范围
+	for _, p := range []amapType.LngLat{req.Origin, req.Destination} {
+		if err := p.Validate(); err != nil {
+			return amapErr.NewInvalidConfigError(fmt.Sprintf("骑行路径规划v5：坐标错误：%v", err))
+		}
+	}
+	return nil
+}
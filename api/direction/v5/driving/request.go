@@ -0,0 +1,106 @@
+package driving
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/enneket/amap/api/direction"
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+)
+
+// MaxWaypoints 驾车路径规划2.0最多途经点数
+const MaxWaypoints = 16
+
+// DrivingRequestV5 驾车路径规划2.0请求参数
+// 文档：https://lbs.amap.com/api/webservice/guide/api/newroute
+type DrivingRequestV5 struct {
+	Origin          amapType.LngLat       `json:"origin"`                     // 起点坐标（必填）
+	Destination     amapType.LngLat       `json:"destination"`                // 终点坐标（必填）
+	OriginID        string                `json:"origin_id,omitempty"`        // 起点POI ID（可选，提升路线规划准确性）
+	DestinationID   string                `json:"destination_id,omitempty"`   // 终点POI ID（可选）
+	DestinationType string                `json:"destination_type,omitempty"` // 终点POI类型（可选）
+	Strategy        string                `json:"strategy,omitempty"`         // 驾车策略（可选，默认32=高德推荐，返回多条备选路线）
+	Waypoints       amapType.LngLatList   `json:"waypoints,omitempty"`        // 途经点（可选，最多16个）
+	AvoidPolygons   []amapType.LngLatList `json:"avoidpolygons,omitempty"`    // 避让区域（可选，每个区域为多边形顶点列表）
+	AvoidRoad       string                `json:"avoidroad,omitempty"`        // 避让道路名称（可选，仅支持一条）
+	Plate           string                `json:"plate,omitempty"`            // 车牌号（可选，用于规避限行，如"京AHA322"）
+	CarType         string                `json:"cartype,omitempty"`          // 车辆类型（可选，0：燃油车，1：纯电动，2：插电混动）
+	Ferry           string                `json:"ferry,omitempty"`            // 是否使用轮渡（可选，0：使用，1：不使用）
+	ShowFields      direction.ShowFields  `json:"show_fields,omitempty"`      // 返回的扩展信息（可选：cost、tmcs、navi、cities、polyline）
+}
+
+// ToParams 将请求参数转换为map[string]string格式
+func (req *DrivingRequestV5) ToParams() map[string]string {
+	params := make(map[string]string)
+	params["origin"] = req.Origin.String()           // 起点坐标为必填项
+	params["destination"] = req.Destination.String() // 终点坐标为必填项
+	if req.OriginID != "" {
+		params["origin_id"] = req.OriginID
+	}
+	if req.DestinationID != "" {
+		params["destination_id"] = req.DestinationID
+	}
+	if req.DestinationType != "" {
+		params["destination_type"] = req.DestinationType
+	}
+	if req.Strategy != "" {
+		params["strategy"] = req.Strategy
+	}
+	if len(req.Waypoints) > 0 {
+		params["waypoints"] = req.Waypoints.Join(";")
+	}
+	if len(req.AvoidPolygons) > 0 {
+		// 区域间用 | 分隔，坐标间用 ; 分隔
+		polygons := make([]string, len(req.AvoidPolygons))
+		for i, polygon := range req.AvoidPolygons {
+			polygons[i] = polygon.Join(";")
+		}
+		params["avoidpolygons"] = strings.Join(polygons, "|")
+	}
+	if req.AvoidRoad != "" {
+		params["avoidroad"] = req.AvoidRoad
+	}
+	if req.Plate != "" {
+		params["plate"] = req.Plate
+	}
+	if req.CarType != "" {
+		params["cartype"] = req.CarType
+	}
+	if req.Ferry != "" {
+		params["ferry"] = req.Ferry
+	}
+	if len(req.ShowFields) > 0 {
+		params["show_fields"] = req.ShowFields.String()
+	}
+	return params
+}
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 direction.v5.driving）
+func (req *DrivingRequestV5) Endpoint() string {
+	return "direction.v5.driving"
+}
+
+// Validate 校验必填参数
+func (req *DrivingRequestV5) Validate() error {
+	if req.Origin.IsZero() {
+		return amapErr.NewInvalidConfigError("驾车路径规划v5：origin参数不能为空")
+	}
+	if req.Destination.IsZero() {
+		return amapErr.NewInvalidConfigError("驾车路径规划v5：destination参数不能为空")
+	}
+	if len(req.Waypoints) > MaxWaypoints {
+		return amapErr.NewInvalidConfigError(fmt.Sprintf("驾车路径规划v5：途经点最多 %d 个", MaxWaypoints))
+	}
+	// 校验经纬度范围
+	points := append([]amapType.LngLat{req.Origin, req.Destination}, req.Waypoints...)
+	for _, polygon := range req.AvoidPolygons {
+		points = append(points, polygon...)
+	}
+	for _, p := range points {
+		if err := p.Validate(); err != nil {
+			return amapErr.NewInvalidConfigError(fmt.Sprintf("驾车路径规划v5：坐标错误：%v", err))
+		}
+	}
+	return nil
+}
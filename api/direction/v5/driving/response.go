@@ -0,0 +1,143 @@
+package driving
+
+import (
+	"github.com/enneket/amap/api/direction"
+	"github.com/enneket/amap/geo"
+	"github.com/enneket/amap/geojson"
+	amapType "github.com/enneket/amap/types"
+)
+
+// DrivingResponseV5 驾车路径规划2.0响应
+// 文档：https://lbs.amap.com/api/webservice/guide/api/newroute
+type DrivingResponseV5 struct {
+	amapType.BaseResponse                     // 继承基础响应（Status/Info/InfoCode）
+	Count                 amapType.FlexString `json:"count"` // 路径方案个数
+	Route                 RouteV5             `json:"route"` // 路线信息
+}
+
+// RouteV5 路线信息
+type RouteV5 struct {
+	Origin      amapType.FlexString `json:"origin"`      // 起点坐标
+	Destination amapType.FlexString `json:"destination"` // 终点坐标
+	TaxiCost    amapType.FlexString `json:"taxi_cost"`   // 预计打车费用（元）
+	Paths       []PathV5            `json:"paths"`       // 路径方案（含备选路线）
+}
+
+// PathV5 路径方案
+type PathV5 struct {
+	Distance    amapType.FlexString `json:"distance"`    // 方案距离（米）
+	Restriction amapType.FlexString `json:"restriction"` // 限行结果（0：限行已规避或未限行，1：限行无法规避）
+	Cost        CostV5              `json:"cost"`        // 费用信息（show_fields 含 cost 时返回）
+	Steps       []StepV5            `json:"steps"`       // 导航路段
+}
+
+// CostV5 费用信息（show_fields 含 cost 时返回）
+type CostV5 struct {
+	Duration      amapType.FlexString `json:"duration"`       // 耗时（秒）
+	Tolls         amapType.FlexString `json:"tolls"`          // 过路费（元）
+	TollDistance  amapType.FlexString `json:"toll_distance"`  // 收费路段距离（米）
+	TollRoad      amapType.FlexString `json:"toll_road"`      // 主要收费道路
+	TrafficLights amapType.FlexString `json:"traffic_lights"` // 红绿灯个数
+}
+
+// StepV5 导航路段
+type StepV5 struct {
+	Instruction  amapType.FlexString `json:"instruction"`      // 行驶指示
+	Orientation  amapType.FlexString `json:"orientation"`      // 进入道路方向
+	RoadName     amapType.FlexString `json:"road_name"`        // 道路名称
+	StepDistance amapType.FlexString `json:"step_distance"`    // 路段距离（米）
+	Cost         CostV5              `json:"cost"`             // 费用信息（show_fields 含 cost 时返回）
+	Tmcs         []TmcV5             `json:"tmcs,omitempty"`   // 路况信息（show_fields 含 tmcs 时返回）
+	Navi         NaviV5              `json:"navi"`             // 导航动作（show_fields 含 navi 时返回）
+	Cities       []CityV5            `json:"cities,omitempty"` // 途经城市（show_fields 含 cities 时返回）
+	Polyline     amapType.FlexString `json:"polyline"`         // 路段坐标（show_fields 含 polyline 时返回）
+}
+
+// TmcV5 路况信息
+type TmcV5 struct {
+	TmcStatus   amapType.FlexString `json:"tmc_status"`   // 路况状态（未知、畅通、缓行、拥堵、严重拥堵）
+	TmcDistance amapType.FlexString `json:"tmc_distance"` // 该路况的距离（米）
+	TmcPolyline amapType.FlexString `json:"tmc_polyline"` // 该路况的坐标
+}
+
+// NaviV5 导航动作
+type NaviV5 struct {
+	Action          amapType.FlexString `json:"action"`           // 主要动作
+	AssistantAction amapType.FlexString `json:"assistant_action"` // 辅助动作
+}
+
+// CityV5 途经城市
+type CityV5 struct {
+	Adcode    amapType.FlexString `json:"adcode"`    // 城市行政区划编码
+	Citycode  amapType.FlexString `json:"citycode"`  // 城市编码
+	City      amapType.FlexString `json:"city"`      // 城市名称
+	Districts []DistrictV5        `json:"districts"` // 途经区县
+}
+
+// DistrictV5 途经区县
+type DistrictV5 struct {
+	Name   amapType.FlexString `json:"name"`   // 区县名称
+	Adcode amapType.FlexString `json:"adcode"` // 区县行政区划编码
+}
+
+// Metrics 数值字段
+func (r *RouteV5) Metrics() direction.Metrics {
+	return direction.Metrics{TaxiCost: r.TaxiCost}
+}
+
+// Metrics 数值字段（实现 direction.Plan，耗时、费用在 show_fields 含 cost 时返回）
+func (p *PathV5) Metrics() direction.Metrics {
+	return direction.Metrics{
+		Distance:      p.Distance,
+		Duration:      p.Cost.Duration,
+		Tolls:         p.Cost.Tolls,
+		TollDistance:  p.Cost.TollDistance,
+		TrafficLights: p.Cost.TrafficLights,
+	}
+}
+
+// Metrics 数值字段（耗时在 show_fields 含 cost 时返回）
+func (s *StepV5) Metrics() direction.Metrics {
+	return direction.Metrics{Distance: s.StepDistance, Duration: s.Cost.Duration}
+}
+
+// Points 路径坐标（拼接各路段的 polyline，show_fields 含 polyline 时返回）
+func (p *PathV5) Points() (amapType.LngLatList, error) {
+	steps := make([]string, len(p.Steps))
+	for i, step := range p.Steps {
+		steps[i] = step.Polyline.String()
+	}
+	return geo.PathPoints("", steps...)
+}
+
+// ToGeoJSON 导出路径为 GeoJSON 线要素（属性含 distance/duration，单位为米和秒）
+func (p *PathV5) ToGeoJSON(opts ...geojson.Option) (*geojson.Feature, error) {
+	points, err := p.Points()
+	if err != nil {
+		return nil, err
+	}
+	return geojson.RouteFeature(points, p.Distance.String(), p.Cost.Duration.String(), opts...), nil
+}
+
+// Plans 全部路线方案（实现 direction.Route）
+func (resp *DrivingResponseV5) Plans() []direction.Plan {
+	plans := make([]direction.Plan, len(resp.Route.Paths))
+	for i := range resp.Route.Paths {
+		plans[i] = &resp.Route.Paths[i]
+	}
+	return plans
+}
+
+// ToGeoJSON 导出所有路径为 GeoJSON 要素集合（属性 index 为路径序号）
+func (resp *DrivingResponseV5) ToGeoJSON(opts ...geojson.Option) (*geojson.FeatureCollection, error) {
+	fc := geojson.NewFeatureCollection()
+	for i := range resp.Route.Paths {
+		feature, err := resp.Route.Paths[i].ToGeoJSON(opts...)
+		if err != nil {
+			return nil, err
+		}
+		feature.Properties["index"] = i
+		fc.Add(feature)
+	}
+	return fc, nil
+}
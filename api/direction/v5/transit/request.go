@@ -0,0 +1,98 @@
+package transit
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/enneket/amap/api/direction"
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+)
+
+// TransitRequestV5 公交路径规划2.0请求参数
+// 文档：https://lbs.amap.com/api/webservice/guide/api/newroute
+type TransitRequestV5 struct {
+	Origin           amapType.LngLat      `json:"origin"`                     // 起点坐标（必填）
+	Destination      amapType.LngLat      `json:"destination"`                // 终点坐标（必填）
+	City1            string               `json:"city1"`                      // 起点所在城市的citycode（必填）
+	City2            string               `json:"city2"`                      // 终点所在城市的citycode（必填，跨城时与 City1 不同）
+	OriginPOI        string               `json:"originpoi,omitempty"`        // 起点POI ID（可选）
+	DestinationPOI   string               `json:"destinationpoi,omitempty"`   // 终点POI ID（可选）
+	Ad1              string               `json:"ad1,omitempty"`              // 起点所在区县的adcode（可选）
+	Ad2              string               `json:"ad2,omitempty"`              // 终点所在区县的adcode（可选）
+	Strategy         string               `json:"strategy,omitempty"`         // 换乘策略（可选，0：推荐，1：最经济，2：最少换乘，3：最少步行，默认0）
+	AlternativeRoute int                  `json:"AlternativeRoute,omitempty"` // 返回的方案条数（可选，1-10，默认5）
+	MultiExport      string               `json:"multiexport,omitempty"`      // 地铁出入口是否返回多个（可选，0：只返回一个，1：返回全部）
+	NightFlag        string               `json:"nightflag,omitempty"`        // 是否考虑夜班车（可选，0：不考虑，1：考虑）
+	Date             string               `json:"date,omitempty"`             // 出发日期（可选，格式：2024-01-01）
+	Time             string               `json:"time,omitempty"`             // 出发时间（可选，格式：9-54）
+	ShowFields       direction.ShowFields `json:"show_fields,omitempty"`      // 返回的扩展信息（可选：cost、polyline）
+}
+
+// ToParams 将请求参数转换为map[string]string格式
+func (req *TransitRequestV5) ToParams() map[string]string {
+	params := make(map[string]string)
+	params["origin"] = req.Origin.String()           // 起点坐标为必填项
+	params["destination"] = req.Destination.String() // 终点坐标为必填项
+	params["city1"] = req.City1                      // 起点城市为必填项
+	params["city2"] = req.City2                      // 终点城市为必填项
+	if req.OriginPOI != "" {
+		params["originpoi"] = req.OriginPOI
+	}
+	if req.DestinationPOI != "" {
+		params["destinationpoi"] = req.DestinationPOI
+	}
+	if req.Ad1 != "" {
+		params["ad1"] = req.Ad1
+	}
+	if req.Ad2 != "" {
+		params["ad2"] = req.Ad2
+	}
+	if req.Strategy != "" {
+		params["strategy"] = req.Strategy
+	}
+	if req.AlternativeRoute > 0 {
+		params["AlternativeRoute"] = strconv.Itoa(req.AlternativeRoute)
+	}
+	if req.MultiExport != "" {
+		params["multiexport"] = req.MultiExport
+	}
+	if req.NightFlag != "" {
+		params["nightflag"] = req.NightFlag
+	}
+	if req.Date != "" {
+		params["date"] = req.Date
+	}
+	if req.Time != "" {
+		params["time"] = req.Time
+	}
+	if len(req.ShowFields) > 0 {
+		params["show_fields"] = req.ShowFields.String()
+	}
+	return params
+}
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 direction.v5.transit）
+func (req *TransitRequestV5) Endpoint() string {
+	return "direction.v5.transit"
+}
+
+// Validate 校验必填参数
+func (req *TransitRequestV5) Validate() error {
+	if req.Origin.IsZero() {
+		return amapErr.NewInvalidConfigError("公交路径规划v5：origin参数不能为空")
+	}
+	if req.Destination.IsZero() {
+		return amapErr.NewInvalidConfigError("公交路径规划v5：destination参数不能为空")
+	}
+	if req.City1 == "" || req.City2 == "" {
+		return amapErr.NewInvalidConfigError("公交路径规划v5：city1和city2参数不能为空")
+	}
+	// 校验经纬度范围
+	for _, p := range []amapType.LngLat{req.Origin, req.Destination} {
+		if err := p.Validate(); err != nil {
+			return amapErr.NewInvalidConfigError(fmt.Sprintf("公交路径规划v5：坐标错误：%v", err))
+		}
+	}
+	return nil
+}
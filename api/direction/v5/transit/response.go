@@ -0,0 +1,219 @@
+package transit
+
+import (
+	"github.com/enneket/amap/api/direction"
+	"github.com/enneket/amap/geo"
+	"github.com/enneket/amap/geojson"
+	amapType "github.com/enneket/amap/types"
+)
+
+// TransitResponseV5 公交路径规划2.0响应
+// 文档：https://lbs.amap.com/api/webservice/guide/api/newroute
+type TransitResponseV5 struct {
+	amapType.BaseResponse                     // 继承基础响应（Status/Info/InfoCode）
+	Count                 amapType.FlexString `json:"count"` // 换乘方案个数
+	Route                 RouteV5             `json:"route"` // 路线信息
+}
+
+// RouteV5 路线信息
+type RouteV5 struct {
+	Origin      amapType.FlexString `json:"origin"`      // 起点坐标
+	Destination amapType.FlexString `json:"destination"` // 终点坐标
+	Distance    amapType.FlexString `json:"distance"`    // 起终点步行距离（米）
+	Cost        RouteCostV5         `json:"cost"`        // 费用信息
+	Transits    []TransitV5         `json:"transits"`    // 换乘方案
+}
+
+// RouteCostV5 路线费用信息
+type RouteCostV5 struct {
+	TaxiCost amapType.FlexString `json:"taxi_cost"` // 预计打车费用（元）
+}
+
+// TransitV5 换乘方案
+type TransitV5 struct {
+	Cost            TransitCostV5       `json:"cost"`             // 费用信息（show_fields 含 cost 时返回）
+	Distance        amapType.FlexString `json:"distance"`         // 方案距离（米）
+	WalkingDistance amapType.FlexString `json:"walking_distance"` // 步行距离（米）
+	NightFlag       amapType.FlexString `json:"nightflag"`        // 是否夜班车（0：否，1：是）
+	Segments        []SegmentV5         `json:"segments"`         // 换乘路段（按顺序）
+}
+
+// TransitCostV5 换乘方案费用信息
+type TransitCostV5 struct {
+	Duration   amapType.FlexString `json:"duration"`    // 耗时（秒）
+	TransitFee amapType.FlexString `json:"transit_fee"` // 公交票价（元）
+	TaxiFee    amapType.FlexString `json:"taxi_fee"`    // 打车费用（元，含打车路段时返回）
+}
+
+// SegmentV5 换乘路段（步行、公交地铁、火车、打车按需返回）
+type SegmentV5 struct {
+	Walking *WalkingV5 `json:"walking,omitempty"` // 步行
+	Bus     *BusV5     `json:"bus,omitempty"`     // 公交、地铁
+	Railway *RailwayV5 `json:"railway,omitempty"` // 火车
+	Taxi    *TaxiV5    `json:"taxi,omitempty"`    // 打车
+}
+
+// CostV5 耗时信息
+type CostV5 struct {
+	Duration amapType.FlexString `json:"duration"` // 耗时（秒）
+}
+
+// PolylineV5 坐标串（v5 公交接口以对象返回）
+type PolylineV5 struct {
+	Polyline amapType.FlexString `json:"polyline"` // 坐标串
+}
+
+// WalkingV5 步行路段
+type WalkingV5 struct {
+	Origin      amapType.FlexString `json:"origin"`      // 起点坐标
+	Destination amapType.FlexString `json:"destination"` // 终点坐标
+	Distance    amapType.FlexString `json:"distance"`    // 步行距离（米）
+	Cost        CostV5              `json:"cost"`        // 耗时信息
+	Steps       []WalkingStepV5     `json:"steps"`       // 步行导航路段
+}
+
+// WalkingStepV5 步行导航路段
+type WalkingStepV5 struct {
+	Instruction amapType.FlexString `json:"instruction"` // 步行指示
+	Road        amapType.FlexString `json:"road"`        // 道路名称
+	Distance    amapType.FlexString `json:"distance"`    // 距离（米）
+	Navi        NaviV5              `json:"navi"`        // 导航动作
+	Polyline    PolylineV5          `json:"polyline"`    // 坐标（show_fields 含 polyline 时返回）
+}
+
+// NaviV5 导航动作
+type NaviV5 struct {
+	Action          amapType.FlexString `json:"action"`           // 主要动作
+	AssistantAction amapType.FlexString `json:"assistant_action"` // 辅助动作
+	WalkType        amapType.FlexString `json:"walk_type"`        // 道路类型
+}
+
+// BusV5 公交、地铁路段
+type BusV5 struct {
+	Buslines []BuslineV5 `json:"buslines"` // 可选的线路（第一条为推荐线路）
+}
+
+// BuslineV5 公交、地铁线路
+type BuslineV5 struct {
+	DepartureStop StopV5              `json:"departure_stop"` // 上车站
+	ArrivalStop   StopV5              `json:"arrival_stop"`   // 下车站
+	Name          amapType.FlexString `json:"name"`           // 线路名称
+	ID            amapType.FlexString `json:"id"`             // 线路ID
+	Type          amapType.FlexString `json:"type"`           // 线路类型（如普通公交线路、地铁线路）
+	Distance      amapType.FlexString `json:"distance"`       // 乘坐距离（米）
+	Cost          CostV5              `json:"cost"`           // 耗时信息
+	Polyline      PolylineV5          `json:"polyline"`       // 线路坐标（show_fields 含 polyline 时返回）
+	StartTime     amapType.FlexString `json:"start_time"`     // 首班车时间
+	EndTime       amapType.FlexString `json:"end_time"`       // 末班车时间
+	ViaNum        amapType.FlexString `json:"via_num"`        // 途经站数
+	ViaStops      []StopV5            `json:"via_stops"`      // 途经站
+}
+
+// StopV5 公交、地铁站点
+type StopV5 struct {
+	Name     amapType.FlexString `json:"name"`     // 站点名称
+	ID       amapType.FlexString `json:"id"`       // 站点ID
+	Location amapType.FlexString `json:"location"` // 站点坐标
+}
+
+// RailwayV5 火车路段
+type RailwayV5 struct {
+	ID            amapType.FlexString `json:"id"`             // 线路ID
+	Time          amapType.FlexString `json:"time"`           // 运行时长（秒）
+	Name          amapType.FlexString `json:"name"`           // 线路名称
+	Trip          amapType.FlexString `json:"trip"`           // 车次
+	Distance      amapType.FlexString `json:"distance"`       // 距离（米）
+	Type          amapType.FlexString `json:"type"`           // 线路类型
+	DepartureStop RailwayStopV5       `json:"departure_stop"` // 出发站
+	ArrivalStop   RailwayStopV5       `json:"arrival_stop"`   // 到达站
+}
+
+// RailwayStopV5 火车站点
+type RailwayStopV5 struct {
+	ID       amapType.FlexString `json:"id"`       // 站点ID
+	Name     amapType.FlexString `json:"name"`     // 站点名称
+	Location amapType.FlexString `json:"location"` // 站点坐标
+	Adcode   amapType.FlexString `json:"adcode"`   // 站点所在城市的adcode
+	Time     amapType.FlexString `json:"time"`     // 出发/到达时间
+}
+
+// TaxiV5 打车路段
+type TaxiV5 struct {
+	Price      amapType.FlexString `json:"price"`      // 预计费用（元）
+	DriveTime  amapType.FlexString `json:"drivetime"`  // 预计耗时（秒）
+	Distance   amapType.FlexString `json:"distance"`   // 距离（米）
+	Polyline   amapType.FlexString `json:"polyline"`   // 线路坐标
+	StartPoint amapType.FlexString `json:"startpoint"` // 起点坐标
+	StartName  amapType.FlexString `json:"startname"`  // 起点名称
+	EndPoint   amapType.FlexString `json:"endpoint"`   // 终点坐标
+	EndName    amapType.FlexString `json:"endname"`    // 终点名称
+}
+
+// Metrics 数值字段
+func (r *RouteV5) Metrics() direction.Metrics {
+	return direction.Metrics{TaxiCost: r.Cost.TaxiCost}
+}
+
+// Metrics 数值字段（实现 direction.Plan，耗时、费用在 show_fields 含 cost 时返回）
+func (t *TransitV5) Metrics() direction.Metrics {
+	return direction.Metrics{
+		Distance:        t.Distance,
+		Duration:        t.Cost.Duration,
+		Fare:            t.Cost.TransitFee,
+		WalkingDistance: t.WalkingDistance,
+	}
+}
+
+// Points 换乘方案坐标（按顺序拼接步行、推荐线路、火车起终站和打车路段的坐标，show_fields 含 polyline 时返回）
+func (t *TransitV5) Points() (amapType.LngLatList, error) {
+	var parts []string
+	for _, segment := range t.Segments {
+		if segment.Walking != nil {
+			for _, step := range segment.Walking.Steps {
+				parts = append(parts, step.Polyline.Polyline.String())
+			}
+		}
+		if segment.Bus != nil && len(segment.Bus.Buslines) > 0 {
+			parts = append(parts, segment.Bus.Buslines[0].Polyline.Polyline.String())
+		}
+		if segment.Railway != nil && segment.Railway.DepartureStop.Location != "" && segment.Railway.ArrivalStop.Location != "" {
+			parts = append(parts, segment.Railway.DepartureStop.Location.String()+";"+segment.Railway.ArrivalStop.Location.String())
+		}
+		if segment.Taxi != nil {
+			parts = append(parts, segment.Taxi.Polyline.String())
+		}
+	}
+	return geo.PathPoints("", parts...)
+}
+
+// ToGeoJSON 导出换乘方案为 GeoJSON 线要素（属性含 distance/duration，单位为米和秒）
+func (t *TransitV5) ToGeoJSON(opts ...geojson.Option) (*geojson.Feature, error) {
+	points, err := t.Points()
+	if err != nil {
+		return nil, err
+	}
+	return geojson.RouteFeature(points, t.Distance.String(), t.Cost.Duration.String(), opts...), nil
+}
+
+// Plans 全部换乘方案（实现 direction.Route）
+func (resp *TransitResponseV5) Plans() []direction.Plan {
+	plans := make([]direction.Plan, len(resp.Route.Transits))
+	for i := range resp.Route.Transits {
+		plans[i] = &resp.Route.Transits[i]
+	}
+	return plans
+}
+
+// ToGeoJSON 导出所有换乘方案为 GeoJSON 要素集合（属性 index 为方案序号）
+func (resp *TransitResponseV5) ToGeoJSON(opts ...geojson.Option) (*geojson.FeatureCollection, error) {
+	fc := geojson.NewFeatureCollection()
+	for i := range resp.Route.Transits {
+		feature, err := resp.Route.Transits[i].ToGeoJSON(opts...)
+		if err != nil {
+			return nil, err
+		}
+		feature.Properties["index"] = i
+		fc.Add(feature)
+	}
+	return fc, nil
+}
@@ -1,17 +1,22 @@
 package distance
 
 import (
+	"fmt"
 	"strconv"
 
+	amapErr "github.com/enneket/amap/errors"
 	amapType "github.com/enneket/amap/types"
 )
 
+// MaxOrigins 距离测量单次请求的最大起点数
+const MaxOrigins = 100
+
 // DistanceRequest 距离测量请求参数
 // 文档：https://lbs.amap.com/api/webservice/guide/api/direction/#distance
 
 type DistanceRequest struct {
-	Origins        string                  `json:"origins"`                   // 起点坐标列表（必填），格式："经度1,纬度1|经度2,纬度2|..."，最多支持100个起点
-	Destination    string                  `json:"destination"`               // 终点坐标列表（必填），格式："经度1,纬度1|经度2,纬度2|..."，最多支持100个终点
+	Origins        amapType.LngLatList     `json:"origins"`                   // 起点坐标列表（必填，以 | 分隔发送），最多支持100个起点
+	Destination    amapType.LngLat         `json:"destination"`               // 终点坐标（必填）
 	Type           int                     `json:"type,omitempty"`            // 计算方式（可选，默认1）：1-直线距离，2-驾车导航距离，3-公交规划距离，4-步行规划距离
 	CoordinateType amapType.CoordinateType `json:"coordinate_type,omitempty"` // 输入/输出坐标系（可选，默认gcj02，支持wgs84/bd09ll）
 	Output         amapType.OutputType     `json:"output,omitempty"`          // 输出格式（可选，默认JSON）
@@ -23,8 +28,8 @@ type DistanceRequest struct {
 // ToParams 将请求参数转换为map[string]string格式
 func (req *DistanceRequest) ToParams() map[string]string {
 	params := make(map[string]string)
-	params["origins"] = req.Origins         // 起点为必填项
-	params["destination"] = req.Destination // 终点为必填项
+	params["origins"] = req.Origins.Join("|")        // 起点为必填项
+	params["destination"] = req.Destination.String() // 终点为必填项
 
 	if req.Type != 0 {
 		params["type"] = strconv.Itoa(req.Type)
@@ -52,3 +57,28 @@ func (req *DistanceRequest) ToParams() map[string]string {
 
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 distance）
+func (req *DistanceRequest) Endpoint() string {
+	return "distance"
+}
+
+// Validate 校验必填参数
+func (req *DistanceRequest) Validate() error {
+	if len(req.Origins) == 0 {
+		return amapErr.NewInvalidConfigError("距离测量：origins参数不能为空")
+	}
+	if len(req.Origins) > MaxOrigins {
+		return amapErr.NewInvalidConfigError(fmt.Sprintf("距离测量：起点最多 %d 个", MaxOrigins))
+	}
+	if req.Destination.IsZero() {
+		return amapErr.NewInvalidConfigError("距离测量：destination参数不能为空")
+	}
+	// 校验经纬度范围
+	for _, p := range append(amapType.LngLatList{req.Destination}, req.Origins...) {
+		if err := p.Validate(); err != nil {
+			return amapErr.NewInvalidConfigError(fmt.Sprintf("距离测量：坐标错误：%v", err))
+		}
+	}
+	return nil
+}
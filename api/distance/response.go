@@ -14,10 +14,10 @@ type DistanceResponse struct {
 
 // DistanceResult 距离测量结果项
 type DistanceResult struct {
-	OriginId      string `json:"origin_id"` // 起点ID（当传入originid时返回）
-	DestinationId string `json:"dest_id"`   // 终点ID（当传入destid时返回）
-	Distance      string `json:"distance"`  // 距离（单位：米）
-	Duration      string `json:"duration"`  // 预计时间（单位：秒，仅当type为2/3/4时返回）
-	Info          string `json:"info"`      // 状态信息（如"OK"表示成功）
-	Status        string `json:"status"`    // 状态码（如"1"表示成功）
+	OriginId      amapType.FlexString `json:"origin_id"` // 起点ID（当传入originid时返回）
+	DestinationId amapType.FlexString `json:"dest_id"`   // 终点ID（当传入destid时返回）
+	Distance      amapType.FlexString `json:"distance"`  // 距离（单位：米）
+	Duration      amapType.FlexString `json:"duration"`  // 预计时间（单位：秒，仅当type为2/3/4时返回）
+	Info          amapType.FlexString `json:"info"`      // 状态信息（如"OK"表示成功）
+	Status        amapType.FlexString `json:"status"`    // 状态码（如"1"表示成功）
 }
@@ -1,6 +1,7 @@
 package district
 
 import (
+	amapErr "github.com/enneket/amap/errors"
 	amapType "github.com/enneket/amap/types"
 )
 
@@ -55,3 +56,16 @@ func (req *DistrictRequest) ToParams() map[string]string {
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 district）
+func (req *DistrictRequest) Endpoint() string {
+	return "district"
+}
+
+// Validate 校验必填参数
+func (req *DistrictRequest) Validate() error {
+	if req.Keywords == "" {
+		return amapErr.NewInvalidConfigError("行政区查询：keywords参数不能为空")
+	}
+	return nil
+}
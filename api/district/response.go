@@ -1,6 +1,8 @@
 package district
 
 import (
+	"github.com/enneket/amap/geo"
+	"github.com/enneket/amap/geojson"
 	amapType "github.com/enneket/amap/types"
 )
 
@@ -10,7 +12,7 @@ import (
 
 type DistrictResponse struct {
 	amapType.BaseResponse           // 继承基础响应（Status/Info/InfoCode）
-	Count       string            `json:"count"`       // 匹配的行政区数量
+	Count       amapType.FlexString            `json:"count"`       // 匹配的行政区数量
 	Districts   []DistrictItem    `json:"districts"`   // 行政区列表
 	Suggestion  *SuggestionItem   `json:"suggestion,omitempty"` // 建议词列表（可选）
 }
@@ -19,15 +21,15 @@ type DistrictResponse struct {
 // 支持嵌套返回子级行政区
 
 type DistrictItem struct {
-	Name        string          `json:"name"`        // 行政区名称（如"北京市"）
-	Level       string          `json:"level"`       // 行政区级别（country/province/city/district/street/town）
-	Adcode      string          `json:"adcode"`      // 行政区划编码
-	Citycode    string          `json:"citycode"`    // 城市编码（仅城市和区县级别有值）
-	Center      string          `json:"center"`      // 行政区中心点坐标（经度,纬度）
-	Polyline    string          `json:"polyline"`    // 行政区边界坐标（仅extensions=all时返回）
+	Name        amapType.FlexString          `json:"name"`        // 行政区名称（如"北京市"）
+	Level       amapType.FlexString          `json:"level"`       // 行政区级别（country/province/city/district/street/town）
+	Adcode      amapType.FlexString          `json:"adcode"`      // 行政区划编码
+	Citycode    amapType.FlexString          `json:"citycode"`    // 城市编码（仅城市和区县级别有值）
+	Center      amapType.FlexString          `json:"center"`      // 行政区中心点坐标（经度,纬度）
+	Polyline    amapType.FlexString          `json:"polyline"`    // 行政区边界坐标（仅extensions=all时返回）
 	Districts   []DistrictItem  `json:"districts"`   // 子级行政区列表（根据subdistrict参数决定返回级别）
-	ParentCity  []string        `json:"parent_city"`  // 父级城市（仅区县级别有值）
-	BusinessAreas []string      `json:"business_areas,omitempty"` // 商圈信息（仅城市级别有值）
+	ParentCity  amapType.FlexStringList        `json:"parent_city"`  // 父级城市（仅区县级别有值）
+	BusinessAreas amapType.FlexStringList      `json:"business_areas,omitempty"` // 商圈信息（仅城市级别有值）
 	Regions     []RegionItem    `json:"regions,omitempty"` // 区域列表（扩展信息）
 }
 
@@ -35,16 +37,63 @@ type DistrictItem struct {
 // 当关键字匹配不准确时返回建议词
 
 type SuggestionItem struct {
-	Keywords    []string        `json:"keywords"`    // 建议关键字列表
-	Cities      []string        `json:"cities"`      // 建议城市列表
+	Keywords    amapType.FlexStringList        `json:"keywords"`    // 建议关键字列表
+	Cities      amapType.FlexStringList        `json:"cities"`      // 建议城市列表
 }
 
 // RegionItem 区域信息
 // 扩展字段，包含区域的详细信息
 
 type RegionItem struct {
-	Center      string          `json:"center"`      // 区域中心点坐标
-	Name        string          `json:"name"`        // 区域名称
-	Level       string          `json:"level"`       // 区域级别
-	Polyline    string          `json:"polyline"`    // 区域边界坐标
+	Center      amapType.FlexString          `json:"center"`      // 区域中心点坐标
+	Name        amapType.FlexString          `json:"name"`        // 区域名称
+	Level       amapType.FlexString          `json:"level"`       // 区域级别
+	Polyline    amapType.FlexString          `json:"polyline"`    // 区域边界坐标
+}
+
+// LngLat 解析行政区中心点坐标（未返回坐标或格式错误时返回错误）
+func (item *DistrictItem) LngLat() (amapType.LngLat, error) {
+	return amapType.ParseLngLat(item.Center.String())
+}
+
+// Boundary 解析行政区边界（需 extensions=all，各区域以 | 分隔）
+func (item *DistrictItem) Boundary() (geo.MultiPolygon, error) {
+	return geo.DecodeMultiPolygon(item.Polyline.String())
+}
+
+// ToGeoJSON 导出行政区为 GeoJSON 要素：返回边界时（extensions=all）为 MultiPolygon，否则为中心点
+// 属性含 name/level/adcode/citycode/center
+func (item *DistrictItem) ToGeoJSON(opts ...geojson.Option) (*geojson.Feature, error) {
+	props := geojson.Properties{
+		"name":     item.Name,
+		"level":    item.Level,
+		"adcode":   item.Adcode,
+		"citycode": item.Citycode,
+		"center":   item.Center,
+	}
+	boundary, err := item.Boundary()
+	if err != nil {
+		return nil, err
+	}
+	if len(boundary) > 0 {
+		return geojson.NewFeature(geojson.MultiPolygon(boundary, opts...), props), nil
+	}
+	center, err := item.LngLat()
+	if err != nil {
+		return nil, err
+	}
+	return geojson.NewFeature(geojson.Point(center, opts...), props), nil
+}
+
+// ToGeoJSON 导出匹配的行政区为 GeoJSON 要素集合（不含子级行政区）
+func (resp *DistrictResponse) ToGeoJSON(opts ...geojson.Option) (*geojson.FeatureCollection, error) {
+	fc := geojson.NewFeatureCollection()
+	for i := range resp.Districts {
+		feature, err := resp.Districts[i].ToGeoJSON(opts...)
+		if err != nil {
+			return nil, err
+		}
+		fc.Add(feature)
+	}
+	return fc, nil
 }
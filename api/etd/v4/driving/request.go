@@ -1,20 +1,23 @@
 package driving
 
 import (
+	"fmt"
+
+	amapErr "github.com/enneket/amap/errors"
 	amapType "github.com/enneket/amap/types"
 )
 
 // ETDDrivingRequestV4 未来驾车路径规划v4请求参数
 type ETDDrivingRequestV4 struct {
-	Origin          string                  `json:"origin"`                   // 起点坐标（必填，格式：经度,纬度）
-	Destination     string                  `json:"destination"`              // 终点坐标（必填，格式：经度,纬度）
+	Origin          amapType.LngLat         `json:"origin"`                   // 起点坐标（必填）
+	Destination     amapType.LngLat         `json:"destination"`              // 终点坐标（必填）
 	DepartureTime   string                  `json:"departure_time"`           // 出发时间（必填，格式：YYYY-MM-DD HH:mm）
 	Strategy        string                  `json:"strategy,omitempty"`       // 驾车策略（可选，默认0=最快捷）
-	Waypoints       string                  `json:"waypoints,omitempty"`      // 途经点（可选，格式：lng1,lat1|lng2,lat2）
+	Waypoints       amapType.LngLatList     `json:"waypoints,omitempty"`      // 途经点（可选，以 | 分隔发送）
 	VehicleType     string                  `json:"vehicle_type,omitempty"`   // 车辆类型（可选，默认0=小型车）
 	PlateNumber     string                  `json:"plate_number,omitempty"`   // 车牌号（可选，用于规避限行）
 	AvoidRoad       string                  `json:"avoid_road,omitempty"`     // 避让道路（可选，格式：道路ID1|道路ID2）
-	AvoidArea       string                  `json:"avoid_area,omitempty"`     // 避让区域（可选，格式：lng1,lat1,lng2,lat2）
+	AvoidArea       amapType.Bounds         `json:"avoid_area,omitempty"`     // 避让区域（可选，以"左下经度,左下纬度,右上经度,右上纬度"格式发送）
 	CoordinateType  amapType.CoordinateType `json:"coordinate_type,omitempty"` // 输入/输出坐标系（可选，默认gcj02）
 	Output          amapType.OutputType     `json:"output,omitempty"`          // 输出格式（可选，默认JSON）
 	Language        amapType.LanguageType   `json:"language,omitempty"`        // 语言（可选，默认中文）
@@ -24,14 +27,14 @@ type ETDDrivingRequestV4 struct {
 // ToParams 将请求参数转换为map[string]string格式
 func (req *ETDDrivingRequestV4) ToParams() map[string]string {
 	params := make(map[string]string)
-	params["origin"] = req.Origin     // 起点坐标为必填项
-	params["destination"] = req.Destination // 终点坐标为必填项
+	params["origin"] = req.Origin.String()     // 起点坐标为必填项
+	params["destination"] = req.Destination.String() // 终点坐标为必填项
 	params["departure_time"] = req.DepartureTime // 出发时间为必填项
 	if req.Strategy != "" {
 		params["strategy"] = req.Strategy
 	}
-	if req.Waypoints != "" {
-		params["waypoints"] = req.Waypoints
+	if len(req.Waypoints) > 0 {
+		params["waypoints"] = req.Waypoints.Join("|")
 	}
 	if req.VehicleType != "" {
 		params["vehicle_type"] = req.VehicleType
@@ -42,8 +45,8 @@ func (req *ETDDrivingRequestV4) ToParams() map[string]string {
 	if req.AvoidRoad != "" {
 		params["avoid_road"] = req.AvoidRoad
 	}
-	if req.AvoidArea != "" {
-		params["avoid_area"] = req.AvoidArea
+	if !req.AvoidArea.IsZero() {
+		params["avoid_area"] = req.AvoidArea.Join(",")
 	}
 	if req.CoordinateType != "" {
 		params["coordinate_type"] = string(req.CoordinateType)
@@ -59,3 +62,33 @@ func (req *ETDDrivingRequestV4) ToParams() map[string]string {
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 direction.v4.etd_driving）
+func (req *ETDDrivingRequestV4) Endpoint() string {
+	return "direction.v4.etd_driving"
+}
+
+// Validate 校验必填参数
+func (req *ETDDrivingRequestV4) Validate() error {
+	if req.Origin.IsZero() {
+		return amapErr.NewInvalidConfigError("未来驾车路径规划v4：origin参数不能为空")
+	}
+	if req.Destination.IsZero() {
+		return amapErr.NewInvalidConfigError("未来驾车路径规划v4：destination参数不能为空")
+	}
+	if req.DepartureTime == "" {
+		return amapErr.NewInvalidConfigError("未来驾车路径规划v4：departure_time参数不能为空")
+	}
+	// 校验经纬度范围
+	for _, p := range append([]amapType.LngLat{req.Origin, req.Destination}, req.Waypoints...) {
+		if err := p.Validate(); err != nil {
+			return amapErr.NewInvalidConfigError(fmt.Sprintf("未来驾车路径规划v4：坐标错误：%v", err))
+		}
+	}
+	if !req.AvoidArea.IsZero() {
+		if err := req.AvoidArea.Validate(); err != nil {
+			return amapErr.NewInvalidConfigError(fmt.Sprintf("未来驾车路径规划v4：avoid_area错误：%v", err))
+		}
+	}
+	return nil
+}
@@ -1,5 +1,12 @@
 package geo_code
 
+import (
+	"fmt"
+	"strings"
+
+	amapErr "github.com/enneket/amap/errors"
+)
+
 // GeocodeRequest 地理编码请求参数
 // 文档：https://lbs.amap.com/api/webservice/guide/api/georegeo#t4
 type GeocodeRequest struct {
@@ -20,3 +27,57 @@ func (req *GeocodeRequest) ToParams() map[string]string {
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 geocode.geo）
+func (req *GeocodeRequest) Endpoint() string {
+	return "geocode.geo"
+}
+
+// Validate 校验必填参数
+func (req *GeocodeRequest) Validate() error {
+	if req.Address == "" {
+		return amapErr.NewInvalidConfigError("地理编码：address参数不能为空")
+	}
+	return nil
+}
+
+// MaxBatchAddresses 批量地理编码单次请求的最大地址数
+const MaxBatchAddresses = 10
+
+// BatchGeocodeRequest 批量地理编码请求参数（batch=true，地址以 | 分隔）
+type BatchGeocodeRequest struct {
+	Addresses []string `json:"address"`        // 待解析的地址列表（必填，最多 MaxBatchAddresses 个，地址中不能包含 |）
+	City      string   `json:"city,omitempty"` // 城市（可选，作用于所有地址）
+}
+
+// ToParams 将请求参数转换为map[string]string格式
+func (req *BatchGeocodeRequest) ToParams() map[string]string {
+	params := make(map[string]string)
+	params["address"] = strings.Join(req.Addresses, "|")
+	params["batch"] = "true"
+	if req.City != "" {
+		params["city"] = req.City
+	}
+	return params
+}
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 geocode.geo）
+func (req *BatchGeocodeRequest) Endpoint() string {
+	return "geocode.geo"
+}
+
+// Validate 校验必填参数
+func (req *BatchGeocodeRequest) Validate() error {
+	if len(req.Addresses) == 0 {
+		return amapErr.NewInvalidConfigError("批量地理编码：address参数不能为空")
+	}
+	if len(req.Addresses) > MaxBatchAddresses {
+		return amapErr.NewInvalidConfigError(fmt.Sprintf("批量地理编码：单次最多 %d 个地址", MaxBatchAddresses))
+	}
+	for i, address := range req.Addresses {
+		if strings.Contains(address, "|") {
+			return amapErr.NewInvalidConfigError(fmt.Sprintf("批量地理编码：第 %d 个地址包含分隔符 |", i+1))
+		}
+	}
+	return nil
+}
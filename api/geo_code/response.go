@@ -1,28 +1,79 @@
 package geo_code
 
 import (
+	"encoding/json"
+
 	amapType "github.com/enneket/amap/types"
 )
 
 // GeoCodeResponse 地理编码响应
 // 文档：https://lbs.amap.com/api/webservice/guide/api/georegeo#t4
 type GeoCodeResponse struct {
-	amapType.BaseResponse               // 继承基础响应（Status/Info/InfoCode）
-	Count                 string        `json:"count"`    // 匹配的地址数量
-	Geocodes              []GeocodeItem `json:"geocodes"` // 地理编码结果列表
+	amapType.BaseResponse                     // 继承基础响应（Status/Info/InfoCode）
+	Count                 amapType.FlexString `json:"count"`    // 匹配的地址数量
+	Geocodes              []GeocodeItem       `json:"geocodes"` // 地理编码结果列表
 }
 
 // GeocodeItem 地理编码结果项
 type GeocodeItem struct {
-	FormattedAddress string `json:"formatted_address"` // 格式化地址（省+市+区+详细地址）
-	Country          string `json:"country"`           // 国家（默认中国）
-	Province         string `json:"province"`          // 省份（如"北京市"）
-	City             string `json:"city"`              // 城市（如"北京市"）
-	Citycode         string `json:"citycode"`          // 城市编码（如"110000"）
-	District         string `json:"district"`          // 区县（如"朝阳区"）
-	Adcode           string `json:"adcode"`            // 行政区划编码（如"110105"）
-	Street           string `json:"street"`            // 街道（如"望京街"）
-	Number           string `json:"number"`            // 门牌号（如"8号"）
-	Location         string `json:"location"`          // 经纬度（格式："经度,纬度"）
-	Level            string `json:"level"`             // 匹配级别（如"门牌号"、"街道"、"区域"）
+	FormattedAddress amapType.FlexString `json:"formatted_address"` // 格式化地址（省+市+区+详细地址）
+	Country          amapType.FlexString `json:"country"`           // 国家（默认中国）
+	Province         amapType.FlexString `json:"province"`          // 省份（如"北京市"）
+	City             amapType.FlexString `json:"city"`              // 城市（如"北京市"）
+	Citycode         amapType.FlexString `json:"citycode"`          // 城市编码（如"110000"）
+	District         amapType.FlexString `json:"district"`          // 区县（如"朝阳区"）
+	Adcode           amapType.FlexString `json:"adcode"`            // 行政区划编码（如"110105"）
+	Street           amapType.FlexString `json:"street"`            // 街道（如"望京街"）
+	Number           amapType.FlexString `json:"number"`            // 门牌号（如"8号"）
+	Location         amapType.FlexString `json:"location"`          // 经纬度（格式："经度,纬度"）
+	Level            amapType.FlexString `json:"level"`             // 匹配级别（如"门牌号"、"街道"、"区域"）
+}
+
+// UnmarshalJSON 解析地理编码结果项
+// 高德在字段无值时返回空数组 []（批量模式下未匹配的地址所有字段均为 []），统一按空字符串处理
+func (item *GeocodeItem) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	for k, v := range fields {
+		if len(v) > 0 && v[0] != '"' {
+			fields[k] = json.RawMessage(`""`)
+		}
+	}
+	normalized, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	type plain GeocodeItem // 去除 UnmarshalJSON 方法，避免递归
+	return json.Unmarshal(normalized, (*plain)(item))
+}
+
+// Found 是否匹配到坐标
+func (item *GeocodeItem) Found() bool {
+	return item.Location != ""
+}
+
+// GeoCodeBatchResponse 批量地理编码响应（geocodes 与请求地址一一对应，未匹配的地址 Location 为空）
+type GeoCodeBatchResponse struct {
+	amapType.BaseResponse                     // 继承基础响应（Status/Info/InfoCode）
+	Count                 amapType.FlexString `json:"count"`    // 返回结果数量
+	Geocodes              []GeocodeItem       `json:"geocodes"` // 地理编码结果列表（与请求地址顺序一致）
+}
+
+// BatchResult 批量地理编码单个地址的结果
+type BatchResult struct {
+	Address string       // 输入地址
+	Item    *GeocodeItem // 匹配结果（未匹配或请求失败时为 nil）
+	Err     error        // 所在批次请求失败时的错误
+}
+
+// Found 是否匹配到坐标
+func (r *BatchResult) Found() bool {
+	return r.Item != nil && r.Item.Found()
+}
+
+// LngLat 解析地理编码结果坐标（未返回坐标或格式错误时返回错误）
+func (item *GeocodeItem) LngLat() (amapType.LngLat, error) {
+	return amapType.ParseLngLat(item.Location.String())
 }
@@ -1,5 +1,9 @@
 package grasproad
 
+import (
+	amapErr "github.com/enneket/amap/errors"
+)
+
 // GraspRoadRequest 轨迹纠偏请求参数
 // 文档：https://lbs.amap.com/api/webservice/guide/api/grasproad
 // 用于将原始轨迹点转换为匹配道路的轨迹点
@@ -32,3 +36,19 @@ func (req *GraspRoadRequest) ToParams() map[string]string {
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 grasproad）
+func (req *GraspRoadRequest) Endpoint() string {
+	return "grasproad"
+}
+
+// Validate 校验必填参数
+func (req *GraspRoadRequest) Validate() error {
+	if req.SID == "" {
+		return amapErr.NewInvalidConfigError("轨迹纠偏：sid参数不能为空")
+	}
+	if req.Points == "" {
+		return amapErr.NewInvalidConfigError("轨迹纠偏：points参数不能为空")
+	}
+	return nil
+}
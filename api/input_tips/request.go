@@ -1,6 +1,9 @@
 package inputtips
 
 import (
+	"fmt"
+
+	amapErr "github.com/enneket/amap/errors"
 	amapType "github.com/enneket/amap/types"
 )
 
@@ -11,7 +14,7 @@ type InputtipsRequest struct {
 	Keywords   string                `json:"keywords"`             // 关键字（必填）
 	City       string                `json:"city,omitempty"`       // 城市（可选）
 	Type       string                `json:"type,omitempty"`       // POI类型（可选）
-	Location   string                `json:"location,omitempty"`   // 经纬度（可选）
+	Location   amapType.LngLat       `json:"location,omitempty"`   // 经纬度（可选）
 	Radius     string                `json:"radius,omitempty"`     // 搜索半径（可选）
 	Offset     string                `json:"offset,omitempty"`     // 返回结果数量（可选，默认10）
 	Page       string                `json:"page,omitempty"`       // 当前页码（可选，默认1）
@@ -35,8 +38,8 @@ func (req *InputtipsRequest) ToParams() map[string]string {
 	if req.Type != "" {
 		params["type"] = req.Type
 	}
-	if req.Location != "" {
-		params["location"] = req.Location
+	if !req.Location.IsZero() {
+		params["location"] = req.Location.String()
 	}
 	if req.Radius != "" {
 		params["radius"] = req.Radius
@@ -71,3 +74,19 @@ func (req *InputtipsRequest) ToParams() map[string]string {
 
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 inputtips）
+func (req *InputtipsRequest) Endpoint() string {
+	return "inputtips"
+}
+
+// Validate 校验必填参数
+func (req *InputtipsRequest) Validate() error {
+	if req.Keywords == "" {
+		return amapErr.NewInvalidConfigError("输入提示：keywords参数不能为空")
+	}
+	if err := req.Location.Validate(); err != nil {
+		return amapErr.NewInvalidConfigError(fmt.Sprintf("输入提示：location坐标错误：%v", err))
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package ip
 
 import (
+	amapErr "github.com/enneket/amap/errors"
 	amapType "github.com/enneket/amap/types"
 )
 
@@ -34,3 +35,16 @@ func (req *IPConfigRequest) ToParams() map[string]string {
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 ip.v5）
+func (req *IPConfigRequest) Endpoint() string {
+	return "ip.v5"
+}
+
+// Validate 校验必填参数
+func (req *IPConfigRequest) Validate() error {
+	if req.IP == "" {
+		return amapErr.NewInvalidConfigError("IP定位v5：ip参数不能为空")
+	}
+	return nil
+}
@@ -10,14 +10,14 @@ import (
 
 type IPConfigResponse struct {
 	amapType.BaseResponse           // 继承基础响应（Status/Info/InfoCode）
-	IP         string        `json:"ip"`         // 查询的IP地址
-	Province   string        `json:"province"`   // 省份名称
-	City       string        `json:"city"`       // 城市名称
-	District   string        `json:"district"`   // 区县名称
-	Adcode     string        `json:"adcode"`     // 行政区划编码
-	Center     string        `json:"center"`     // 城市中心点坐标（经度,纬度）
-	ISP        string        `json:"isp"`        // 互联网服务提供商
-	Country    string        `json:"country"`    // 国家名称
+	IP         amapType.FlexString        `json:"ip"`         // 查询的IP地址
+	Province   amapType.FlexString        `json:"province"`   // 省份名称
+	City       amapType.FlexString        `json:"city"`       // 城市名称
+	District   amapType.FlexString        `json:"district"`   // 区县名称
+	Adcode     amapType.FlexString        `json:"adcode"`     // 行政区划编码
+	Center     amapType.FlexString        `json:"center"`     // 城市中心点坐标（经度,纬度）
+	ISP        amapType.FlexString        `json:"isp"`        // 互联网服务提供商
+	Country    amapType.FlexString        `json:"country"`    // 国家名称
 	Location   *LocationInfo `json:"location,omitempty"` // 详细位置信息（扩展）
 }
 
@@ -25,12 +25,12 @@ type IPConfigResponse struct {
 // 包含更详细的位置描述和坐标信息
 
 type LocationInfo struct {
-	Lat        string        `json:"lat"`        // 纬度
-	Lon        string        `json:"lon"`        // 经度
-	Address    string        `json:"address"`    // 详细地址描述
-	CityCode   string        `json:"city_code"`   // 城市编码
-	ProvinceCode string      `json:"province_code"` // 省份编码
-	DistrictCode string      `json:"district_code"` // 区县编码
+	Lat        amapType.FlexString        `json:"lat"`        // 纬度
+	Lon        amapType.FlexString        `json:"lon"`        // 经度
+	Address    amapType.FlexString        `json:"address"`    // 详细地址描述
+	CityCode   amapType.FlexString        `json:"city_code"`   // 城市编码
+	ProvinceCode amapType.FlexString      `json:"province_code"` // 省份编码
+	DistrictCode amapType.FlexString      `json:"district_code"` // 区县编码
 	ISPInfo    *ISPInfo      `json:"isp_info,omitempty"` // ISP详细信息
 }
 
@@ -38,8 +38,13 @@ type LocationInfo struct {
 // 包含ISP的名称、类型等信息
 
 type ISPInfo struct {
-	Name       string        `json:"name"`       // ISP名称
-	Type       string        `json:"type"`       // ISP类型（如电信、联通、移动等）
-	MCC        string        `json:"mcc"`        // 移动国家码
-	MNC        string        `json:"mnc"`        // 移动网络码
+	Name       amapType.FlexString        `json:"name"`       // ISP名称
+	Type       amapType.FlexString        `json:"type"`       // ISP类型（如电信、联通、移动等）
+	MCC        amapType.FlexString        `json:"mcc"`        // 移动国家码
+	MNC        amapType.FlexString        `json:"mnc"`        // 移动网络码
+}
+
+// LngLat 解析城市中心点坐标（未返回坐标或格式错误时返回错误）
+func (resp *IPConfigResponse) LngLat() (amapType.LngLat, error) {
+	return amapType.ParseLngLat(resp.Center.String())
 }
@@ -0,0 +1,66 @@
+package place
+
+import (
+	"strings"
+
+	amapType "github.com/enneket/amap/types"
+)
+
+// ShowField POI搜索2.0 可选返回的扩展信息（show_fields 参数的取值）
+type ShowField string
+
+const (
+	ShowFieldChildren ShowField = "children" // 子POI
+	ShowFieldBusiness ShowField = "business" // 商业信息（评分、人均消费、营业时间等）
+	ShowFieldIndoor   ShowField = "indoor"   // 室内信息
+	ShowFieldNavi     ShowField = "navi"     // 导航信息（出入口坐标等）
+	ShowFieldPhotos   ShowField = "photos"   // 图片
+)
+
+// ShowFields show_fields 参数（未设置时高德只返回基础信息）
+type ShowFields []ShowField
+
+// AllShowFields 全部扩展信息
+func AllShowFields() ShowFields {
+	return ShowFields{ShowFieldChildren, ShowFieldBusiness, ShowFieldIndoor, ShowFieldNavi, ShowFieldPhotos}
+}
+
+// String 格式化为 show_fields 参数（以 "," 分隔）
+func (f ShowFields) String() string {
+	parts := make([]string, len(f))
+	for i, field := range f {
+		parts[i] = string(field)
+	}
+	return strings.Join(parts, ",")
+}
+
+// Business POI搜索2.0 商业信息（show_fields 含 business 时返回）
+type Business struct {
+	BusinessArea  amapType.FlexString `json:"business_area,omitempty"`  // 商圈
+	OpentimeToday amapType.FlexString `json:"opentime_today,omitempty"` // 今日营业时间
+	OpentimeWeek  amapType.FlexString `json:"opentime_week,omitempty"`  // 营业时间描述
+	Tel           amapType.FlexString `json:"tel,omitempty"`            // 电话
+	Tag           amapType.FlexString `json:"tag,omitempty"`            // 特色内容
+	Rating        amapType.FlexString `json:"rating,omitempty"`         // 评分
+	Cost          amapType.FlexString `json:"cost,omitempty"`           // 人均消费
+	ParkingType   amapType.FlexString `json:"parking_type,omitempty"`   // 停车场类型（地下、地面、路边）
+	Alias         amapType.FlexString `json:"alias,omitempty"`          // 别名
+	KeyTag        amapType.FlexString `json:"keytag,omitempty"`         // 标签
+	RecTag        amapType.FlexString `json:"rectag,omitempty"`         // 推荐标签
+}
+
+// Navi POI搜索2.0 导航信息（show_fields 含 navi 时返回）
+type Navi struct {
+	NaviPoiID    amapType.FlexString `json:"navi_poiid,omitempty"`    // 地图编号
+	EntrLocation amapType.FlexString `json:"entr_location,omitempty"` // 入口坐标
+	ExitLocation amapType.FlexString `json:"exit_location,omitempty"` // 出口坐标
+	GridCode     amapType.FlexString `json:"gridcode,omitempty"`      // 地理格子编码
+}
+
+// IndoorInfo POI搜索2.0 室内信息（show_fields 含 indoor 时返回）
+type IndoorInfo struct {
+	IndoorMap amapType.FlexString `json:"indoor_map,omitempty"` // 是否有室内地图（0/1）
+	Cpid      amapType.FlexString `json:"cpid,omitempty"`       // 所在建筑ID
+	Floor     amapType.FlexString `json:"floor,omitempty"`      // 楼层索引
+	TrueFloor amapType.FlexString `json:"truefloor,omitempty"`  // 楼层名称
+}
@@ -0,0 +1,111 @@
+package place
+
+import (
+	"github.com/enneket/amap/geojson"
+	amapType "github.com/enneket/amap/types"
+)
+
+// PoiItem POI搜索 v3、v5 各接口返回的POI信息（字段与高德返回的 JSON 一致）
+// 各接口包中的 PoiItem 均为该类型的别名，POI() 将其转换为统一的 POI
+type PoiItem struct {
+	ID               amapType.FlexString `json:"id"`                         // POI唯一标识
+	Name             amapType.FlexString `json:"name"`                       // POI名称
+	Type             amapType.FlexString `json:"type"`                       // POI类型
+	TypeCode         amapType.FlexString `json:"typecode"`                   // POI类型编码
+	Address          amapType.FlexString `json:"address,omitempty"`          // 地址信息
+	Location         amapType.FlexString `json:"location"`                   // POI坐标（经度,纬度）
+	Tel              amapType.FlexString `json:"tel,omitempty"`              // 电话
+	Postcode         amapType.FlexString `json:"postcode,omitempty"`         // 邮政编码
+	Website          amapType.FlexString `json:"website,omitempty"`          // 网址
+	Email            amapType.FlexString `json:"email,omitempty"`            // 邮箱
+	Pcode            amapType.FlexString `json:"pcode,omitempty"`            // 省份编码
+	Pname            amapType.FlexString `json:"pname,omitempty"`            // 省份名称
+	Citycode         amapType.FlexString `json:"citycode,omitempty"`         // 城市编码
+	Cityname         amapType.FlexString `json:"cityname,omitempty"`         // 城市名称
+	Adcode           amapType.FlexString `json:"adcode,omitempty"`           // 行政区划编码
+	Adname           amapType.FlexString `json:"adname,omitempty"`           // 行政区划名称
+	BusinessArea     amapType.FlexString `json:"business_area,omitempty"`    // 商圈
+	ShopID           amapType.FlexString `json:"shopid,omitempty"`           // 店铺ID
+	ShopInfo         amapType.FlexInt    `json:"shopinfo,omitempty"`         // 是否有店铺信息（0/1）
+	NaviPoiid        amapType.FlexString `json:"navipoiid,omitempty"`        // 导航POI ID
+	EntranceLocation amapType.FlexString `json:"entrancelocation,omitempty"` // 入口坐标
+	ExitLocation     amapType.FlexString `json:"exitlocation,omitempty"`     // 出口坐标
+	Photos           []PoiPhoto          `json:"photos,omitempty"`           // 图片列表
+	Children         []PoiItem           `json:"children,omitempty"`         // 子POI列表
+	Rating           amapType.FlexString `json:"rating,omitempty"`           // 评分
+	Cost             amapType.FlexString `json:"cost,omitempty"`             // 人均消费
+	OpenTime         amapType.FlexString `json:"opentime,omitempty"`         // 营业时间
+	Tags             amapType.FlexString `json:"tags,omitempty"`             // 标签
+	IndoorMap        amapType.FlexString `json:"indoor_map,omitempty"`       // 是否有室内地图（0/1）
+	IndoorData       *PoiIndoorData      `json:"indoor_data,omitempty"`      // 室内地图数据
+	Distance         amapType.FlexString `json:"distance,omitempty"`         // 距离（仅周边搜索时返回）
+	Direction        amapType.FlexString `json:"direction,omitempty"`        // 方向（仅周边搜索时返回）
+	Floor            amapType.FlexString `json:"floor,omitempty"`            // 楼层
+	ShopType         amapType.FlexString `json:"shop_type,omitempty"`        // 店铺类型
+	GridCode         amapType.FlexString `json:"gridcode,omitempty"`         // 网格编码
+	DistanceSort     amapType.FlexString `json:"distance_sort,omitempty"`    // 距离排序
+	BizExt           *PoiBizExt          `json:"biz_ext,omitempty"`          // 业务扩展信息（v3）
+	Event            *PoiEvent           `json:"event,omitempty"`            // 活动信息
+	Polyline         amapType.FlexString `json:"polyline,omitempty"`         // 边界坐标（仅AOI查询时返回）
+
+	// show_fields 指定返回的扩展信息（仅 v5 接口返回，未指定时为 nil）
+	Business *Business   `json:"business,omitempty"` // 商业信息
+	Navi     *Navi       `json:"navi,omitempty"`     // 导航信息
+	Indoor   *IndoorInfo `json:"indoor,omitempty"`   // 室内信息
+}
+
+// PoiPhoto POI图片信息
+type PoiPhoto struct {
+	Title amapType.FlexString `json:"title"` // 图片标题
+	URL   amapType.FlexString `json:"url"`   // 图片URL
+}
+
+// PoiIndoorData 室内地图数据
+type PoiIndoorData struct {
+	Floor     amapType.FlexString `json:"floor"`          // 楼层
+	TrueFloor amapType.FlexString `json:"truefloor"`      // 真实楼层
+	Cpid      amapType.FlexString `json:"cpid"`           // 建筑ID
+	Pois      []PoiItem           `json:"pois,omitempty"` // 室内POI列表
+}
+
+// PoiBizExt 业务扩展信息
+type PoiBizExt struct {
+	Cost        amapType.FlexString `json:"cost,omitempty"`        // 人均消费
+	Rating      amapType.FlexString `json:"rating,omitempty"`      // 评分
+	OpenTime    amapType.FlexString `json:"opentime,omitempty"`    // 营业时间
+	Charge      amapType.FlexString `json:"charge,omitempty"`      // 是否收费（0/1）
+	MCTags      amapType.FlexString `json:"mctags,omitempty"`      // 商户标签
+	SpecialTags amapType.FlexString `json:"specialtags,omitempty"` // 特色标签
+	FoodType    amapType.FlexString `json:"foodtype,omitempty"`    // 餐饮类型
+}
+
+// PoiEvent 活动信息
+type PoiEvent struct {
+	StartTime amapType.FlexString `json:"start_time,omitempty"` // 活动开始时间
+	EndTime   amapType.FlexString `json:"end_time,omitempty"`   // 活动结束时间
+	Name      amapType.FlexString `json:"name,omitempty"`       // 活动名称
+	Type      amapType.FlexString `json:"type,omitempty"`       // 活动类型
+	Desc      amapType.FlexString `json:"desc,omitempty"`       // 活动描述
+}
+
+// LngLat 解析POI坐标（未返回坐标或格式错误时返回错误）
+func (item *PoiItem) LngLat() (amapType.LngLat, error) {
+	return amapType.ParseLngLat(item.Location.String())
+}
+
+// ToGeoJSON 导出POI为 GeoJSON 点要素（属性含 id/name/type/typecode/address/adcode/adname）
+func (item *PoiItem) ToGeoJSON(opts ...geojson.Option) (*geojson.Feature, error) {
+	p, err := item.LngLat()
+	if err != nil {
+		return nil, err
+	}
+	return geojson.NewFeature(geojson.Point(p, opts...), geojson.Properties{
+		"id":       item.ID,
+		"name":     item.Name,
+		"type":     item.Type,
+		"typecode": item.TypeCode,
+		"address":  item.Address,
+		"adcode":   item.Adcode,
+		"adname":   item.Adname,
+	}), nil
+}
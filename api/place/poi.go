@@ -0,0 +1,215 @@
+// Package place 定义 POI 搜索 v3、v5 各接口共用的 POI 类型
+// 各接口的 PoiItem 通过 POI() 转换为统一的 place.POI，便于同一套代码处理不同接口的结果
+package place
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+)
+
+// POI 统一的POI信息（字段已转换为具体类型，未返回的字段为零值）
+type POI struct {
+	ID           string              // POI唯一标识
+	Name         string              // POI名称
+	Type         string              // POI类型
+	TypeCode     string              // POI类型编码
+	Address      string              // 地址信息
+	Location     amapType.LngLat     // POI坐标
+	Tel          string              // 电话
+	Postcode     string              // 邮政编码
+	Website      string              // 网址
+	Email        string              // 邮箱
+	Pcode        string              // 省份编码
+	Pname        string              // 省份名称
+	Citycode     string              // 城市编码
+	Cityname     string              // 城市名称
+	Adcode       string              // 行政区划编码
+	Adname       string              // 行政区划名称
+	BusinessArea string              // 商圈
+	ShopID       string              // 店铺ID
+	NaviPoiID    string              // 导航POI ID
+	Entrance     amapType.LngLat     // 入口坐标
+	Exit         amapType.LngLat     // 出口坐标
+	Rating       float64             // 评分（0~5）
+	Cost         float64             // 人均消费（元）
+	OpenTime     string              // 营业时间
+	Tags         string              // 标签
+	Distance     float64             // 距离（米，仅周边搜索时返回）
+	Direction    string              // 方向（仅周边搜索时返回）
+	Floor        string              // 楼层
+	IndoorMap    bool                // 是否有室内地图
+	Indoor       *Indoor             // 室内信息
+	Boundary     amapType.LngLatList // 边界坐标（仅AOI查询时返回）
+	Photos       []Photo             // 图片列表
+	Children     []POI               // 子POI列表
+}
+
+// Photo POI图片
+type Photo struct {
+	Title string // 图片标题
+	URL   string // 图片URL
+}
+
+// Indoor POI室内信息
+type Indoor struct {
+	Cpid      string // 所在建筑ID
+	Floor     string // 楼层
+	TrueFloor string // 真实楼层
+}
+
+// POI 转换为统一的 POI（坐标解析为 LngLat，评分、人均消费、距离转换为数字）
+// 顶层未返回的字段依次使用 v3 业务扩展信息 biz_ext、v5 show_fields 扩展信息中的值；
+// 个别字段格式错误时该字段为零值、其余字段照常转换，错误汇总后一并返回
+func (item *PoiItem) POI() (POI, error) {
+	var c converter
+	poi := c.convert(item)
+	return poi, errors.Join(c.errs...)
+}
+
+// FromItems 批量转换 PoiItem 列表：返回的列表与 items 一一对应，
+// 个别 POI 转换出错不影响其他 POI，错误按序号汇总后返回
+func FromItems(items []PoiItem) ([]POI, error) {
+	pois := make([]POI, len(items))
+	var errs []error
+	for i := range items {
+		poi, err := items[i].POI()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("第 %d 个POI（%s）：%w", i, items[i].ID, err))
+		}
+		pois[i] = poi
+	}
+	return pois, errors.Join(errs...)
+}
+
+// converter 转换 PoiItem 并收集字段格式错误
+type converter struct {
+	errs []error
+}
+
+// convert 转换单个 PoiItem（含子POI）
+func (c *converter) convert(item *PoiItem) POI {
+	poi := POI{
+		ID:           item.ID.String(),
+		Name:         item.Name.String(),
+		Type:         item.Type.String(),
+		TypeCode:     item.TypeCode.String(),
+		Address:      item.Address.String(),
+		Location:     c.lngLat("location", item.Location),
+		Tel:          item.Tel.String(),
+		Postcode:     item.Postcode.String(),
+		Website:      item.Website.String(),
+		Email:        item.Email.String(),
+		Pcode:        item.Pcode.String(),
+		Pname:        item.Pname.String(),
+		Citycode:     item.Citycode.String(),
+		Cityname:     item.Cityname.String(),
+		Adcode:       item.Adcode.String(),
+		Adname:       item.Adname.String(),
+		BusinessArea: item.BusinessArea.String(),
+		ShopID:       item.ShopID.String(),
+		NaviPoiID:    item.NaviPoiid.String(),
+		Entrance:     c.lngLat("entrancelocation", item.EntranceLocation),
+		Exit:         c.lngLat("exitlocation", item.ExitLocation),
+		OpenTime:     item.OpenTime.String(),
+		Tags:         item.Tags.String(),
+		Direction:    item.Direction.String(),
+		Floor:        item.Floor.String(),
+		IndoorMap:    item.IndoorMap == "1",
+		Rating:       c.number("rating", item.Rating),
+		Cost:         c.number("cost", item.Cost),
+		Distance:     c.number("distance", item.Distance),
+	}
+	if item.BizExt != nil {
+		if poi.Rating == 0 {
+			poi.Rating = c.number("biz_ext.rating", item.BizExt.Rating)
+		}
+		if poi.Cost == 0 {
+			poi.Cost = c.number("biz_ext.cost", item.BizExt.Cost)
+		}
+		if poi.OpenTime == "" {
+			poi.OpenTime = item.BizExt.OpenTime.String()
+		}
+	}
+	if item.Business != nil {
+		c.mergeBusiness(&poi, item.Business)
+	}
+	if item.Navi != nil {
+		if poi.NaviPoiID == "" {
+			poi.NaviPoiID = item.Navi.NaviPoiID.String()
+		}
+		if poi.Entrance.IsZero() {
+			poi.Entrance = c.lngLat("navi.entr_location", item.Navi.EntrLocation)
+		}
+		if poi.Exit.IsZero() {
+			poi.Exit = c.lngLat("navi.exit_location", item.Navi.ExitLocation)
+		}
+	}
+	if item.IndoorData != nil {
+		poi.Indoor = &Indoor{Cpid: item.IndoorData.Cpid.String(), Floor: item.IndoorData.Floor.String(), TrueFloor: item.IndoorData.TrueFloor.String()}
+	} else if item.Indoor != nil {
+		poi.IndoorMap = poi.IndoorMap || item.Indoor.IndoorMap == "1"
+		poi.Indoor = &Indoor{Cpid: item.Indoor.Cpid.String(), Floor: item.Indoor.Floor.String(), TrueFloor: item.Indoor.TrueFloor.String()}
+	}
+	if item.Polyline != "" {
+		boundary, err := amapType.ParseLngLatList(item.Polyline.String(), ";")
+		if err != nil {
+			c.errs = append(c.errs, amapErr.NewParseError("POI边界坐标格式错误："+err.Error()))
+		}
+		poi.Boundary = boundary
+	}
+	for _, photo := range item.Photos {
+		poi.Photos = append(poi.Photos, Photo{Title: photo.Title.String(), URL: photo.URL.String()})
+	}
+	for i := range item.Children {
+		poi.Children = append(poi.Children, c.convert(&item.Children[i]))
+	}
+	return poi
+}
+
+// mergeBusiness 使用 v5 商业信息补全顶层未返回的字段
+func (c *converter) mergeBusiness(poi *POI, b *Business) {
+	if poi.BusinessArea == "" {
+		poi.BusinessArea = b.BusinessArea.String()
+	}
+	if poi.Tel == "" {
+		poi.Tel = b.Tel.String()
+	}
+	if poi.Rating == 0 {
+		poi.Rating = c.number("business.rating", b.Rating)
+	}
+	if poi.Cost == 0 {
+		poi.Cost = c.number("business.cost", b.Cost)
+	}
+	if poi.OpenTime == "" {
+		poi.OpenTime = b.OpentimeWeek.String()
+	}
+	if poi.Tags == "" {
+		poi.Tags = b.Tag.String()
+	}
+}
+
+// lngLat 解析坐标字段（未返回时为零值）
+func (c *converter) lngLat(field string, s amapType.FlexString) amapType.LngLat {
+	if strings.TrimSpace(s.String()) == "" {
+		return amapType.LngLat{}
+	}
+	p, err := amapType.ParseLngLat(s.String())
+	if err != nil {
+		c.errs = append(c.errs, amapErr.NewParseError(field+" 坐标格式错误："+strconv.Quote(s.String())))
+	}
+	return p
+}
+
+// number 解析数字字段（未返回时为 0）
+func (c *converter) number(field string, s amapType.FlexString) float64 {
+	v, err := s.Float64()
+	if err != nil {
+		c.errs = append(c.errs, fmt.Errorf("%s：%w", field, err))
+	}
+	return v
+}
@@ -2,19 +2,22 @@ package aoi
 
 import (
 	"fmt"
+
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
 )
 
 // AOISearchRequest AOI边界查询请求参数
 // 文档：https://lbs.amap.com/api/webservice/guide/api-advanced/search
 // 用于获取指定AOI（兴趣面）的边界坐标
 type AOISearchRequest struct {
-	ID         string `json:"id,omitempty"`         // AOI ID（可选，与location二选一）
-	Location   string `json:"location,omitempty"`   // 中心点坐标（可选，与id二选一）
-	Keyword    string `json:"keyword,omitempty"`    // 搜索关键词（可选）
-	Offset     int    `json:"offset,omitempty"`     // 每页条数（可选，1-50，默认20）
-	Page       int    `json:"page,omitempty"`       // 页码（可选，默认1）
-	Extensions string `json:"extensions,omitempty"` // 返回结果类型（可选，base/all，默认base）
-	Language   string `json:"language,omitempty"`   // 语言（可选，默认中文）
+	ID         string          `json:"id,omitempty"`         // AOI ID（可选，与location二选一）
+	Location   amapType.LngLat `json:"location,omitempty"`   // 中心点坐标（可选，与id二选一）
+	Keyword    string          `json:"keyword,omitempty"`    // 搜索关键词（可选）
+	Offset     int             `json:"offset,omitempty"`     // 每页条数（可选，1-50，默认20）
+	Page       int             `json:"page,omitempty"`       // 页码（可选，默认1）
+	Extensions string          `json:"extensions,omitempty"` // 返回结果类型（可选，base/all，默认base）
+	Language   string          `json:"language,omitempty"`   // 语言（可选，默认中文）
 }
 
 // ToParams 将AOI查询请求参数转换为map[string]string格式
@@ -24,8 +27,8 @@ func (req *AOISearchRequest) ToParams() map[string]string {
 	if req.ID != "" {
 		params["id"] = req.ID
 	}
-	if req.Location != "" {
-		params["location"] = req.Location
+	if !req.Location.IsZero() {
+		params["location"] = req.Location.String()
 	}
 	if req.Keyword != "" {
 		params["keyword"] = req.Keyword
@@ -45,3 +48,16 @@ func (req *AOISearchRequest) ToParams() map[string]string {
 
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 place.aoi）
+func (req *AOISearchRequest) Endpoint() string {
+	return "place.aoi"
+}
+
+// Validate 校验请求参数（该接口无必填参数，仅校验坐标范围）
+func (req *AOISearchRequest) Validate() error {
+	if err := req.Location.Validate(); err != nil {
+		return amapErr.NewInvalidConfigError(fmt.Sprintf("AOI边界查询：location坐标错误：%v", err))
+	}
+	return nil
+}
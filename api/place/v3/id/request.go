@@ -1,5 +1,9 @@
 package id
 
+import (
+	amapErr "github.com/enneket/amap/errors"
+)
+
 // IDRequest ID查询请求参数
 // 文档：https://lbs.amap.com/api/webservice/guide/api-advanced/search
 // 根据POI ID查询详细信息
@@ -23,3 +27,16 @@ func (req *IDRequest) ToParams() map[string]string {
 	
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 place.detail）
+func (req *IDRequest) Endpoint() string {
+	return "place.detail"
+}
+
+// Validate 校验必填参数
+func (req *IDRequest) Validate() error {
+	if req.ID == "" {
+		return amapErr.NewInvalidConfigError("POI ID查询：id参数不能为空")
+	}
+	return nil
+}
@@ -2,21 +2,24 @@ package text
 
 import (
 	"fmt"
+
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
 )
 
 // TextSearchRequest 文本搜索请求参数
 // 文档：https://lbs.amap.com/api/webservice/guide/api-advanced/search
 // 基于关键词的搜索，支持矩形范围搜索
 type TextSearchRequest struct {
-	Keyword    string `json:"keyword,omitempty"`    // 搜索关键词（可选）
-	Types      string `json:"types,omitempty"`      // POI类型（可选，多个类型用|分隔）
-	City       string `json:"city,omitempty"`       // 搜索城市（可选，默认全国）
-	Offset     int    `json:"offset,omitempty"`     // 每页条数（可选，1-50，默认20）
-	Page       int    `json:"page,omitempty"`       // 页码（可选，默认1）
-	Extensions string `json:"extensions,omitempty"` // 返回结果类型（可选，base/all，默认base）
-	Filter     string `json:"filter,omitempty"`     // 过滤条件（可选，如"price:100-200"）
-	Language   string `json:"language,omitempty"`   // 语言（可选，默认中文）
-	Rectangle  string `json:"rectangle,omitempty"`  // 矩形范围（可选，格式："左下角经度,左下角纬度,右上角经度,右上角纬度"）
+	Keyword    string          `json:"keyword,omitempty"`    // 搜索关键词（可选）
+	Types      string          `json:"types,omitempty"`      // POI类型（可选，多个类型用|分隔）
+	City       string          `json:"city,omitempty"`       // 搜索城市（可选，默认全国）
+	Offset     int             `json:"offset,omitempty"`     // 每页条数（可选，1-50，默认20）
+	Page       int             `json:"page,omitempty"`       // 页码（可选，默认1）
+	Extensions string          `json:"extensions,omitempty"` // 返回结果类型（可选，base/all，默认base）
+	Filter     string          `json:"filter,omitempty"`     // 过滤条件（可选，如"price:100-200"）
+	Language   string          `json:"language,omitempty"`   // 语言（可选，默认中文）
+	Rectangle  amapType.Bounds `json:"rectangle,omitempty"`  // 矩形范围（可选，以"左下角经度,左下角纬度,右上角经度,右上角纬度"格式发送）
 }
 
 // ToParams 将文本搜索请求参数转换为map[string]string格式
@@ -47,9 +50,25 @@ func (req *TextSearchRequest) ToParams() map[string]string {
 	if req.Language != "" {
 		params["language"] = req.Language
 	}
-	if req.Rectangle != "" {
-		params["rectangle"] = req.Rectangle
+	if !req.Rectangle.IsZero() {
+		params["rectangle"] = req.Rectangle.Join(",")
 	}
 	
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 place.text）
+func (req *TextSearchRequest) Endpoint() string {
+	return "place.text"
+}
+
+// Validate 校验请求参数（该接口无必填参数，仅校验矩形范围）
+func (req *TextSearchRequest) Validate() error {
+	if req.Rectangle.IsZero() {
+		return nil
+	}
+	if err := req.Rectangle.Validate(); err != nil {
+		return amapErr.NewInvalidConfigError(fmt.Sprintf("POI文本搜索：rectangle错误：%v", err))
+	}
+	return nil
+}
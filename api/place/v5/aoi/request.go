@@ -1,12 +1,19 @@
 package aoi
 
+import (
+	"fmt"
+
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+)
+
 // AOISearchRequest POI搜索2.0 AOI查询请求参数
 // 文档：https://lbs.amap.com/api/webservice/guide/api-advanced/newpoisearch
 // 基于AOI的搜索，用于查询指定AOI内的POI
 
 type AOISearchRequest struct {
 	ID          string `json:"id,omitempty"`    // AOI ID（可选，与location二选一）
-	Location    string `json:"location,omitempty"` // 中心点坐标（可选，与id二选一）
+	Location    amapType.LngLat `json:"location,omitempty"` // 中心点坐标（可选，与id二选一）
 	Keyword     string `json:"keyword,omitempty"`   // 搜索关键词（可选）
 	Offset      string `json:"offset,omitempty"`    // 每页条数（可选，1-50，默认20）
 	Page        string `json:"page,omitempty"`      // 页码（可选，默认1）
@@ -20,8 +27,8 @@ func (req *AOISearchRequest) ToParams() map[string]string {
 	if req.ID != "" {
 		params["id"] = req.ID
 	}
-	if req.Location != "" {
-		params["location"] = req.Location
+	if !req.Location.IsZero() {
+		params["location"] = req.Location.String()
 	}
 	if req.Keyword != "" {
 		params["keyword"] = req.Keyword
@@ -40,3 +47,16 @@ func (req *AOISearchRequest) ToParams() map[string]string {
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 place.v5.aoi）
+func (req *AOISearchRequest) Endpoint() string {
+	return "place.v5.aoi"
+}
+
+// Validate 校验请求参数（该接口无必填参数，仅校验坐标范围）
+func (req *AOISearchRequest) Validate() error {
+	if err := req.Location.Validate(); err != nil {
+		return amapErr.NewInvalidConfigError(fmt.Sprintf("AOI查询v5：location坐标错误：%v", err))
+	}
+	return nil
+}
@@ -1,28 +1,41 @@
 package around
 
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/enneket/amap/api/place"
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+)
+
 // AroundSearchRequest POI搜索2.0周边搜索请求参数
 // 文档：https://lbs.amap.com/api/webservice/guide/api-advanced/newpoisearch
 // 基于中心点和半径的搜索，用于查询指定区域内的POI
 
 type AroundSearchRequest struct {
-	Keyword     string `json:"keyword"`     // 搜索关键词（必填）
-	Location    string `json:"location"`    // 中心点坐标（经度,纬度，必填）
-	Radius      string `json:"radius,omitempty"`    // 搜索半径（可选，单位：米，默认3000）
-	Types       string `json:"types,omitempty"`   // POI类型（可选，多个类型用|分隔）
-	Sortrule    string `json:"sortrule,omitempty"`   // 排序规则（可选，0：综合排序，1：距离排序）
-	Offset      string `json:"offset,omitempty"`    // 每页条数（可选，1-50，默认20）
-	Page        string `json:"page,omitempty"`      // 页码（可选，默认1）
-	Extensions  string `json:"extensions,omitempty"`  // 返回结果类型（可选，base/all，默认base）
-	Filter      string `json:"filter,omitempty"`     // 过滤条件（可选，如"price:100-200"）
-	Origin      string `json:"origin,omitempty"`     // 起点坐标（可选，用于距离排序）
-	Language    string `json:"language,omitempty"`   // 语言（可选，默认中文）
+	Keyword    string           `json:"keywords,omitempty"`    // 搜索关键词（可选）
+	Location   amapType.LngLat  `json:"location"`              // 中心点坐标（必填）
+	Radius     string           `json:"radius,omitempty"`      // 搜索半径（可选，单位：米，默认5000）
+	Types      string           `json:"types,omitempty"`       // POI类型（可选，多个类型用|分隔）
+	Sortrule   string           `json:"sortrule,omitempty"`    // 排序规则（可选，distance：距离排序，weight：综合排序）
+	Region     string           `json:"region,omitempty"`      // 搜索区划（可选，城市名称、citycode或adcode）
+	CityLimit  bool             `json:"city_limit,omitempty"`  // 仅返回 Region 内的POI（可选，默认false）
+	ShowFields place.ShowFields `json:"show_fields,omitempty"` // 返回的扩展信息（可选，默认只返回基础信息）
+	PageSize   int              `json:"page_size,omitempty"`   // 每页条数（可选，1-25，默认10）
+	PageNum    int              `json:"page_num,omitempty"`    // 页码（可选，1-100，默认1）
+	Filter     string           `json:"filter,omitempty"`      // 过滤条件（可选，如"price:100-200"）
+	Origin     amapType.LngLat  `json:"origin,omitempty"`      // 起点坐标（可选，用于距离排序）
+	Language   string           `json:"language,omitempty"`    // 语言（可选，默认中文）
 }
 
 // ToParams 将周边搜索请求参数转换为map[string]string格式
 func (req *AroundSearchRequest) ToParams() map[string]string {
 	params := make(map[string]string)
-	params["keyword"] = req.Keyword // 关键词为必填项，直接添加
-	params["location"] = req.Location // 中心点坐标为必填项，直接添加
+	params["location"] = req.Location.String() // 中心点坐标为必填项，直接添加
+	if req.Keyword != "" {
+		params["keywords"] = req.Keyword
+	}
 	if req.Radius != "" {
 		params["radius"] = req.Radius
 	}
@@ -32,23 +45,47 @@ func (req *AroundSearchRequest) ToParams() map[string]string {
 	if req.Sortrule != "" {
 		params["sortrule"] = req.Sortrule
 	}
-	if req.Offset != "" {
-		params["offset"] = req.Offset
+	if req.Region != "" {
+		params["region"] = req.Region
 	}
-	if req.Page != "" {
-		params["page"] = req.Page
+	if req.CityLimit {
+		params["city_limit"] = "true"
 	}
-	if req.Extensions != "" {
-		params["extensions"] = req.Extensions
+	if len(req.ShowFields) > 0 {
+		params["show_fields"] = req.ShowFields.String()
+	}
+	if req.PageSize > 0 {
+		params["page_size"] = strconv.Itoa(req.PageSize)
+	}
+	if req.PageNum > 0 {
+		params["page_num"] = strconv.Itoa(req.PageNum)
 	}
 	if req.Filter != "" {
 		params["filter"] = req.Filter
 	}
-	if req.Origin != "" {
-		params["origin"] = req.Origin
+	if !req.Origin.IsZero() {
+		params["origin"] = req.Origin.String()
 	}
 	if req.Language != "" {
 		params["language"] = req.Language
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 place.v5.around）
+func (req *AroundSearchRequest) Endpoint() string {
+	return "place.v5.around"
+}
+
+// Validate 校验必填参数
+func (req *AroundSearchRequest) Validate() error {
+	if req.Location.IsZero() {
+		return amapErr.NewInvalidConfigError("POI周边搜索v5：location参数不能为空")
+	}
+	for _, p := range []amapType.LngLat{req.Location, req.Origin} {
+		if err := p.Validate(); err != nil {
+			return amapErr.NewInvalidConfigError(fmt.Sprintf("POI周边搜索v5：坐标错误：%v", err))
+		}
+	}
+	return nil
+}
@@ -1,24 +1,42 @@
 package id
 
+import (
+	"github.com/enneket/amap/api/place"
+	amapErr "github.com/enneket/amap/errors"
+)
+
 // IDRequest POI搜索2.0 ID查询请求参数
 // 文档：https://lbs.amap.com/api/webservice/guide/api-advanced/newpoisearch
 // 根据POI ID查询详细信息
 
 type IDRequest struct {
-	ID         string `json:"id"`                   // POI ID（必填）
-	Extensions string `json:"extensions,omitempty"` // 返回结果类型（可选，base/all，默认base）
-	Language   string `json:"language,omitempty"`   // 语言（可选，默认中文）
+	ID         string           `json:"id"`                    // POI ID（必填，多个ID用|分隔，最多10个）
+	ShowFields place.ShowFields `json:"show_fields,omitempty"` // 返回的扩展信息（可选，默认只返回基础信息）
+	Language   string           `json:"language,omitempty"`    // 语言（可选，默认中文）
 }
 
 // ToParams 将ID查询请求参数转换为map[string]string格式
 func (req *IDRequest) ToParams() map[string]string {
 	params := make(map[string]string)
 	params["id"] = req.ID // ID为必填项，直接添加
-	if req.Extensions != "" {
-		params["extensions"] = req.Extensions
+	if len(req.ShowFields) > 0 {
+		params["show_fields"] = req.ShowFields.String()
 	}
 	if req.Language != "" {
 		params["language"] = req.Language
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 place.v5.detail）
+func (req *IDRequest) Endpoint() string {
+	return "place.v5.detail"
+}
+
+// Validate 校验必填参数
+func (req *IDRequest) Validate() error {
+	if req.ID == "" {
+		return amapErr.NewInvalidConfigError("POI ID查询v5：id参数不能为空")
+	}
+	return nil
+}
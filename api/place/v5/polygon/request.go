@@ -1,50 +1,79 @@
 package polygon
 
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/enneket/amap/api/place"
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+)
+
 // PolygonSearchRequest POI搜索2.0多边形搜索请求参数
 // 文档：https://lbs.amap.com/api/webservice/guide/api-advanced/newpoisearch
 // 基于多边形边界的搜索，用于查询指定多边形区域内的POI
 
 type PolygonSearchRequest struct {
-	Keyword     string `json:"keyword"`     // 搜索关键词（必填）
-	Polygon     string `json:"polygon"`     // 多边形范围（格式："经度1,纬度1;经度2,纬度2;..."，必填）
-	Types       string `json:"types,omitempty"`   // POI类型（可选，多个类型用|分隔）
-	Sortrule    string `json:"sortrule,omitempty"`   // 排序规则（可选，0：综合排序，1：距离排序）
-	Offset      string `json:"offset,omitempty"`    // 每页条数（可选，1-50，默认20）
-	Page        string `json:"page,omitempty"`      // 页码（可选，默认1）
-	Extensions  string `json:"extensions,omitempty"`  // 返回结果类型（可选，base/all，默认base）
-	Filter      string `json:"filter,omitempty"`     // 过滤条件（可选，如"price:100-200"）
-	Origin      string `json:"origin,omitempty"`     // 起点坐标（可选，用于距离排序）
-	Language    string `json:"language,omitempty"`   // 语言（可选，默认中文）
+	Keyword    string              `json:"keywords,omitempty"`    // 搜索关键词（可选）
+	Polygon    amapType.LngLatList `json:"polygon"`               // 多边形范围（必填，以 | 分隔发送；两个坐标时表示矩形左下|右上）
+	Types      string              `json:"types,omitempty"`       // POI类型（可选，多个类型用|分隔）
+	Sortrule   string              `json:"sortrule,omitempty"`    // 排序规则（可选，0：综合排序，1：距离排序）
+	ShowFields place.ShowFields    `json:"show_fields,omitempty"` // 返回的扩展信息（可选，默认只返回基础信息）
+	PageSize   int                 `json:"page_size,omitempty"`   // 每页条数（可选，1-25，默认10）
+	PageNum    int                 `json:"page_num,omitempty"`    // 页码（可选，1-100，默认1）
+	Filter     string              `json:"filter,omitempty"`      // 过滤条件（可选，如"price:100-200"）
+	Origin     amapType.LngLat     `json:"origin,omitempty"`      // 起点坐标（可选，用于距离排序）
+	Language   string              `json:"language,omitempty"`    // 语言（可选，默认中文）
 }
 
 // ToParams 将多边形搜索请求参数转换为map[string]string格式
 func (req *PolygonSearchRequest) ToParams() map[string]string {
 	params := make(map[string]string)
-	params["keyword"] = req.Keyword // 关键词为必填项，直接添加
-	params["polygon"] = req.Polygon // 多边形范围为必填项，直接添加
+	params["polygon"] = req.Polygon.Join("|") // 多边形范围为必填项，直接添加
+	if req.Keyword != "" {
+		params["keywords"] = req.Keyword
+	}
 	if req.Types != "" {
 		params["types"] = req.Types
 	}
 	if req.Sortrule != "" {
 		params["sortrule"] = req.Sortrule
 	}
-	if req.Offset != "" {
-		params["offset"] = req.Offset
+	if len(req.ShowFields) > 0 {
+		params["show_fields"] = req.ShowFields.String()
 	}
-	if req.Page != "" {
-		params["page"] = req.Page
+	if req.PageSize > 0 {
+		params["page_size"] = strconv.Itoa(req.PageSize)
 	}
-	if req.Extensions != "" {
-		params["extensions"] = req.Extensions
+	if req.PageNum > 0 {
+		params["page_num"] = strconv.Itoa(req.PageNum)
 	}
 	if req.Filter != "" {
 		params["filter"] = req.Filter
 	}
-	if req.Origin != "" {
-		params["origin"] = req.Origin
+	if !req.Origin.IsZero() {
+		params["origin"] = req.Origin.String()
 	}
 	if req.Language != "" {
 		params["language"] = req.Language
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 place.v5.polygon）
+func (req *PolygonSearchRequest) Endpoint() string {
+	return "place.v5.polygon"
+}
+
+// Validate 校验必填参数
+func (req *PolygonSearchRequest) Validate() error {
+	if len(req.Polygon) == 0 {
+		return amapErr.NewInvalidConfigError("POI多边形搜索v5：polygon参数不能为空")
+	}
+	for _, p := range append(amapType.LngLatList{req.Origin}, req.Polygon...) {
+		if err := p.Validate(); err != nil {
+			return amapErr.NewInvalidConfigError(fmt.Sprintf("POI多边形搜索v5：坐标错误：%v", err))
+		}
+	}
+	return nil
+}
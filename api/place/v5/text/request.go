@@ -1,52 +1,63 @@
 package text
 
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/enneket/amap/api/place"
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+)
+
 // TextSearchRequest POI搜索2.0文本搜索请求参数
 // 文档：https://lbs.amap.com/api/webservice/guide/api-advanced/newpoisearch
 // 基于关键词的搜索，用于查询指定区域内的POI
 
 type TextSearchRequest struct {
-	Keyword     string `json:"keyword"`     // 搜索关键词（必填）
-	Types       string `json:"types,omitempty"`   // POI类型（可选，多个类型用|分隔）
-	City        string `json:"city,omitempty"`    // 搜索城市（可选，默认全国）
-	Citylimit   string `json:"citylimit,omitempty"`  // 仅在指定城市内搜索（可选，0/1，默认0）
-	Offset      string `json:"offset,omitempty"`    // 每页条数（可选，1-50，默认20）
-	Page        string `json:"page,omitempty"`      // 页码（可选，默认1）
-	Extensions  string `json:"extensions,omitempty"`  // 返回结果类型（可选，base/all，默认base）
-	Filter      string `json:"filter,omitempty"`     // 过滤条件（可选，如"price:100-200"）
-	Origin      string `json:"origin,omitempty"`     // 起点坐标（可选，用于距离排序）
-	Sortrule    string `json:"sortrule,omitempty"`   // 排序规则（可选，0：综合排序，1：距离排序）
-	Adcode      string `json:"adcode,omitempty"`     // 行政区划编码筛选（可选）
-	Building    string `json:"building,omitempty"`   // 建筑物筛选（可选）
-	Language    string `json:"language,omitempty"`   // 语言（可选，默认中文）
+	Keyword    string           `json:"keywords,omitempty"`    // 搜索关键词（与 Types 至少填一个）
+	Types      string           `json:"types,omitempty"`       // POI类型（与 Keyword 至少填一个，多个类型用|分隔）
+	Region     string           `json:"region,omitempty"`      // 搜索区划（可选，城市名称、citycode或adcode，默认全国）
+	CityLimit  bool             `json:"city_limit,omitempty"`  // 仅返回 Region 内的POI（可选，默认false）
+	ShowFields place.ShowFields `json:"show_fields,omitempty"` // 返回的扩展信息（可选，默认只返回基础信息）
+	PageSize   int              `json:"page_size,omitempty"`   // 每页条数（可选，1-25，默认10）
+	PageNum    int              `json:"page_num,omitempty"`    // 页码（可选，1-100，默认1）
+	Filter     string           `json:"filter,omitempty"`      // 过滤条件（可选，如"price:100-200"）
+	Origin     amapType.LngLat  `json:"origin,omitempty"`      // 起点坐标（可选，用于距离排序）
+	Sortrule   string           `json:"sortrule,omitempty"`    // 排序规则（可选，0：综合排序，1：距离排序）
+	Adcode     string           `json:"adcode,omitempty"`      // 行政区划编码筛选（可选）
+	Building   string           `json:"building,omitempty"`    // 建筑物筛选（可选）
+	Language   string           `json:"language,omitempty"`    // 语言（可选，默认中文）
 }
 
 // ToParams 将文本搜索请求参数转换为map[string]string格式
 func (req *TextSearchRequest) ToParams() map[string]string {
 	params := make(map[string]string)
-	params["keyword"] = req.Keyword // 关键词为必填项，直接添加
+	if req.Keyword != "" {
+		params["keywords"] = req.Keyword
+	}
 	if req.Types != "" {
 		params["types"] = req.Types
 	}
-	if req.City != "" {
-		params["city"] = req.City
+	if req.Region != "" {
+		params["region"] = req.Region
 	}
-	if req.Citylimit != "" {
-		params["citylimit"] = req.Citylimit
+	if req.CityLimit {
+		params["city_limit"] = "true"
 	}
-	if req.Offset != "" {
-		params["offset"] = req.Offset
+	if len(req.ShowFields) > 0 {
+		params["show_fields"] = req.ShowFields.String()
 	}
-	if req.Page != "" {
-		params["page"] = req.Page
+	if req.PageSize > 0 {
+		params["page_size"] = strconv.Itoa(req.PageSize)
 	}
-	if req.Extensions != "" {
-		params["extensions"] = req.Extensions
+	if req.PageNum > 0 {
+		params["page_num"] = strconv.Itoa(req.PageNum)
 	}
 	if req.Filter != "" {
 		params["filter"] = req.Filter
 	}
-	if req.Origin != "" {
-		params["origin"] = req.Origin
+	if !req.Origin.IsZero() {
+		params["origin"] = req.Origin.String()
 	}
 	if req.Sortrule != "" {
 		params["sortrule"] = req.Sortrule
@@ -62,3 +73,19 @@ func (req *TextSearchRequest) ToParams() map[string]string {
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 place.v5.text）
+func (req *TextSearchRequest) Endpoint() string {
+	return "place.v5.text"
+}
+
+// Validate 校验必填参数
+func (req *TextSearchRequest) Validate() error {
+	if req.Keyword == "" && req.Types == "" {
+		return amapErr.NewInvalidConfigError("POI文本搜索v5：keywords和types不能同时为空")
+	}
+	if err := req.Origin.Validate(); err != nil {
+		return amapErr.NewInvalidConfigError(fmt.Sprintf("POI文本搜索v5：origin坐标错误：%v", err))
+	}
+	return nil
+}
@@ -72,3 +72,13 @@ func (req *HardwarePositionRequest) ToParams() map[string]string {
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 position）
+func (req *HardwarePositionRequest) Endpoint() string {
+	return "position"
+}
+
+// Validate 校验请求参数（该接口无必填参数）
+func (req *HardwarePositionRequest) Validate() error {
+	return nil
+}
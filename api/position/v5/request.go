@@ -100,3 +100,13 @@ func (req *HardwarePositionRequest) ToParams() map[string]string {
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 position.v5）
+func (req *HardwarePositionRequest) Endpoint() string {
+	return "position.v5"
+}
+
+// Validate 校验请求参数（该接口无必填参数）
+func (req *HardwarePositionRequest) Validate() error {
+	return nil
+}
@@ -1,30 +1,37 @@
 package re_geo_code
 
 import (
+	"fmt"
 	"strconv"
+
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
 )
 
 // ReGeocodeRequest 逆地理编码请求参数
 // 文档：https://lbs.amap.com/api/webservice/guide/api/georegeo#t5
 type ReGeocodeRequest struct {
-	Location   string `json:"location"`   // 必填：经纬度（格式："经度,纬度"，如"116.481028,39.921983"）
-	Poitype    string `json:"poitype"`    // 可选：POI类型过滤（如"餐饮|酒店"，仅extensions=all时生效）
-	Radius     int    `json:"radius"`     // 可选：搜索半径（单位：米，默认1000，最大3000）
-	Extensions string `json:"extensions"` // 可选：返回信息类型（默认"base"基础信息，"all"详细信息）
-	RoadLevel  int    `json:"roadlevel"`  // 可选：是否返回道路等级（默认false，仅extensions=all时生效）
-	HomeOrCorp string `json:"homeorcorp"` // 可选：是否优化 POI 返回顺序
-	Callback   string `json:"callback"`   // 可选：JSONP回调函数名
+	Location   amapType.LngLat `json:"location"`   // 必填：经纬度（如 {Lng: 116.481028, Lat: 39.921983}）
+	Poitype    string          `json:"poitype"`    // 可选：POI类型过滤（如"餐饮|酒店"，仅extensions=all时生效）
+	Radius     int             `json:"radius"`     // 可选：搜索半径（单位：米，默认1000，最大3000）
+	Extensions string          `json:"extensions"` // 可选：返回信息类型（默认"base"基础信息，"all"详细信息）
+	RoadLevel  int             `json:"roadlevel"`  // 可选：是否返回道路等级（默认false，仅extensions=all时生效）
+	HomeOrCorp string          `json:"homeorcorp"` // 可选：是否优化 POI 返回顺序
+	Callback   string          `json:"callback"`   // 可选：JSONP回调函数名
 }
 
 // ToParams 将请求参数转换为map[string]string格式
 func (req *ReGeocodeRequest) ToParams() map[string]string {
 	params := make(map[string]string)
-	if req.Location != "" {
-		params["location"] = req.Location
+	if !req.Location.IsZero() {
+		params["location"] = req.Location.String()
 	}
+	// 未设置时使用默认值：搜索半径1000米，返回基础信息
+	params["radius"] = "1000"
 	if req.Radius > 0 {
 		params["radius"] = strconv.Itoa(req.Radius)
 	}
+	params["extensions"] = "base"
 	if req.Extensions != "" {
 		params["extensions"] = req.Extensions
 	}
@@ -39,3 +46,76 @@ func (req *ReGeocodeRequest) ToParams() map[string]string {
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 geocode.regeo）
+func (req *ReGeocodeRequest) Endpoint() string {
+	return "geocode.regeo"
+}
+
+// Validate 校验必填参数
+func (req *ReGeocodeRequest) Validate() error {
+	if req.Location.IsZero() {
+		return amapErr.NewInvalidConfigError("逆地理编码：location参数不能为空")
+	}
+	if err := req.Location.Validate(); err != nil {
+		return amapErr.NewInvalidConfigError(fmt.Sprintf("逆地理编码：location坐标错误：%v", err))
+	}
+	return nil
+}
+
+// MaxBatchLocations 批量逆地理编码单次请求的最大坐标数
+const MaxBatchLocations = 20
+
+// BatchReGeocodeRequest 批量逆地理编码请求参数（batch=true，坐标以 | 分隔）
+type BatchReGeocodeRequest struct {
+	Locations  []amapType.LngLat `json:"location"`   // 必填：经纬度列表（最多 MaxBatchLocations 个）
+	Poitype    string            `json:"poitype"`    // 可选：POI类型过滤（仅extensions=all时生效）
+	Radius     int               `json:"radius"`     // 可选：搜索半径（单位：米，默认1000，最大3000）
+	Extensions string            `json:"extensions"` // 可选：返回信息类型（默认"base"基础信息，"all"详细信息）
+	RoadLevel  int               `json:"roadlevel"`  // 可选：是否返回道路等级（仅extensions=all时生效）
+	HomeOrCorp string            `json:"homeorcorp"` // 可选：是否优化 POI 返回顺序
+}
+
+// ToParams 将请求参数转换为map[string]string格式
+func (req *BatchReGeocodeRequest) ToParams() map[string]string {
+	params := make(map[string]string)
+	params["location"] = amapType.LngLatList(req.Locations).Join("|")
+	params["batch"] = "true"
+	if req.Radius > 0 {
+		params["radius"] = strconv.Itoa(req.Radius)
+	}
+	if req.Extensions != "" {
+		params["extensions"] = req.Extensions
+	}
+	if req.RoadLevel > 0 {
+		params["roadlevel"] = strconv.Itoa(req.RoadLevel)
+	}
+	if req.Poitype != "" {
+		params["poitype"] = req.Poitype
+	}
+	if req.HomeOrCorp != "" {
+		params["homeorcorp"] = req.HomeOrCorp
+	}
+	return params
+}
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 geocode.regeo）
+func (req *BatchReGeocodeRequest) Endpoint() string {
+	return "geocode.regeo"
+}
+
+// Validate 校验必填参数
+func (req *BatchReGeocodeRequest) Validate() error {
+	if len(req.Locations) == 0 {
+		return amapErr.NewInvalidConfigError("批量逆地理编码：location参数不能为空")
+	}
+	if len(req.Locations) > MaxBatchLocations {
+		return amapErr.NewInvalidConfigError(fmt.Sprintf("批量逆地理编码：单次最多 %d 个坐标", MaxBatchLocations))
+	}
+	for i, location := range req.Locations {
+		if err := location.Validate(); err != nil {
+			return amapErr.NewInvalidConfigError(fmt.Sprintf("批量逆地理编码：第 %d 个坐标错误：%v", i+1, err))
+		}
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package re_geo_code
 
 import (
+	"github.com/enneket/amap/api/place"
 	amapType "github.com/enneket/amap/types"
 )
 
@@ -11,9 +12,22 @@ type ReGeocodeResponse struct {
 	ReGeocode             ReGeocodeData `json:"regeocode"` // 逆地理编码核心数据
 }
 
+// ReGeocodeBatchResponse 批量逆地理编码响应（batch=true 时返回 regeocodes 数组，与请求坐标一一对应）
+type ReGeocodeBatchResponse struct {
+	amapType.BaseResponse                 // 继承基础响应（Status/Info/InfoCode）
+	ReGeocodes            []ReGeocodeData `json:"regeocodes"` // 逆地理编码结果列表（与请求坐标顺序一致）
+}
+
+// BatchResult 批量逆地理编码单个坐标的结果
+type BatchResult struct {
+	Location amapType.LngLat // 输入坐标
+	Data     *ReGeocodeData  // 逆地理编码结果（请求失败时为 nil）
+	Err      error           // 所在批次请求失败时的错误
+}
+
 // ReGeocodeData 逆地理编码核心数据
 type ReGeocodeData struct {
-	FormattedAddress  string              `json:"formatted_address"` // 格式化地址（省+市+区+乡镇+街道+门牌号）
+	FormattedAddress  amapType.FlexString `json:"formatted_address"` // 格式化地址（省+市+区+乡镇+街道+门牌号）
 	AddressComponent  AddressComponent    `json:"addressComponent"`  // 地址元素列表
 	Roads             []RoadItem          `json:"roads"`             // 道路列表（仅extensions=all时返回）
 	RoadIntersections []RoadIntersectItem `json:"roadinters"`        // 道路交叉口列表（仅extensions=all时返回）
@@ -23,89 +37,119 @@ type ReGeocodeData struct {
 
 // AddressComponent 地址组件详情
 type AddressComponent struct {
-	Country      string           `json:"country"`      // 国家（默认中国）
-	Province     string           `json:"province"`     // 省份（如"北京市"）
-	City         []string         `json:"city"`         // 城市（如"北京市"）
-	Citycode     string           `json:"citycode"`     // 城市编码（如"110000"）
-	District     string           `json:"district"`     // 区县（如"朝阳区"）
-	Adcode       string           `json:"adcode"`       // 行政区划编码（如"110105"）
-	Township     string           `json:"township"`     // 乡镇（如"望京街道"）
-	Towncode     string           `json:"towncode"`     // 乡镇编码（如"110105028"）
-	Neighborhood NeighborhoodItem `json:"neighborhood"` // 周边小区信息（仅extensions=all时返回）
-	Building     BuildingItem     `json:"building"`     // 建筑物信息（仅extensions=all时返回）
-	StreetNumber StreetNumberItem `json:"streetNumber"` // 门牌信息列表
-	SeaArea      string           `json:"seaArea"`      // 所属海域信息"渤海"）
-	BusinessArea BusinessAreaItem `json:"businessArea"` // 商圈（如"望京"）
+	Country      amapType.FlexString     `json:"country"`      // 国家（默认中国）
+	Province     amapType.FlexString     `json:"province"`     // 省份（如"北京市"）
+	City         amapType.FlexStringList `json:"city"`         // 城市（如"北京市"）
+	Citycode     amapType.FlexString     `json:"citycode"`     // 城市编码（如"110000"）
+	District     amapType.FlexString     `json:"district"`     // 区县（如"朝阳区"）
+	Adcode       amapType.FlexString     `json:"adcode"`       // 行政区划编码（如"110105"）
+	Township     amapType.FlexString     `json:"township"`     // 乡镇（如"望京街道"）
+	Towncode     amapType.FlexString     `json:"towncode"`     // 乡镇编码（如"110105028"）
+	Neighborhood NeighborhoodItem        `json:"neighborhood"` // 周边小区信息（仅extensions=all时返回）
+	Building     BuildingItem            `json:"building"`     // 建筑物信息（仅extensions=all时返回）
+	StreetNumber StreetNumberItem        `json:"streetNumber"` // 门牌信息列表
+	SeaArea      amapType.FlexString     `json:"seaArea"`      // 所属海域信息"渤海"）
+	BusinessArea BusinessAreaItem        `json:"businessArea"` // 商圈（如"望京"）
 }
 
 // POIItem POI信息（仅extensions=all时返回）
 type POIItem struct {
-	ID           string `json:"id"`           // POI唯一标识
-	Name         string `json:"name"`         // POI名称（如"望京SOHO"）
-	Type         string `json:"type"`         // POI类型（如"写字楼|商务办公"）
-	Tel          any    `json:"tel"`          // 联系电话
-	Distance     string `json:"distance"`     // 与请求坐标的距离（单位：米）
-	Direction    string `json:"direction"`    // 相对于请求坐标的方向（如"东北"）
-	Address      string `json:"address"`      // POI地址
-	Location     string `json:"location"`     // POI经纬度（"经度,纬度"）
-	BusinessArea string `json:"businessarea"` // POI所在商圈名称
+	ID           amapType.FlexString `json:"id"`           // POI唯一标识
+	Name         amapType.FlexString `json:"name"`         // POI名称（如"望京SOHO"）
+	Type         amapType.FlexString `json:"type"`         // POI类型（如"写字楼|商务办公"）
+	Tel          amapType.FlexString `json:"tel"`          // 联系电话
+	Distance     amapType.FlexString `json:"distance"`     // 与请求坐标的距离（单位：米）
+	Direction    amapType.FlexString `json:"direction"`    // 相对于请求坐标的方向（如"东北"）
+	Address      amapType.FlexString `json:"address"`      // POI地址
+	Location     amapType.FlexString `json:"location"`     // POI经纬度（"经度,纬度"）
+	BusinessArea amapType.FlexString `json:"businessarea"` // POI所在商圈名称
 }
 
 // RoadItem 道路信息（仅extensions=all时返回）
 type RoadItem struct {
-	ID        string `json:"id"`        // 道路唯一标识
-	Name      string `json:"name"`      // 道路名称（如"京密路"）
-	Distance  string `json:"distance"`  // 与请求坐标的距离（米）
-	Direction string `json:"direction"` // 方位
-	Location  string `json:"location"`  // 坐标点
+	ID        amapType.FlexString `json:"id"`        // 道路唯一标识
+	Name      amapType.FlexString `json:"name"`      // 道路名称（如"京密路"）
+	Distance  amapType.FlexString `json:"distance"`  // 与请求坐标的距离（米）
+	Direction amapType.FlexString `json:"direction"` // 方位
+	Location  amapType.FlexString `json:"location"`  // 坐标点
 }
 
 // RoadIntersectItem 道路交叉口信息（仅extensions=all时返回）
 type RoadIntersectItem struct {
-	Distance   string `json:"distance"`   // 交叉路口到请求坐标的距离
-	Direction  string `json:"direction"`  // 方位
-	Location   string `json:"location"`   // 路口经纬度
-	FirstID    string `json:"firstID"`    // 第一条道路ID
-	FirstName  string `json:"firstName"`  // 第一条道路名称
-	SecondID   string `json:"secondID"`   // 第二条道路ID
-	SecondName string `json:"secondName"` // 第二条道路名称
+	Distance   amapType.FlexString `json:"distance"`   // 交叉路口到请求坐标的距离
+	Direction  amapType.FlexString `json:"direction"`  // 方位
+	Location   amapType.FlexString `json:"location"`   // 路口经纬度
+	FirstID    amapType.FlexString `json:"firstID"`    // 第一条道路ID
+	FirstName  amapType.FlexString `json:"firstName"`  // 第一条道路名称
+	SecondID   amapType.FlexString `json:"secondID"`   // 第二条道路ID
+	SecondName amapType.FlexString `json:"secondName"` // 第二条道路名称
 }
 
 // BuildingItem 建筑物信息（仅extensions=all时返回）
 type BuildingItem struct {
-	Name     string `json:"name"`     // 建筑物名称（如"望京SOHO"）
-	Type     string `json:"type"`     // 建筑物类型（如"写字楼"）
-	Location string `json:"location"` // 建筑物中心点经纬度
+	Name     amapType.FlexString `json:"name"`     // 建筑物名称（如"望京SOHO"）
+	Type     amapType.FlexString `json:"type"`     // 建筑物类型（如"写字楼"）
+	Location amapType.FlexString `json:"location"` // 建筑物中心点经纬度
 }
 
 // NeighborhoodItem 周边小区信息（仅extensions=all时返回）
 type NeighborhoodItem struct {
-	Name string `json:"name"` // 小区名称
-	Type string `json:"type"` // 小区类型（如"住宅区"）
+	Name amapType.FlexString `json:"name"` // 小区名称
+	Type amapType.FlexString `json:"type"` // 小区类型（如"住宅区"）
 }
 
 // StreetNumberItem 门牌信息
 type StreetNumberItem struct {
-	Street    string `json:"street"`    // 街道（如"望京街"）
-	Number    string `json:"number"`    // 门牌号（如"8号"）
-	Location  string `json:"location"`  // 坐标点
-	Direction string `json:"direction"` // 方向
-	Distance  string `json:"distance"`  // 门牌地址到请求坐标的距离
+	Street    amapType.FlexString `json:"street"`    // 街道（如"望京街"）
+	Number    amapType.FlexString `json:"number"`    // 门牌号（如"8号"）
+	Location  amapType.FlexString `json:"location"`  // 坐标点
+	Direction amapType.FlexString `json:"direction"` // 方向
+	Distance  amapType.FlexString `json:"distance"`  // 门牌地址到请求坐标的距离
 }
 
 type BusinessAreaItem struct {
-	BusinessArea string `json:"businessArea"` // 商圈（如"望京"）
-	Location     string `json:"location"`     // 商圈中心点经纬度
-	Name         string `json:"name"`         // 商圈名称（如"望京"）
-	ID           string `json:"id"`           // 商圈所在区域的 adcode
+	BusinessArea amapType.FlexString `json:"businessArea"` // 商圈（如"望京"）
+	Location     amapType.FlexString `json:"location"`     // 商圈中心点经纬度
+	Name         amapType.FlexString `json:"name"`         // 商圈名称（如"望京"）
+	ID           amapType.FlexString `json:"id"`           // 商圈所在区域的 adcode
 }
 
 type AoiItem struct {
-	ID       string `json:"id"`       // AOI唯一标识
-	Name     string `json:"name"`     // AOI名称（如"望京SOHO"）
-	AdCode   string `json:"adcode"`   // AOI所在区域的 adcode
-	Location string `json:"location"` // AOI经纬度（"经度,纬度"）
-	Area     string `json:"area"`     // AOI面积（单位：平方米）
-	Distance string `json:"distance"` // 与请求坐标的距离（单位：米）
-	Type     string `json:"type"`     // AOI类型（如"写字楼|商务办公"）
+	ID       amapType.FlexString `json:"id"`       // AOI唯一标识
+	Name     amapType.FlexString `json:"name"`     // AOI名称（如"望京SOHO"）
+	AdCode   amapType.FlexString `json:"adcode"`   // AOI所在区域的 adcode
+	Location amapType.FlexString `json:"location"` // AOI经纬度（"经度,纬度"）
+	Area     amapType.FlexString `json:"area"`     // AOI面积（单位：平方米）
+	Distance amapType.FlexString `json:"distance"` // 与请求坐标的距离（单位：米）
+	Type     amapType.FlexString `json:"type"`     // AOI类型（如"写字楼|商务办公"）
+}
+
+// POI 转换为统一的 place.POI（逆地理编码的POI只含基础字段，其余字段为零值）
+func (item *POIItem) POI() (place.POI, error) {
+	poi := item.placeItem()
+	return poi.POI()
+}
+
+// POIs 将周边POI列表转换为统一的 place.POI（与 Pois 一一对应，个别POI转换出错不影响其他POI）
+func (data *ReGeocodeData) POIs() ([]place.POI, error) {
+	items := make([]place.PoiItem, len(data.Pois))
+	for i := range data.Pois {
+		items[i] = data.Pois[i].placeItem()
+	}
+	return place.FromItems(items)
+}
+
+// placeItem 映射为 POI 搜索接口的 PoiItem 字段
+func (item *POIItem) placeItem() place.PoiItem {
+	return place.PoiItem{
+		ID:           item.ID,
+		Name:         item.Name,
+		Type:         item.Type,
+		Tel:          item.Tel,
+		Distance:     item.Distance,
+		Direction:    item.Direction,
+		Address:      item.Address,
+		Location:     item.Location,
+		BusinessArea: item.BusinessArea,
+	}
 }
@@ -1,12 +1,15 @@
 package circle
 
 import (
+	"fmt"
+
+	amapErr "github.com/enneket/amap/errors"
 	amapType "github.com/enneket/amap/types"
 )
 
 // CircleTrafficRequest 圆形区域内交通态势查询请求参数
 type CircleTrafficRequest struct {
-	Center          string                  `json:"center"`                   // 中心点坐标（必填，格式：lng,lat）
+	Center          amapType.LngLat         `json:"center"`                   // 中心点坐标（必填）
 	Radius          string                  `json:"radius"`                   // 半径（必填，单位：米，最大5000米）
 	Level           string                  `json:"level,omitempty"`         // 路况等级（可选，默认all）
 	CoordinateType  amapType.CoordinateType `json:"coordinate_type,omitempty"` // 输入/输出坐标系（可选，默认gcj02）
@@ -18,7 +21,7 @@ type CircleTrafficRequest struct {
 // ToParams 将请求参数转换为map[string]string格式
 func (req *CircleTrafficRequest) ToParams() map[string]string {
 	params := make(map[string]string)
-	params["center"] = req.Center // 中心点坐标为必填项
+	params["center"] = req.Center.String() // 中心点坐标为必填项
 	params["radius"] = req.Radius // 半径为必填项
 	if req.Level != "" {
 		params["level"] = req.Level
@@ -37,3 +40,22 @@ func (req *CircleTrafficRequest) ToParams() map[string]string {
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 traffic.circle）
+func (req *CircleTrafficRequest) Endpoint() string {
+	return "traffic.circle"
+}
+
+// Validate 校验必填参数
+func (req *CircleTrafficRequest) Validate() error {
+	if req.Center.IsZero() {
+		return amapErr.NewInvalidConfigError("圆形区域交通态势查询：center参数不能为空")
+	}
+	if err := req.Center.Validate(); err != nil {
+		return amapErr.NewInvalidConfigError(fmt.Sprintf("圆形区域交通态势查询：center坐标错误：%v", err))
+	}
+	if req.Radius == "" {
+		return amapErr.NewInvalidConfigError("圆形区域交通态势查询：radius参数不能为空")
+	}
+	return nil
+}
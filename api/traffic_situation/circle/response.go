@@ -1,48 +1,80 @@
 package circle
 
+import (
+	amapType "github.com/enneket/amap/types"
+	"github.com/enneket/amap/geo"
+	"github.com/enneket/amap/geojson"
+)
+
 // CircleTrafficResponse 圆形区域内交通态势查询响应结果
 type CircleTrafficResponse struct {
-	Status      string        `json:"status"`      // 状态码（1：成功；0：失败）
-	Info        string        `json:"info"`        // 状态信息
-	Infocode    string        `json:"infocode"`    // 状态码说明
+	Status      amapType.FlexString        `json:"status"`      // 状态码（1：成功；0：失败）
+	Info        amapType.FlexString        `json:"info"`        // 状态信息
+	Infocode    amapType.FlexString        `json:"infocode"`    // 状态码说明
 	Trafficinfo TrafficInfo   `json:"trafficinfo"` // 交通态势信息
 }
 
 // TrafficInfo 交通态势信息
 type TrafficInfo struct {
-	Description string      `json:"description"` // 路况描述
+	Description amapType.FlexString      `json:"description"` // 路况描述
 	Evaluation  Evaluation  `json:"evaluation"`  // 整体路况评估
 	Roads       []RoadInfo  `json:"roads"`       // 道路列表
 }
 
 // Evaluation 路况评估
 type Evaluation struct {
-	Expedite    int     `json:"expedite"`    // 畅通路段数
-	Congested   int     `json:"congested"`   // 拥堵路段数
-	Blocking    int     `json:"blocking"`    // 严重拥堵路段数
-	Unknown     int     `json:"unknown"`     // 未知路段数
-	Status      string  `json:"status"`      // 整体路况状态
-	Description string  `json:"description"` // 整体路况描述
+	Expedite    amapType.FlexInt     `json:"expedite"`    // 畅通路段数
+	Congested   amapType.FlexInt     `json:"congested"`   // 拥堵路段数
+	Blocking    amapType.FlexInt     `json:"blocking"`    // 严重拥堵路段数
+	Unknown     amapType.FlexInt     `json:"unknown"`     // 未知路段数
+	Status      amapType.FlexString  `json:"status"`      // 整体路况状态
+	Description amapType.FlexString  `json:"description"` // 整体路况描述
 }
 
 // RoadInfo 道路信息
 type RoadInfo struct {
-	Name        string     `json:"name"`        // 道路名称
-	Status      int        `json:"status"`      // 路况状态（0：未知；1：畅通；2：缓行；3：拥堵；4：严重拥堵）
-	Direction   string     `json:"direction"`   // 方向
-	Lcodes      []string   `json:"lcodes"`      // 道路编码列表
-	Polyline    string     `json:"polyline"`    // 道路坐标串
-	Speed       float64    `json:"speed"`       // 平均车速
+	Name        amapType.FlexString     `json:"name"`        // 道路名称
+	Status      amapType.FlexInt        `json:"status"`      // 路况状态（0：未知；1：畅通；2：缓行；3：拥堵；4：严重拥堵）
+	Direction   amapType.FlexString     `json:"direction"`   // 方向
+	Lcodes      amapType.FlexStringList   `json:"lcodes"`      // 道路编码列表
+	Polyline    amapType.FlexString     `json:"polyline"`    // 道路坐标串
+	Speed       amapType.FlexFloat    `json:"speed"`       // 平均车速
 	Jams        []JamInfo  `json:"jams"`        // 拥堵路段列表
 }
 
 // JamInfo 拥堵路段信息
 type JamInfo struct {
-	Polyline    string  `json:"polyline"`    // 拥堵路段坐标串
-	Status      int     `json:"status"`      // 拥堵状态（1：缓行；2：拥堵；3：严重拥堵）
-	Direction   string  `json:"direction"`   // 方向
-	Length      float64 `json:"length"`      // 拥堵长度
-	Speed       float64 `json:"speed"`       // 拥堵路段车速
-	Time        int     `json:"time"`        // 预计通过时间
-	Level       int     `json:"level"`       // 拥堵等级
+	Polyline    amapType.FlexString  `json:"polyline"`    // 拥堵路段坐标串
+	Status      amapType.FlexInt     `json:"status"`      // 拥堵状态（1：缓行；2：拥堵；3：严重拥堵）
+	Direction   amapType.FlexString  `json:"direction"`   // 方向
+	Length      amapType.FlexFloat `json:"length"`      // 拥堵长度
+	Speed       amapType.FlexFloat `json:"speed"`       // 拥堵路段车速
+	Time        amapType.FlexInt     `json:"time"`        // 预计通过时间
+	Level       amapType.FlexInt     `json:"level"`       // 拥堵等级
+}
+
+// ToGeoJSON 导出道路为 GeoJSON 线要素，按路况状态设置 stroke 颜色（属性含 name/status/direction/speed）
+func (road *RoadInfo) ToGeoJSON(opts ...geojson.Option) (*geojson.Feature, error) {
+	points, err := geo.DecodePolyline(road.Polyline.String())
+	if err != nil {
+		return nil, err
+	}
+	props := geojson.TrafficStyle(road.Status.Int())
+	props["name"] = road.Name
+	props["direction"] = road.Direction
+	props["speed"] = road.Speed
+	return geojson.NewFeature(geojson.LineString(points, opts...), props), nil
+}
+
+// ToGeoJSON 导出所有道路为 GeoJSON 要素集合（需 extensions=all 返回道路坐标）
+func (resp *CircleTrafficResponse) ToGeoJSON(opts ...geojson.Option) (*geojson.FeatureCollection, error) {
+	fc := geojson.NewFeatureCollection()
+	for i := range resp.Trafficinfo.Roads {
+		feature, err := resp.Trafficinfo.Roads[i].ToGeoJSON(opts...)
+		if err != nil {
+			return nil, err
+		}
+		fc.Add(feature)
+	}
+	return fc, nil
 }
@@ -1,12 +1,15 @@
 package line
 
 import (
+	"fmt"
+
+	amapErr "github.com/enneket/amap/errors"
 	amapType "github.com/enneket/amap/types"
 )
 
 // LineTrafficRequest 指定线路交通态势查询请求参数
 type LineTrafficRequest struct {
-	Path            string                  `json:"path"`                    // 路径坐标串（必填，格式：lng1,lat1;lng2,lat2;...）
+	Path            amapType.LngLatList     `json:"path"`                    // 路径坐标串（必填，以 ; 分隔发送）
 	Level           string                  `json:"level,omitempty"`         // 路况等级（可选，默认all）
 	CoordinateType  amapType.CoordinateType `json:"coordinate_type,omitempty"` // 输入/输出坐标系（可选，默认gcj02）
 	Output          amapType.OutputType     `json:"output,omitempty"`          // 输出格式（可选，默认JSON）
@@ -17,7 +20,7 @@ type LineTrafficRequest struct {
 // ToParams 将请求参数转换为map[string]string格式
 func (req *LineTrafficRequest) ToParams() map[string]string {
 	params := make(map[string]string)
-	params["path"] = req.Path // 路径坐标串为必填项
+	params["path"] = req.Path.Join(";") // 路径坐标串为必填项
 	if req.Level != "" {
 		params["level"] = req.Level
 	}
@@ -35,3 +38,21 @@ func (req *LineTrafficRequest) ToParams() map[string]string {
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 traffic.road）
+func (req *LineTrafficRequest) Endpoint() string {
+	return "traffic.road"
+}
+
+// Validate 校验必填参数
+func (req *LineTrafficRequest) Validate() error {
+	if len(req.Path) == 0 {
+		return amapErr.NewInvalidConfigError("指定线路交通态势查询：path参数不能为空")
+	}
+	for _, p := range req.Path {
+		if err := p.Validate(); err != nil {
+			return amapErr.NewInvalidConfigError(fmt.Sprintf("指定线路交通态势查询：path坐标错误：%v", err))
+		}
+	}
+	return nil
+}
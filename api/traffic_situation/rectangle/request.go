@@ -1,12 +1,15 @@
 package rectangle
 
 import (
+	"fmt"
+
+	amapErr "github.com/enneket/amap/errors"
 	amapType "github.com/enneket/amap/types"
 )
 
 // RectangleTrafficRequest 矩形区域内交通态势查询请求参数
 type RectangleTrafficRequest struct {
-	Rectangle       string                  `json:"rectangle"`                // 矩形区域（必填，格式：左下角lng,左下角lat;右上角lng,右上角lat）
+	Rectangle       amapType.Bounds         `json:"rectangle"`                // 矩形区域（必填，以"左下角;右上角"格式发送）
 	Level           string                  `json:"level,omitempty"`         // 路况等级（可选，默认all）
 	CoordinateType  amapType.CoordinateType `json:"coordinate_type,omitempty"` // 输入/输出坐标系（可选，默认gcj02）
 	Output          amapType.OutputType     `json:"output,omitempty"`          // 输出格式（可选，默认JSON）
@@ -17,7 +20,7 @@ type RectangleTrafficRequest struct {
 // ToParams 将请求参数转换为map[string]string格式
 func (req *RectangleTrafficRequest) ToParams() map[string]string {
 	params := make(map[string]string)
-	params["rectangle"] = req.Rectangle // 矩形区域为必填项
+	params["rectangle"] = req.Rectangle.String() // 矩形区域为必填项
 	if req.Level != "" {
 		params["level"] = req.Level
 	}
@@ -35,3 +38,19 @@ func (req *RectangleTrafficRequest) ToParams() map[string]string {
 	}
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 traffic.rectangle）
+func (req *RectangleTrafficRequest) Endpoint() string {
+	return "traffic.rectangle"
+}
+
+// Validate 校验必填参数
+func (req *RectangleTrafficRequest) Validate() error {
+	if req.Rectangle.IsZero() {
+		return amapErr.NewInvalidConfigError("矩形区域交通态势查询：rectangle参数不能为空")
+	}
+	if err := req.Rectangle.Validate(); err != nil {
+		return amapErr.NewInvalidConfigError(fmt.Sprintf("矩形区域交通态势查询：rectangle错误：%v", err))
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package weatherinfo
 
 import (
+	amapErr "github.com/enneket/amap/errors"
 	amapType "github.com/enneket/amap/types"
 )
 
@@ -35,3 +36,16 @@ func (req *WeatherinfoRequest) ToParams() map[string]string {
 
 	return params
 }
+
+// Endpoint 接口名称（对应 amap.Endpoints 中的 weather）
+func (req *WeatherinfoRequest) Endpoint() string {
+	return "weather"
+}
+
+// Validate 校验必填参数
+func (req *WeatherinfoRequest) Validate() error {
+	if req.City == "" {
+		return amapErr.NewInvalidConfigError("天气信息：city参数不能为空")
+	}
+	return nil
+}
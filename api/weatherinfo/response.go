@@ -16,49 +16,49 @@ type WeatherinfoResponse struct {
 
 // WeatherInfo 实时天气信息
 type WeatherInfo struct {
-	City        string `json:"city"`         // 城市名称（如"北京市"）
-	CityID      string `json:"cityid"`       // 城市ID（如"101010100"）
-	Temp        string `json:"temp"`         // 实时温度（如"22"）
-	WD          string `json:"WD"`           // 风向（如"东南风"）
-	WS          string `json:"WS"`           // 风力（如"1级"）
-	SD          string `json:"SD"`           // 湿度（如"40%"）
-	AP          string `json:"AP"`           // 气压（如"1013hPa"）
-	NJD         string `json:"njd"`          // 能见度（如"10km"）
-	WSE         string `json:"WSE"`          // 风力等级（如"1"）
-	Time        string `json:"time"`         // 数据发布时间（如"10:30"）
-	IsRadar     string `json:"isRadar"`      // 是否有雷达图（如"1"表示有）
-	Radar       string `json:"Radar"`        // 雷达图URL（如"JC_RADAR_AZ9010_JB"）
-	Weather     string `json:"weather"`      // 天气状况（如"晴"）
-	Temperature string `json:"temperature"`  // 温度范围（如"10~22℃"）
-	Winddirection string `json:"winddirection"` // 风向（如"东南"）
-	Windpower   string `json:"windpower"`    // 风力（如"1-2级"）
-	Humidity    string `json:"humidity"`     // 湿度（如"40%"）
+	City        amapType.FlexString `json:"city"`         // 城市名称（如"北京市"）
+	CityID      amapType.FlexString `json:"cityid"`       // 城市ID（如"101010100"）
+	Temp        amapType.FlexString `json:"temp"`         // 实时温度（如"22"）
+	WD          amapType.FlexString `json:"WD"`           // 风向（如"东南风"）
+	WS          amapType.FlexString `json:"WS"`           // 风力（如"1级"）
+	SD          amapType.FlexString `json:"SD"`           // 湿度（如"40%"）
+	AP          amapType.FlexString `json:"AP"`           // 气压（如"1013hPa"）
+	NJD         amapType.FlexString `json:"njd"`          // 能见度（如"10km"）
+	WSE         amapType.FlexString `json:"WSE"`          // 风力等级（如"1"）
+	Time        amapType.FlexString `json:"time"`         // 数据发布时间（如"10:30"）
+	IsRadar     amapType.FlexString `json:"isRadar"`      // 是否有雷达图（如"1"表示有）
+	Radar       amapType.FlexString `json:"Radar"`        // 雷达图URL（如"JC_RADAR_AZ9010_JB"）
+	Weather     amapType.FlexString `json:"weather"`      // 天气状况（如"晴"）
+	Temperature amapType.FlexString `json:"temperature"`  // 温度范围（如"10~22℃"）
+	Winddirection amapType.FlexString `json:"winddirection"` // 风向（如"东南"）
+	Windpower   amapType.FlexString `json:"windpower"`    // 风力（如"1-2级"）
+	Humidity    amapType.FlexString `json:"humidity"`     // 湿度（如"40%"）
 }
 
 // Forecast 预报信息
 type Forecast struct {
-	City          string `json:"city"`          // 城市名称
-	Adcode        string `json:"adcode"`        // 行政区划编码
-	Province      string `json:"province"`      // 省份名称
-	Reporttime    string `json:"reporttime"`    // 预报发布时间
-	Castype       string `json:"castype"`       // 预报类型（如"1"表示24小时预报）
+	City          amapType.FlexString `json:"city"`          // 城市名称
+	Adcode        amapType.FlexString `json:"adcode"`        // 行政区划编码
+	Province      amapType.FlexString `json:"province"`      // 省份名称
+	Reporttime    amapType.FlexString `json:"reporttime"`    // 预报发布时间
+	Castype       amapType.FlexString `json:"castype"`       // 预报类型（如"1"表示24小时预报）
 	Forecast      []DailyForecast `json:"forecast"` // 每日预报列表
 }
 
 // DailyForecast 每日预报
 type DailyForecast struct {
-	Date          string `json:"date"`          // 日期（如"2023-05-20"）
-	Week          string `json:"week"`          // 星期几（如"六"）
-	Dayweather    string `json:"dayweather"`    // 白天天气状况（如"晴"）
-	Nightweather  string `json:"nightweather"`  // 夜间天气状况（如"晴"）
-	Daytemp       string `json:"daytemp"`       // 白天温度（如"22"）
-	Nighttemp     string `json:"nighttemp"`     // 夜间温度（如"10"）
-	Daywind       string `json:"daywind"`       // 白天风向（如"东南风"）
-	Nightwind     string `json:"nightwind"`     // 夜间风向（如"东南风"）
-	Daypower      string `json:"daypower"`      // 白天风力（如"1级"）
-	Nightpower    string `json:"nightpower"`    // 夜间风力（如"1级"）
-	Daytemp_float float64 `json:"daytemp_float"` // 白天温度（浮点数）
-	Nighttemp_float float64 `json:"nighttemp_float"` // 夜间温度（浮点数）
+	Date          amapType.FlexString `json:"date"`          // 日期（如"2023-05-20"）
+	Week          amapType.FlexString `json:"week"`          // 星期几（如"六"）
+	Dayweather    amapType.FlexString `json:"dayweather"`    // 白天天气状况（如"晴"）
+	Nightweather  amapType.FlexString `json:"nightweather"`  // 夜间天气状况（如"晴"）
+	Daytemp       amapType.FlexString `json:"daytemp"`       // 白天温度（如"22"）
+	Nighttemp     amapType.FlexString `json:"nighttemp"`     // 夜间温度（如"10"）
+	Daywind       amapType.FlexString `json:"daywind"`       // 白天风向（如"东南风"）
+	Nightwind     amapType.FlexString `json:"nightwind"`     // 夜间风向（如"东南风"）
+	Daypower      amapType.FlexString `json:"daypower"`      // 白天风力（如"1级"）
+	Nightpower    amapType.FlexString `json:"nightpower"`    // 夜间风力（如"1级"）
+	Daytemp_float amapType.FlexFloat `json:"daytemp_float"` // 白天温度（浮点数）
+	Nighttemp_float amapType.FlexFloat `json:"nighttemp_float"` // 夜间温度（浮点数）
 }
 
 // Suggestion 生活指数建议
@@ -83,7 +83,7 @@ type Suggestion struct {
 
 // SuggestionItem 生活指数建议项
 type SuggestionItem struct {
-	Brf  string `json:"brf"`  // 简短描述（如"舒适"）
-	Txt  string `json:"txt"`  // 详细描述（如"白天温度适宜，风力不大，相信您在这样的天气条件下，应会感到比较清爽和舒适。"）
-	Type string `json:"type"` // 指数类型（如"comf"）
+	Brf  amapType.FlexString `json:"brf"`  // 简短描述（如"舒适"）
+	Txt  amapType.FlexString `json:"txt"`  // 详细描述（如"白天温度适宜，风力不大，相信您在这样的天气条件下，应会感到比较清爽和舒适。"）
+	Type amapType.FlexString `json:"type"` // 指数类型（如"comf"）
 }
@@ -0,0 +1,230 @@
+package amap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/enneket/amap/api/convert"
+	geoCode "github.com/enneket/amap/api/geo_code"
+	reGeoCode "github.com/enneket/amap/api/re_geo_code"
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+	"github.com/enneket/amap/utils"
+)
+
+// defaultBatchConcurrency 批量接口默认的最大并发请求数
+const defaultBatchConcurrency = 4
+
+// batchConcurrency 返回批量接口的最大并发请求数
+func (c *Client) batchConcurrency() int {
+	if c.config.BatchConcurrency > 0 {
+		return c.config.BatchConcurrency
+	}
+	return defaultBatchConcurrency
+}
+
+// runChunks 将 n 个元素按 size 分块，以有限并发对每块调用 fn(ctx, start, end)
+// 所有块执行完毕后返回各块错误的合并（相同的错误只保留一个）；ctx 取消后剩余的块仍会调用 fn，由请求立即返回 CanceledError
+func (c *Client) runChunks(ctx context.Context, n, size int, fn func(ctx context.Context, start, end int) error) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+		seen = map[string]bool{}
+	)
+	sem := make(chan struct{}, c.batchConcurrency())
+	for start := 0; start < n; start += size {
+		end := min(start+size, n)
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := fn(ctx, start, end); err != nil {
+				mu.Lock()
+				if !seen[err.Error()] {
+					seen[err.Error()] = true
+					errs = append(errs, err)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// GeoCodeBatch 批量地理编码：按每批 10 个地址（batch=true）分块，并发请求后按输入顺序返回结果
+// 返回的结果与 addresses 一一对应：未匹配的地址 Found() 为 false，空地址不发送请求；
+// 某批请求失败时该批结果的 Err 被设置，并在返回的 error 中合并所有批次的错误
+func (c *Client) GeoCodeBatch(ctx context.Context, addresses []string, city string) ([]geoCode.BatchResult, error) {
+	results := make([]geoCode.BatchResult, len(addresses))
+	// 1. 过滤空地址，校验地址中不含分隔符
+	var indexes []int
+	for i, address := range addresses {
+		results[i].Address = address
+		if strings.Contains(address, "|") {
+			return nil, amapErr.NewInvalidConfigError(fmt.Sprintf("批量地理编码：第 %d 个地址包含分隔符 |", i+1))
+		}
+		if strings.TrimSpace(address) != "" {
+			indexes = append(indexes, i)
+		}
+	}
+	// 2. 分块请求，结果按下标写回
+	err := c.runChunks(ctx, len(indexes), geoCode.MaxBatchAddresses, func(ctx context.Context, start, end int) error {
+		chunk := indexes[start:end]
+		req := &geoCode.BatchGeocodeRequest{City: city}
+		for _, i := range chunk {
+			req.Addresses = append(req.Addresses, addresses[i])
+		}
+		resp, err := Do[geoCode.GeoCodeBatchResponse](ctx, c, req)
+		if err == nil && len(resp.Geocodes) != len(chunk) {
+			err = amapErr.NewParseError(fmt.Sprintf("批量地理编码：返回 %d 个结果，请求 %d 个地址", len(resp.Geocodes), len(chunk)))
+		}
+		for j, i := range chunk {
+			if err != nil {
+				results[i].Err = err
+			} else if resp.Geocodes[j].Found() {
+				results[i].Item = &resp.Geocodes[j]
+			}
+		}
+		return err
+	})
+	return results, err
+}
+
+// ReGeocodeBatch 批量逆地理编码：按每批 20 个坐标（batch=true）分块，并发请求后按输入顺序返回结果
+// 返回的结果与 req.Locations 一一对应；某批请求失败时该批结果的 Err 被设置（Data 为 nil），并在返回的 error 中合并所有批次的错误
+func (c *Client) ReGeocodeBatch(ctx context.Context, req *reGeoCode.BatchReGeocodeRequest) ([]reGeoCode.BatchResult, error) {
+	// 1. 校验坐标范围
+	results := make([]reGeoCode.BatchResult, len(req.Locations))
+	for i, location := range req.Locations {
+		if err := location.Validate(); err != nil {
+			return nil, amapErr.NewInvalidConfigError(fmt.Sprintf("批量逆地理编码：第 %d 个坐标错误：%v", i+1, err))
+		}
+		results[i].Location = location
+	}
+	// 2. 分块请求，结果按下标写回
+	err := c.runChunks(ctx, len(req.Locations), reGeoCode.MaxBatchLocations, func(ctx context.Context, start, end int) error {
+		chunk := *req
+		chunk.Locations = req.Locations[start:end]
+		resp, err := Do[reGeoCode.ReGeocodeBatchResponse](ctx, c, &chunk)
+		if err == nil && len(resp.ReGeocodes) != end-start {
+			err = amapErr.NewParseError(fmt.Sprintf("批量逆地理编码：返回 %d 个结果，请求 %d 个坐标", len(resp.ReGeocodes), end-start))
+		}
+		for i := start; i < end; i++ {
+			if err != nil {
+				results[i].Err = err
+			} else {
+				results[i].Data = &resp.ReGeocodes[i-start]
+			}
+		}
+		return err
+	})
+	return results, err
+}
+
+// ConvertBatch 批量坐标转换：按每批 40 个坐标分块，并发请求后按输入顺序返回结果
+// req.Fallback 控制是否使用 utils 包的算法本地计算（gps、baidu、autonavi），结果的 Source 标明实际使用的方式；
+// 某批请求失败且未能本地计算时该批结果的 Err 被设置，并在返回的 error 中合并所有批次的错误
+func (c *Client) ConvertBatch(ctx context.Context, req *convert.BatchConvertRequest) ([]convert.BatchResult, error) {
+	// 1. 校验参数
+	local, canLocal, err := localConverter(req.CoordSys)
+	if err != nil {
+		return nil, err
+	}
+	if req.Fallback == convert.FallbackLocal && !canLocal {
+		return nil, amapErr.NewInvalidConfigError(fmt.Sprintf("批量坐标转换：坐标系 %s 不支持本地计算", req.CoordSys))
+	}
+	results := make([]convert.BatchResult, len(req.Locations))
+	for i, p := range req.Locations {
+		if err := p.Validate(); err != nil {
+			return nil, amapErr.NewInvalidConfigError(fmt.Sprintf("批量坐标转换：第 %d 个坐标错误：%v", i+1, err))
+		}
+		results[i].Input = p
+	}
+	convertLocal := func(start, end int) {
+		for i := start; i < end; i++ {
+			results[i].Location = local(req.Locations[i])
+			results[i].Source = convert.SourceLocal
+		}
+	}
+	if req.Fallback == convert.FallbackLocal {
+		convertLocal(0, len(results))
+		return results, nil
+	}
+
+	// 2. 分块请求，接口不可用时按策略改为本地计算
+	err = c.runChunks(ctx, len(req.Locations), convert.MaxLocations, func(ctx context.Context, start, end int) error {
+		locations, err := c.convertChunk(ctx, req.Locations[start:end], req.CoordSys)
+		if err == nil {
+			for j, p := range locations {
+				results[start+j].Location = p
+				results[start+j].Source = convert.SourceAPI
+			}
+			return nil
+		}
+		if req.Fallback == convert.FallbackOnError && canLocal && shouldConvertLocally(err) {
+			convertLocal(start, end)
+			return nil
+		}
+		for i := start; i < end; i++ {
+			results[i].Err = err
+		}
+		return err
+	})
+	return results, err
+}
+
+// convertChunk 调用坐标转换接口转换一批坐标（不超过 40 个）
+func (c *Client) convertChunk(ctx context.Context, points amapType.LngLatList, coordSys string) (amapType.LngLatList, error) {
+	resp, err := c.ConvertCtx(ctx, &convert.ConvertRequest{Locations: points.Join("|"), CoordSys: coordSys})
+	if err != nil {
+		return nil, err
+	}
+	locations, err := resp.LngLats()
+	if err != nil {
+		return nil, amapErr.NewParseError(fmt.Sprintf("批量坐标转换：%v", err))
+	}
+	if len(locations) != len(points) {
+		return nil, amapErr.NewParseError(fmt.Sprintf("批量坐标转换：返回 %d 个坐标，请求 %d 个坐标", len(locations), len(points)))
+	}
+	return locations, nil
+}
+
+// localConverter 返回原坐标系对应的本地转换函数（mapbar 无公开算法，ok 为 false）
+func localConverter(coordSys string) (fn func(amapType.LngLat) amapType.LngLat, ok bool, err error) {
+	var from amapType.CoordinateType
+	switch coordSys {
+	case convert.CoordSysGPS:
+		from = amapType.CoordinateType_WGS84
+	case convert.CoordSysBaidu:
+		from = amapType.CoordinateType_BD09LL
+	case convert.CoordSysAutonavi:
+		from = amapType.CoordinateType_GCJ02
+	case convert.CoordSysMapbar:
+		return nil, false, nil
+	default:
+		return nil, false, amapErr.NewInvalidConfigError(fmt.Sprintf("批量坐标转换：不支持的坐标系 %q（可选值：gps, mapbar, baidu, autonavi）", coordSys))
+	}
+	return func(p amapType.LngLat) amapType.LngLat {
+		c, _ := utils.Transform(utils.Coordinate{Lng: p.Lng, Lat: p.Lat}, from, amapType.CoordinateType_GCJ02)
+		return amapType.LngLat{Lng: c.Lng, Lat: c.Lat}
+	}, true, nil
+}
+
+// shouldConvertLocally 接口是否因网络、配额或服务端问题不可用（ctx 取消时不改为本地计算）
+func shouldConvertLocally(err error) bool {
+	var canceledErr *amapErr.CanceledError
+	if errors.As(err, &canceledErr) {
+		return false
+	}
+	switch amapErr.Classify(err) {
+	case amapErr.CategoryNetwork, amapErr.CategoryQuota, amapErr.CategoryServer:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,276 @@
+package amap
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/enneket/amap/amaptest"
+	"github.com/enneket/amap/api/convert"
+	reGeoCode "github.com/enneket/amap/api/re_geo_code"
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+	"github.com/enneket/amap/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// geocodeBatchFixture 按请求地址生成批量地理编码响应，地址含“未知”时返回未匹配项（字段均为 []）
+func geocodeBatchFixture(params url.Values) string {
+	var items []string
+	for _, address := range strings.Split(params.Get("address"), "|") {
+		if strings.Contains(address, "未知") {
+			items = append(items, `{"formatted_address":[],"province":[],"city":[],"district":[],"location":[],"level":[]}`)
+			continue
+		}
+		items = append(items, fmt.Sprintf(`{"formatted_address":"%s","location":"116.%d,39.9","level":"门牌号"}`, address, len(items)))
+	}
+	return fmt.Sprintf(`{"status":"1","info":"OK","infocode":"10000","count":"%d","geocodes":[%s]}`, len(items), strings.Join(items, ","))
+}
+
+// TestGeoCodeBatch_Aligned 测试批量地理编码按10个一批分块，结果与输入一一对应
+func TestGeoCodeBatch_Aligned(t *testing.T) {
+	// 1. 创建假服务器和Client
+	client, srv := newFakeClient(t)
+	srv.SetFixtureFunc(amaptest.PathGeocode, geocodeBatchFixture)
+
+	// 2. 构造25个地址，包含空地址和未匹配地址
+	addresses := make([]string, 25)
+	for i := range addresses {
+		addresses[i] = fmt.Sprintf("地址%d", i)
+	}
+	addresses[3] = ""
+	addresses[17] = "未知地址"
+
+	// 3. 执行批量请求
+	results, err := client.GeoCodeBatch(context.Background(), addresses, "北京")
+
+	// 4. 验证结果
+	require.NoError(t, err)
+	require.Len(t, results, 25)
+	for i, result := range results {
+		assert.Equal(t, addresses[i], result.Address)
+		if i == 3 || i == 17 {
+			assert.False(t, result.Found(), i)
+			continue
+		}
+		require.True(t, result.Found(), i)
+		assert.EqualValues(t, addresses[i], result.Item.FormattedAddress)
+	}
+	assert.Equal(t, 3, srv.Count(amaptest.PathGeocode)) // 24 个非空地址分 3 批
+	for _, req := range srv.Requests() {
+		assert.Equal(t, "true", req.Params.Get("batch"))
+		assert.Equal(t, "北京", req.Params.Get("city"))
+		assert.LessOrEqual(t, len(strings.Split(req.Params.Get("address"), "|")), 10)
+	}
+}
+
+// TestGeoCodeBatch_ChunkError 测试某批请求失败时只影响该批结果
+func TestGeoCodeBatch_ChunkError(t *testing.T) {
+	// 1. 创建假服务器，第一次请求返回服务繁忙
+	srv := amaptest.NewServer()
+	defer srv.Close()
+	srv.SetFixtureFunc(amaptest.PathGeocode, geocodeBatchFixture)
+	srv.InjectError(amaptest.PathGeocode, amapErr.InfoCodeServerIsBusy, 1)
+
+	// 2. 创建Client，串行执行便于确定失败的批次
+	config := NewConfig("test_key")
+	config.BaseURL = srv.URL
+	config.BatchConcurrency = 1
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	// 3. 执行批量请求
+	addresses := make([]string, 15)
+	for i := range addresses {
+		addresses[i] = fmt.Sprintf("地址%d", i)
+	}
+	results, err := client.GeoCodeBatch(context.Background(), addresses, "")
+
+	// 4. 验证结果：前10个失败，后5个成功
+	assert.True(t, amapErr.IsRetryable(err))
+	for i, result := range results {
+		if i < 10 {
+			assert.Error(t, result.Err, i)
+			assert.False(t, result.Found(), i)
+		} else {
+			assert.NoError(t, result.Err, i)
+			assert.True(t, result.Found(), i)
+		}
+	}
+}
+
+// TestGeoCodeBatch_InvalidAddress 测试地址包含分隔符时返回参数错误
+func TestGeoCodeBatch_InvalidAddress(t *testing.T) {
+	client, srv := newFakeClient(t)
+	_, err := client.GeoCodeBatch(context.Background(), []string{"a", "b|c"}, "")
+	assert.IsType(t, amapErr.InvalidConfigError(""), err)
+	assert.Equal(t, 0, srv.Count(""))
+}
+
+// regeocodeBatchFixture 按坐标生成格式化地址，便于验证结果顺序
+func regeocodeBatchFixture(params url.Values) string {
+	var items []string
+	for _, location := range strings.Split(params.Get("location"), "|") {
+		items = append(items, fmt.Sprintf(`{"formatted_address":"%s","addressComponent":{"city":[],"adcode":"110105"}}`, location))
+	}
+	return `{"status":"1","info":"OK","infocode":"10000","regeocodes":[` + strings.Join(items, ",") + `]}`
+}
+
+// TestReGeocodeBatch_Aligned 测试批量逆地理编码按20个一批分块，结果与输入坐标一一对应
+func TestReGeocodeBatch_Aligned(t *testing.T) {
+	// 1. 创建假服务器，按坐标生成格式化地址
+	client, srv := newFakeClient(t)
+	srv.SetFixtureFunc(amaptest.PathRegeocode, regeocodeBatchFixture)
+
+	// 2. 构造45个坐标
+	req := &reGeoCode.BatchReGeocodeRequest{Extensions: "base"}
+	for i := 0; i < 45; i++ {
+		req.Locations = append(req.Locations, amapType.LngLat{Lng: 116 + float64(i)/1000, Lat: 39.9})
+	}
+
+	// 3. 执行批量请求
+	results, err := client.ReGeocodeBatch(context.Background(), req)
+
+	// 4. 验证结果
+	require.NoError(t, err)
+	require.Len(t, results, 45)
+	for i, result := range results {
+		require.NoError(t, result.Err)
+		assert.Equal(t, req.Locations[i], result.Location)
+		assert.EqualValues(t, req.Locations[i].String(), result.Data.FormattedAddress)
+	}
+	assert.Equal(t, 3, srv.Count(amaptest.PathRegeocode))
+	for _, r := range srv.Requests() {
+		assert.Equal(t, "true", r.Params.Get("batch"))
+		assert.Equal(t, "base", r.Params.Get("extensions"))
+	}
+}
+
+// TestReGeocodeBatch_ChunkError 测试某批请求失败时该批结果的 Err 被设置
+func TestReGeocodeBatch_ChunkError(t *testing.T) {
+	// 1. 创建假服务器，第一次请求返回服务繁忙
+	srv := amaptest.NewServer()
+	defer srv.Close()
+	srv.SetFixtureFunc(amaptest.PathRegeocode, regeocodeBatchFixture)
+	srv.InjectError(amaptest.PathRegeocode, amapErr.InfoCodeServerIsBusy, 1)
+
+	// 2. 创建Client，串行执行便于确定失败的批次
+	config := NewConfig("test_key")
+	config.BaseURL = srv.URL
+	config.BatchConcurrency = 1
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	// 3. 执行批量请求
+	req := &reGeoCode.BatchReGeocodeRequest{}
+	for i := 0; i < 25; i++ {
+		req.Locations = append(req.Locations, amapType.LngLat{Lng: 116 + float64(i)/1000, Lat: 39.9})
+	}
+	results, err := client.ReGeocodeBatch(context.Background(), req)
+
+	// 4. 验证结果：前20个失败，后5个成功
+	assert.True(t, amapErr.IsRetryable(err))
+	require.Len(t, results, 25)
+	for i, result := range results {
+		assert.Equal(t, req.Locations[i], result.Location, i)
+		if i < 20 {
+			assert.Error(t, result.Err, i)
+			assert.Nil(t, result.Data, i)
+		} else {
+			assert.NoError(t, result.Err, i)
+			require.NotNil(t, result.Data, i)
+			assert.EqualValues(t, req.Locations[i].String(), result.Data.FormattedAddress)
+		}
+	}
+}
+
+// TestReGeocodeBatch_InvalidLocation 测试坐标超出范围时返回参数错误
+func TestReGeocodeBatch_InvalidLocation(t *testing.T) {
+	client, srv := newFakeClient(t)
+	_, err := client.ReGeocodeBatch(context.Background(), &reGeoCode.BatchReGeocodeRequest{Locations: []amapType.LngLat{{Lng: 116.1, Lat: 39.1}, {Lng: 116.2, Lat: 95}}})
+	assert.IsType(t, amapErr.InvalidConfigError(""), err)
+	assert.Equal(t, 0, srv.Count(""))
+}
+
+// TestConvertBatch_Chunks 测试批量坐标转换按40个一批分块，结果按输入顺序返回
+func TestConvertBatch_Chunks(t *testing.T) {
+	// 1. 创建假服务器和Client（默认响应原样返回请求的坐标，便于验证结果顺序）
+	client, srv := newFakeClient(t)
+
+	// 2. 构造95个坐标
+	req := &convert.BatchConvertRequest{CoordSys: convert.CoordSysGPS}
+	for i := 0; i < 95; i++ {
+		req.Locations = append(req.Locations, amapType.LngLat{Lng: 116 + float64(i)/1000, Lat: 39.9})
+	}
+
+	// 3. 执行批量请求
+	results, err := client.ConvertBatch(context.Background(), req)
+
+	// 4. 验证结果
+	require.NoError(t, err)
+	require.Len(t, results, 95)
+	for i, result := range results {
+		assert.Equal(t, req.Locations[i], result.Location, i)
+		assert.Equal(t, convert.SourceAPI, result.Source, i)
+	}
+	assert.Equal(t, 3, srv.Count(amaptest.PathConvert))
+	for _, r := range srv.Requests() {
+		assert.Equal(t, "gps", r.Params.Get("coordsys"))
+		assert.NotContains(t, r.Params.Get("locations"), ";")
+		assert.LessOrEqual(t, len(strings.Split(r.Params.Get("locations"), "|")), 40)
+	}
+}
+
+// TestConvertBatch_Fallback 测试配额耗尽时改为本地计算，并标明结果来源
+func TestConvertBatch_Fallback(t *testing.T) {
+	// 1. 创建假服务器，第一批请求返回日配额超限
+	srv := amaptest.NewServer()
+	defer srv.Close()
+	srv.InjectError(amaptest.PathConvert, amapErr.InfoCodeDailyQueryOverLimit, 1)
+	config := NewConfig("test_key")
+	config.BaseURL = srv.URL
+	config.BatchConcurrency = 1
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	req := &convert.BatchConvertRequest{CoordSys: convert.CoordSysBaidu, Fallback: convert.FallbackOnError}
+	for i := 0; i < 50; i++ {
+		req.Locations = append(req.Locations, amapType.LngLat{Lng: 116.404, Lat: 39.915 + float64(i)/1000})
+	}
+
+	// 2. 执行批量请求：第一批本地计算，第二批调用接口
+	results, err := client.ConvertBatch(context.Background(), req)
+	require.NoError(t, err)
+	for i, result := range results {
+		if i < 40 {
+			assert.Equal(t, convert.SourceLocal, result.Source, i)
+			gcj := utils.BD09ToGCJ02Exact(utils.Coordinate{Lng: req.Locations[i].Lng, Lat: req.Locations[i].Lat})
+			assert.InDelta(t, gcj.Lng, result.Location.Lng, 1e-9)
+			assert.InDelta(t, gcj.Lat, result.Location.Lat, 1e-9)
+		} else {
+			assert.Equal(t, convert.SourceAPI, result.Source, i)
+		}
+	}
+
+	// 3. 不允许本地计算时返回错误，且只影响失败的批次
+	srv.InjectError(amaptest.PathConvert, amapErr.InfoCodeDailyQueryOverLimit, 1)
+	req.Fallback = convert.FallbackNone
+	results, err = client.ConvertBatch(context.Background(), req)
+	assert.True(t, amapErr.IsQuotaExceeded(err))
+	assert.Error(t, results[0].Err)
+	assert.NoError(t, results[45].Err)
+
+	// 4. 直接本地计算时不发送请求；mapbar 不支持本地计算
+	srv.Reset()
+	req.Fallback = convert.FallbackLocal
+	results, err = client.ConvertBatch(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, convert.SourceLocal, results[49].Source)
+	assert.Equal(t, 0, srv.Count(""))
+	req.CoordSys = convert.CoordSysMapbar
+	_, err = client.ConvertBatch(context.Background(), req)
+	assert.IsType(t, amapErr.InvalidConfigError(""), err)
+}
@@ -0,0 +1,11 @@
+// Package cache 提供高德 API 响应缓存的接口及内存（LRU）、文件两种实现
+package cache
+
+import "time"
+
+// Cache 响应缓存接口（value 为高德返回的原始 JSON），实现需保证并发安全
+type Cache interface {
+	Get(key string) ([]byte, bool)                   // 读取缓存（不存在或已过期时返回 false）
+	Set(key string, value []byte, ttl time.Duration) // 写入缓存（ttl<=0 表示不缓存）
+	Delete(key string)                               // 删除缓存
+}
@@ -0,0 +1,82 @@
+package cache
+
+import (
+	"testing"
+	"time"
+)
+
+// 测试内存缓存LRU淘汰
+func TestMemoryCache_LRU(t *testing.T) {
+	c := NewMemoryCache(2)
+	c.Set("a", []byte("1"), time.Minute)
+	c.Set("b", []byte("2"), time.Minute)
+	c.Get("a") // a 变为最近使用
+	c.Set("c", []byte("3"), time.Minute)
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("最久未使用的条目应被淘汰")
+	}
+	if v, ok := c.Get("a"); !ok || string(v) != "1" {
+		t.Errorf("条目a应保留，实际：%s %v", v, ok)
+	}
+	if c.Len() != 2 {
+		t.Errorf("条目数错误：%d", c.Len())
+	}
+}
+
+// 测试内存缓存过期
+func TestMemoryCache_TTL(t *testing.T) {
+	now := time.Now()
+	c := NewMemoryCache(10)
+	c.now = func() time.Time { return now }
+	c.Set("a", []byte("1"), time.Minute)
+	c.Set("b", []byte("2"), 0) // ttl<=0 不缓存
+
+	if _, ok := c.Get("a"); !ok {
+		t.Error("未过期的条目应命中")
+	}
+	if _, ok := c.Get("b"); ok {
+		t.Error("ttl<=0 的条目不应缓存")
+	}
+	now = now.Add(time.Minute)
+	if _, ok := c.Get("a"); ok {
+		t.Error("过期条目不应命中")
+	}
+	if c.Len() != 0 {
+		t.Error("过期条目应被清理")
+	}
+}
+
+// 测试文件缓存读写、过期和删除
+func TestFileCache(t *testing.T) {
+	c, err := NewFileCache(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	value := []byte(`{"status":"1","count":"1"}`)
+	c.Set("GET /v3/geocode/geo?address=北京", value, time.Hour)
+	if v, ok := c.Get("GET /v3/geocode/geo?address=北京"); !ok || string(v) != string(value) {
+		t.Errorf("文件缓存读取错误：%s %v", v, ok)
+	}
+
+	// 重新打开同一目录仍然有效
+	c2, _ := NewFileCache(c.dir)
+	c2.now = c.now
+	if _, ok := c2.Get("GET /v3/geocode/geo?address=北京"); !ok {
+		t.Error("文件缓存应跨实例有效")
+	}
+
+	c.Delete("GET /v3/geocode/geo?address=北京")
+	if _, ok := c.Get("GET /v3/geocode/geo?address=北京"); ok {
+		t.Error("删除后不应命中")
+	}
+
+	c.Set("k", value, time.Minute)
+	now = now.Add(2 * time.Minute)
+	if _, ok := c.Get("k"); ok {
+		t.Error("过期条目不应命中")
+	}
+}
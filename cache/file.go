@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileCache 基于文件的缓存（每个条目一个文件，进程重启后仍然有效）
+type FileCache struct {
+	dir string
+	now func() time.Time
+}
+
+// fileEntry 缓存文件内容
+type fileEntry struct {
+	Key     string          `json:"key"`
+	Expires time.Time       `json:"expires"`
+	Value   json.RawMessage `json:"value"`
+}
+
+// NewFileCache 创建文件缓存（dir 不存在时自动创建）
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileCache{dir: dir, now: time.Now}, nil
+}
+
+// path 缓存 key 对应的文件路径（key 做 SHA-256 摘要，避免非法文件名）
+func (c *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get 读取缓存，过期条目会被删除
+func (c *FileCache) Get(key string) ([]byte, bool) {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	var entry fileEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.Key != key {
+		return nil, false
+	}
+	if !c.now().Before(entry.Expires) {
+		_ = os.Remove(c.path(key))
+		return nil, false
+	}
+	return entry.Value, true
+}
+
+// Set 写入缓存（先写临时文件再重命名，避免读到不完整的文件）
+// value 须为合法 JSON（高德响应），写入失败时静默忽略
+func (c *FileCache) Set(key string, value []byte, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	data, err := json.Marshal(fileEntry{Key: key, Expires: c.now().Add(ttl), Value: value})
+	if err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(c.dir, "tmp-*")
+	if err != nil {
+		return
+	}
+	_, writeErr := tmp.Write(data)
+	closeErr := tmp.Close()
+	if writeErr != nil || closeErr != nil || os.Rename(tmp.Name(), c.path(key)) != nil {
+		_ = os.Remove(tmp.Name())
+	}
+}
+
+// Delete 删除缓存
+func (c *FileCache) Delete(key string) {
+	_ = os.Remove(c.path(key))
+}
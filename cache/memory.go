@@ -0,0 +1,94 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// MemoryCache 进程内 LRU 缓存（超出容量时淘汰最久未使用的条目）
+type MemoryCache struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	items    map[string]*list.Element
+	now      func() time.Time
+}
+
+// memoryEntry LRU 链表节点
+type memoryEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCache 创建内存 LRU 缓存（capacity 为最大条目数，<=0 时默认 1000）
+func NewMemoryCache(capacity int) *MemoryCache {
+	if capacity <= 0 {
+		capacity = 1000
+	}
+	return &MemoryCache{
+		capacity: capacity,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+		now:      time.Now,
+	}
+}
+
+// Get 读取缓存，命中时将条目移到队首
+func (c *MemoryCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*memoryEntry)
+	if !c.now().Before(entry.expires) {
+		c.removeElement(elem)
+		return nil, false
+	}
+	c.ll.MoveToFront(elem)
+	return entry.value, true
+}
+
+// Set 写入缓存，超出容量时淘汰队尾条目
+func (c *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	expires := c.now().Add(ttl)
+	if elem, ok := c.items[key]; ok {
+		entry := elem.Value.(*memoryEntry)
+		entry.value, entry.expires = value, expires
+		c.ll.MoveToFront(elem)
+		return
+	}
+	c.items[key] = c.ll.PushFront(&memoryEntry{key: key, value: value, expires: expires})
+	for c.ll.Len() > c.capacity {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+// Delete 删除缓存
+func (c *MemoryCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.items[key]; ok {
+		c.removeElement(elem)
+	}
+}
+
+// Len 返回当前条目数（含尚未清理的过期条目）
+func (c *MemoryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+func (c *MemoryCache) removeElement(elem *list.Element) {
+	c.ll.Remove(elem)
+	delete(c.items, elem.Value.(*memoryEntry).key)
+}
@@ -0,0 +1,57 @@
+package amap
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/enneket/amap/utils"
+)
+
+// DefaultCacheTTLs 默认的按服务族缓存时间：地理编码、行政区、POI 详情等结果稳定的服务缓存较久，天气缓存较短
+// 未列出的服务（路径规划、交通态势、定位等实时数据）不缓存
+func DefaultCacheTTLs() map[string]time.Duration {
+	return map[string]time.Duration{
+		ServiceGeocode:  7 * 24 * time.Hour,
+		ServiceDistrict: 30 * 24 * time.Hour,
+		ServicePlace:    24 * time.Hour,
+		ServiceBus:      24 * time.Hour,
+		ServiceIP:       24 * time.Hour,
+		ServiceWeather:  10 * time.Minute,
+	}
+}
+
+// bypassCacheKey ctx 中标记跳过缓存的 key
+type bypassCacheKey struct{}
+
+// WithoutCache 返回跳过缓存读取的 ctx：本次请求一定访问高德，成功后仍会刷新缓存
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCacheKey{}, true)
+}
+
+// cacheTTL 返回请求的缓存时间（未配置缓存、非 GET 请求或服务未配置 TTL 时返回 0）
+func (c *Client) cacheTTL(method, service string) time.Duration {
+	if c.config.Cache == nil || method != http.MethodGet {
+		return 0
+	}
+	if ttl, ok := c.config.CacheTTLs[service]; ok {
+		return ttl
+	}
+	return c.config.CacheTTLs[ServiceDefault]
+}
+
+// cacheKey 缓存 key：请求路径 + 按 key 排序的业务参数
+// 即签名参数串去掉 key、timestamp、sig（多 Key 轮换时同一请求命中同一缓存）
+func cacheKey(method, path string, params map[string]string) string {
+	if parsed, err := url.Parse(path); err == nil {
+		path = parsed.Path
+	}
+	return method + " " + path + "?" + utils.EncodeParams(params, true)
+}
+
+// cacheBypassed 判断 ctx 是否要求跳过缓存
+func cacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(bypassCacheKey{}).(bool)
+	return bypass
+}
@@ -0,0 +1,101 @@
+package amap
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	geoCode "github.com/enneket/amap/api/geo_code"
+	"github.com/enneket/amap/api/weatherinfo"
+	"github.com/enneket/amap/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCachingTestServer 创建记录请求次数的mock服务器
+func newCachingTestServer(body string, requests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		_, _ = w.Write([]byte(body))
+	}))
+}
+
+// TestCache_HitAndBypass 测试缓存命中与跳过缓存
+func TestCache_HitAndBypass(t *testing.T) {
+	// 1. 创建mock服务器
+	requests := 0
+	mockServer := newCachingTestServer(`{
+		"status": "1",
+		"info": "OK",
+		"infocode": "10000",
+		"count": "1",
+		"geocodes": [{"formatted_address": "北京市朝阳区阜通东大街6号", "location": "116.483038,39.990633"}]
+	}`, &requests)
+	defer mockServer.Close()
+
+	// 2. 创建Client实例，配置内存缓存
+	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
+	config.Cache = cache.NewMemoryCache(100)
+	config.CacheTTLs = DefaultCacheTTLs()
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	// 3. 相同请求两次，第二次命中缓存
+	req := &geoCode.GeocodeRequest{Address: "北京市朝阳区阜通东大街6号", City: "北京"}
+	resp, err := client.GeoCode(req)
+	require.NoError(t, err)
+	cached, err := client.GeoCode(req)
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+	assert.Equal(t, resp.Geocodes, cached.Geocodes)
+	assert.EqualValues(t, "116.483038,39.990633", cached.Geocodes[0].Location)
+
+	// 4. 不同参数不命中
+	_, err = client.GeoCode(&geoCode.GeocodeRequest{Address: "北京市朝阳区阜通东大街6号", City: "010"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests)
+
+	// 5. 跳过缓存
+	_, err = client.GeoCodeCtx(WithoutCache(t.Context()), req)
+	require.NoError(t, err)
+	assert.Equal(t, 3, requests)
+}
+
+// TestCache_PerServiceTTL 测试未配置TTL的服务不缓存，错误响应不缓存
+func TestCache_PerServiceTTL(t *testing.T) {
+	// 1. 创建mock服务器，返回错误
+	requests := 0
+	mockServer := newCachingTestServer(`{"status":"0","info":"INVALID_PARAMS","infocode":"20000"}`, &requests)
+	defer mockServer.Close()
+
+	// 2. 创建Client实例，只缓存天气
+	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
+	config.Cache = cache.NewMemoryCache(100)
+	config.CacheTTLs = map[string]time.Duration{ServiceWeather: time.Minute}
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	// 3. 错误响应不缓存
+	req := &weatherinfo.WeatherinfoRequest{City: "110000"}
+	_, err = client.Weatherinfo(req)
+	assert.Error(t, err)
+	_, err = client.Weatherinfo(req)
+	assert.Error(t, err)
+	assert.Equal(t, 2, requests)
+
+	// 4. 未配置TTL的服务不缓存
+	_, _ = client.GeoCode(&geoCode.GeocodeRequest{Address: "北京"})
+	_, _ = client.GeoCode(&geoCode.GeocodeRequest{Address: "北京"})
+	assert.Equal(t, 4, requests)
+}
+
+// TestCacheKey 测试缓存key不含key、timestamp、sig且与参数顺序无关
+func TestCacheKey(t *testing.T) {
+	key := cacheKey(http.MethodGet, "https://restapi.amap.com/v3/geocode/geo", map[string]string{"city": "北京", "address": "朝阳区"})
+	assert.Equal(t, key, cacheKey(http.MethodGet, "/v3/geocode/geo", map[string]string{"address": "朝阳区", "city": "北京"}))
+	assert.NotContains(t, key, "timestamp")
+	assert.NotContains(t, key, "key=")
+}
@@ -1,6 +1,7 @@
 package amap
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -22,6 +23,11 @@ import (
 	drivingV2 "github.com/enneket/amap/api/direction/v2/driving"
 	electricV2 "github.com/enneket/amap/api/direction/v2/electric"
 	walkingV2 "github.com/enneket/amap/api/direction/v2/walking"
+	bicyclingV5 "github.com/enneket/amap/api/direction/v5/bicycling"
+	drivingV5 "github.com/enneket/amap/api/direction/v5/driving"
+	electrobikeV5 "github.com/enneket/amap/api/direction/v5/electrobike"
+	transitV5 "github.com/enneket/amap/api/direction/v5/transit"
+	walkingV5 "github.com/enneket/amap/api/direction/v5/walking"
 	distance "github.com/enneket/amap/api/distance"
 	district "github.com/enneket/amap/api/district"
 	etdDrivingV4 "github.com/enneket/amap/api/etd/v4/driving"
@@ -57,79 +63,222 @@ import (
 type Client struct {
 	config     *Config      // 全局配置
 	httpClient *http.Client // 复用的 HTTP 客户端
+	limiter    *rateLimiter // 客户端限流器（未配置 RateLimits 时为 nil）
+	keys       *keyPool     // Key 池（Config.Key 与 Config.Keys 合并）
+
+	middlewares []Middleware // 中间件（Config.Middlewares 在前，Use 追加在后）
+	handler     Handler      // 组装好的中间件链
 }
 
 // NewClient 创建客户端实例（校验配置合法性）
 func NewClient(cfg *Config) (*Client, error) {
-	if cfg.Key == "" {
+	keys := newKeyPool(cfg)
+	if len(keys.keys) == 0 {
 		return nil, amapErr.NewInvalidConfigError("API Key 不能为空")
 	}
-	// 初始化 HTTP 客户端（支持超时、代理）
+	// 初始化 HTTP 客户端（支持超时、代理、自定义传输层）
 	httpClient := &http.Client{Timeout: cfg.Timeout}
-	if cfg.Proxy != "" {
+	if cfg.Transport != nil {
+		httpClient.Transport = cfg.Transport
+	} else if cfg.Proxy != "" {
 		proxyURL, _ := url.Parse(cfg.Proxy)
 		httpClient.Transport = &http.Transport{Proxy: http.ProxyURL(proxyURL)}
 	}
-	return &Client{config: cfg, httpClient: httpClient}, nil
+	c := &Client{config: cfg, httpClient: httpClient, limiter: newRateLimiter(cfg.RateLimits), keys: keys}
+	c.Use(cfg.Middlewares...)
+	return c, nil
 }
 
 // DoRequest 通用请求方法（封装公共参数、签名、响应解析）
 func (c *Client) DoRequest(method string, path string, params map[string]string, resp interface{}) error {
-	// 1. 合并公共参数（Key、签名、Timestamp 等）
-	allParams := c.buildPublicParams(params)
-	// 2. 签名（如果配置了 SecurityKey）
-	if c.config.SecurityKey != "" {
-		allParams["sig"] = utils.Sign(allParams, c.config.SecurityKey)
+	return c.DoRequestCtx(context.Background(), method, path, params, resp)
+}
+
+// DoRequestCtx 同 DoRequest，请求绑定 ctx：ctx 取消或超时会中断进行中的请求，并返回 CanceledError
+// 配置了 Config.Cache 时先查缓存（可用 WithoutCache(ctx) 跳过），成功响应按服务族 TTL 写入缓存
+// 服务族（限流、缓存、根路径的归属）由 ServiceOf(path) 识别
+func (c *Client) DoRequestCtx(ctx context.Context, method string, path string, params map[string]string, resp interface{}) error {
+	return c.do(ctx, ServiceOf(path), method, path, params, resp)
+}
+
+// do 按指定服务族发送请求（接口表中的接口使用 Endpoint.Service，其余请求由 DoRequestCtx 按路径识别）
+func (c *Client) do(ctx context.Context, service string, method string, path string, params map[string]string, resp interface{}) error {
+	ttl := c.cacheTTL(method, service)
+	if ttl <= 0 {
+		return c.doWithRetry(ctx, service, method, path, params, resp)
 	}
-	// 3. 构建完整 URL：如果配置了 BaseURL，则替换 path 中的基础 URL
-	fullPath := path
-	if c.config.BaseURL != "" {
-		// 解析原始 path，提取相对路径
-		parsedURL, err := url.Parse(path)
-		if err == nil {
-			// 构建新的 URL：BaseURL + 相对路径
-			fullPath = c.config.BaseURL + parsedURL.Path
+	// 1. 命中缓存直接返回
+	key := cacheKey(method, path, params)
+	if !cacheBypassed(ctx) {
+		if raw, ok := c.config.Cache.Get(key); ok && json.Unmarshal(raw, resp) == nil {
+			return nil
+		}
+	}
+	// 2. 请求高德并缓存原始响应
+	var raw json.RawMessage
+	if err := c.doWithRetry(ctx, service, method, path, params, &raw); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw, resp); err != nil {
+		return err
+	}
+	c.config.Cache.Set(key, raw, ttl)
+	return nil
+}
+
+// doWithRetry 发送请求，配置了 Config.Retry 时按重试策略重试临时性失败，返回的错误包装为 RetryError（携带尝试次数）
+func (c *Client) doWithRetry(ctx context.Context, service string, method string, path string, params map[string]string, resp interface{}) error {
+	policy := c.config.Retry
+	if policy == nil {
+		return c.doOnce(ctx, service, method, path, params, resp)
+	}
+	attempts := 0
+	var err error
+	for {
+		attempts++
+		if err = c.doOnce(ctx, service, method, path, params, resp); err == nil {
+			return nil
+		}
+		if attempts >= policy.maxAttempts() || !policy.shouldRetry(err) {
+			break
+		}
+		// 退避等待（等待期间 ctx 取消则立即返回）
+		if waitErr := sleepCtx(ctx, policy.backoff(attempts)); waitErr != nil {
+			err = waitErr
+			break
+		}
+	}
+	return amapErr.NewRetryError(attempts, err)
+}
+
+// doOnce 执行一次请求（不含重试）：从 Key 池选择 Key，Key 无效或配额耗尽时自动换下一个 Key
+func (c *Client) doOnce(ctx context.Context, service string, method string, path string, params map[string]string, resp interface{}) error {
+	var tried []string
+	var lastErr error
+	for {
+		key, err := c.keys.pick(tried)
+		if err != nil {
+			// 所有 Key 均不可用：优先返回最近一次高德返回的错误
+			if lastErr != nil {
+				return lastErr
+			}
+			return err
+		}
+		err = c.send(ctx, key, service, method, path, params, resp)
+		if !c.keys.report(key.Key, err) {
+			return err
+		}
+		tried = append(tried, key.Key)
+		lastErr = err
+	}
+}
+
+// send 使用指定 Key 发送单次请求：构建公共参数并签名后交给中间件链，最终由 roundTrip 发出 HTTP 请求
+func (c *Client) send(ctx context.Context, key KeyPair, service string, method string, path string, params map[string]string, resp interface{}) error {
+	// 0. 请求发出前先检查 ctx 是否已取消
+	if err := ctx.Err(); err != nil {
+		return amapErr.NewCanceledError(err)
+	}
+	// 0.1 客户端限流：等待 QPS 令牌并扣减每日配额
+	if c.limiter != nil {
+		if err := c.limiter.wait(ctx, key.Key, service); err != nil {
+			return err
 		}
 	}
-	// 4. 根据请求方法构建请求
+	// 1. 合并公共参数（Key、签名、Timestamp 等）
+	allParams := c.buildPublicParams(key.Key, params)
+	// 2. 签名（如果该 Key 配置了 SecurityKey）
+	if key.SecurityKey != "" {
+		allParams["sig"] = utils.Sign(allParams, key.SecurityKey)
+	}
+	// 3. 构建完整 URL：使用服务族的根路径（Config.ServiceBaseURLs 或 BaseURL）替换 path 中的基础 URL
+	fullPath := c.resolveURL(service, path)
+	if method != http.MethodGet && method != http.MethodPost {
+		return amapErr.NewInvalidConfigError("不支持的请求方法：" + method)
+	}
+	// 4. 经过中间件链发送请求
+	call := &Call{
+		Service:     service,
+		Method:      method,
+		URL:         fullPath,
+		Params:      allParams,
+		securityKey: key.SecurityKey,
+	}
+	baseResp, err := c.handler(ctx, call)
+	if err != nil {
+		return err
+	}
+	// 5. 校验 API 错误
+	if baseResp.Status != "1" {
+		apiErr := amapErr.NewAPIError(baseResp.InfoCode, baseResp.Info)
+		apiErr.Path = call.Path()
+		apiErr.Params = debugParams(call.Params)
+		return apiErr
+	}
+	// 6. 解析到业务响应结构体
+	return json.Unmarshal(baseResp.RawJSON, resp)
+}
+
+// roundTrip 中间件链的最内层：发出 HTTP 请求并解析基础响应
+func (c *Client) roundTrip(ctx context.Context, call *Call) (*amapType.BaseResponse, error) {
+	// 1. 根据请求方法构建请求
 	var req *http.Request
 	var err error
-	if method == http.MethodGet {
+	if call.Method == http.MethodGet {
 		// GET 请求：参数拼接到 URL
-		fullURL := fullPath + "?" + utils.EncodeParams(allParams, true)
-		req, _ = http.NewRequest(method, fullURL, nil)
-	} else if method == http.MethodPost {
-		// POST 请求：参数作为请求体
-		req, _ = http.NewRequest(method, fullPath, strings.NewReader(utils.EncodeParams(allParams, true)))
-		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		fullURL := call.URL + "?" + utils.EncodeParams(call.Params, true)
+		req, err = http.NewRequestWithContext(ctx, call.Method, fullURL, nil)
 	} else {
-		return amapErr.NewInvalidConfigError("不支持的请求方法：" + method)
+		// POST 请求：参数作为请求体
+		req, err = http.NewRequestWithContext(ctx, call.Method, call.URL, strings.NewReader(utils.EncodeParams(call.Params, true)))
+		if err == nil {
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
+	}
+	if err != nil {
+		return nil, amapErr.NewNetworkError(err.Error())
 	}
-	// 5. 设置请求头
+	// 2. 设置请求头
 	req.Header.Set("User-Agent", c.config.UserAgent)
-	// 6. 发送 HTTP 请求
+	// 3. 发送 HTTP 请求（ctx 取消时区分返回 CanceledError）
 	rawResp, err := c.httpClient.Do(req)
 	if err != nil {
-		return amapErr.NewNetworkError(err.Error())
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, amapErr.NewCanceledError(ctxErr)
+		}
+		return nil, amapErr.NewNetworkError(err.Error())
 	}
 	defer rawResp.Body.Close()
-	// 7. 解析响应（先解析基础响应，再解析业务响应）
+	// 4. 可重试的 HTTP 状态码（如 429/503）直接返回，交由重试策略处理
+	if c.config.Retry != nil && c.config.Retry.retryableStatus(rawResp.StatusCode) {
+		return nil, amapErr.NewHTTPStatusError(rawResp.StatusCode)
+	}
+	// 5. 解析基础响应（保留原始 JSON 供业务响应解析）
 	baseResp, _, err := amapType.ReadBaseResponse(rawResp.Body)
 	if err != nil {
-		return err
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, amapErr.NewCanceledError(ctxErr)
+		}
+		return nil, err
 	}
-	// 8. 校验 API 错误
-	if baseResp.Status != "1" {
-		return amapErr.NewAPIError(baseResp.InfoCode, baseResp.Info)
+	return &baseResp, nil
+}
+
+// debugParams 复制请求参数用于错误排查（去除 key、sig 等敏感参数）
+func debugParams(params map[string]string) map[string]string {
+	result := make(map[string]string, len(params))
+	for k, v := range params {
+		if k != "key" && k != "sig" {
+			result[k] = v
+		}
 	}
-	// 9. 解析到业务响应结构体
-	return json.Unmarshal(baseResp.RawJSON, resp)
+	return result
 }
 
 // buildPublicParams 构建公共参数（Key、Timestamp 等）
-func (c *Client) buildPublicParams(params map[string]string) map[string]string {
+func (c *Client) buildPublicParams(key string, params map[string]string) map[string]string {
 	publicParams := map[string]string{
-		"key":       c.config.Key,
+		"key":       key,
 		"timestamp": fmt.Sprintf("%d", time.Now().Unix()),
 		"output":    "JSON",
 	}
@@ -144,879 +293,498 @@ func (c *Client) buildPublicParams(params map[string]string) map[string]string {
 
 // GeoCode 地理编码API调用方法（为amap.Client绑定方法）
 func (c *Client) GeoCode(req *geoCode.GeocodeRequest) (*geoCode.GeoCodeResponse, error) {
-	// 校验必填参数
-	if req.Address == "" {
-		return nil, amapErr.NewInvalidConfigError("地理编码：address参数不能为空")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp geoCode.GeoCodeResponse
-	if err := c.DoRequest(http.MethodGet, geoCode.API_PATH, params, &resp); err != nil {
-		return nil, err
-	}
+	return c.GeoCodeCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// GeoCodeCtx 同 GeoCode，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) GeoCodeCtx(ctx context.Context, req *geoCode.GeocodeRequest) (*geoCode.GeoCodeResponse, error) {
+	return Do[geoCode.GeoCodeResponse](ctx, c, req)
 }
 
 // ReGeocode 逆地理编码API调用方法
 func (c *Client) ReGeocode(req *reGeoCode.ReGeocodeRequest) (*reGeoCode.ReGeocodeResponse, error) {
-	// 校验必填参数
-	if req.Location == "" {
-		return nil, amapErr.NewInvalidConfigError("逆地理编码：location参数不能为空")
-	}
-	// 简单校验经纬度格式（经度,纬度）
-	if !strings.Contains(req.Location, ",") {
-		return nil, amapErr.NewInvalidConfigError("逆地理编码：location格式错误，应为\"经度,纬度\"")
-	}
-
-	// 处理默认值
-	if req.Radius <= 0 {
-		req.Radius = 1000 // 默认搜索半径1000米
-	}
-	if req.Extensions == "" {
-		req.Extensions = "base" // 默认返回基础信息
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp reGeoCode.ReGeocodeResponse
-	if err := c.DoRequest(http.MethodGet, reGeoCode.API_PATH, params, &resp); err != nil {
-		return nil, err
-	}
+	return c.ReGeocodeCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// ReGeocodeCtx 同 ReGeocode，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) ReGeocodeCtx(ctx context.Context, req *reGeoCode.ReGeocodeRequest) (*reGeoCode.ReGeocodeResponse, error) {
+	return Do[reGeoCode.ReGeocodeResponse](ctx, c, req)
 }
 
 // Walking 步行路径规划API调用方法（v1）
 func (c *Client) Walking(req *walkingV1.WalkingRequest) (*walkingV1.WalkingResponse, error) {
-	// 校验必填参数
-	if req.Origin == "" {
-		return nil, amapErr.NewInvalidConfigError("步行路径规划：origin参数不能为空")
-	}
-	if req.Destination == "" {
-		return nil, amapErr.NewInvalidConfigError("步行路径规划：destination参数不能为空")
-	}
-	// 简单校验经纬度格式
-	if !strings.Contains(req.Origin, ",") || !strings.Contains(req.Destination, ",") {
-		return nil, amapErr.NewInvalidConfigError("步行路径规划：坐标格式错误，应为\"经度,纬度\"")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp walkingV1.WalkingResponse
-	if err := c.DoRequest(http.MethodGet, walkingV1.API_PATH, params, &resp); err != nil {
-		return nil, err
-	}
+	return c.WalkingCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// WalkingCtx 同 Walking，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) WalkingCtx(ctx context.Context, req *walkingV1.WalkingRequest) (*walkingV1.WalkingResponse, error) {
+	return Do[walkingV1.WalkingResponse](ctx, c, req)
 }
 
 // Driving 驾车路径规划API调用方法（v1）
 func (c *Client) Driving(req *drivingV1.DrivingRequest) (*drivingV1.DrivingResponse, error) {
-	// 校验必填参数
-	if req.Origin == "" {
-		return nil, amapErr.NewInvalidConfigError("驾车路径规划：origin参数不能为空")
-	}
-	if req.Destination == "" {
-		return nil, amapErr.NewInvalidConfigError("驾车路径规划：destination参数不能为空")
-	}
-	// 简单校验经纬度格式
-	if !strings.Contains(req.Origin, ",") || !strings.Contains(req.Destination, ",") {
-		return nil, amapErr.NewInvalidConfigError("驾车路径规划：坐标格式错误，应为\"经度,纬度\"")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp drivingV1.DrivingResponse
-	if err := c.DoRequest(http.MethodGet, drivingV1.API_PATH, params, &resp); err != nil {
-		return nil, err
-	}
+	return c.DrivingCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// DrivingCtx 同 Driving，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) DrivingCtx(ctx context.Context, req *drivingV1.DrivingRequest) (*drivingV1.DrivingResponse, error) {
+	return Do[drivingV1.DrivingResponse](ctx, c, req)
 }
 
 // Bicycling 骑行路径规划API调用方法（v1）
 func (c *Client) Bicycling(req *bicyclingV1.BicyclingRequest) (*bicyclingV1.BicyclingResponse, error) {
-	// 校验必填参数
-	if req.Origin == "" {
-		return nil, amapErr.NewInvalidConfigError("骑行路径规划：origin参数不能为空")
-	}
-	if req.Destination == "" {
-		return nil, amapErr.NewInvalidConfigError("骑行路径规划：destination参数不能为空")
-	}
-	// 简单校验经纬度格式
-	if !strings.Contains(req.Origin, ",") || !strings.Contains(req.Destination, ",") {
-		return nil, amapErr.NewInvalidConfigError("骑行路径规划：坐标格式错误，应为\"经度,纬度\"")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp bicyclingV1.BicyclingResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/direction/bicycling", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.BicyclingCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// BicyclingCtx 同 Bicycling，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) BicyclingCtx(ctx context.Context, req *bicyclingV1.BicyclingRequest) (*bicyclingV1.BicyclingResponse, error) {
+	return Do[bicyclingV1.BicyclingResponse](ctx, c, req)
 }
 
 // Bus 公交路线规划API调用方法（v1）
 func (c *Client) Bus(req *busV1.BusRequest) (*busV1.BusResponse, error) {
-	// 校验必填参数
-	if req.Origin == "" {
-		return nil, amapErr.NewInvalidConfigError("公交路径规划：origin参数不能为空")
-	}
-	if req.Destination == "" {
-		return nil, amapErr.NewInvalidConfigError("公交路径规划：destination参数不能为空")
-	}
-	// 简单校验经纬度格式
-	if !strings.Contains(req.Origin, ",") || !strings.Contains(req.Destination, ",") {
-		return nil, amapErr.NewInvalidConfigError("公交路径规划：坐标格式错误，应为\"经度,纬度\"")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp busV1.BusResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/direction/transit/integrated", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.BusCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// BusCtx 同 Bus，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) BusCtx(ctx context.Context, req *busV1.BusRequest) (*busV1.BusResponse, error) {
+	return Do[busV1.BusResponse](ctx, c, req)
 }
 
 // WalkingV2 步行路径规划API调用方法（v2）
+//
+// Deprecated: 该接口路径并非高德公开的路径规划2.0地址，请改用 WalkingV5。
 func (c *Client) WalkingV2(req *walkingV2.WalkingRequestV2) (*walkingV2.WalkingResponseV2, error) {
-	// 校验必填参数
-	if req.Origin == "" {
-		return nil, amapErr.NewInvalidConfigError("步行路径规划v2：origin参数不能为空")
-	}
-	if req.Destination == "" {
-		return nil, amapErr.NewInvalidConfigError("步行路径规划v2：destination参数不能为空")
-	}
-	// 简单校验经纬度格式
-	if !strings.Contains(req.Origin, ",") || !strings.Contains(req.Destination, ",") {
-		return nil, amapErr.NewInvalidConfigError("步行路径规划v2：坐标格式错误，应为\"经度,纬度\"")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp walkingV2.WalkingResponseV2
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/direction/v2/walking", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.WalkingV2Ctx(context.Background(), req)
+}
 
-	return &resp, nil
+// WalkingV2Ctx 同 WalkingV2，支持通过 ctx 取消请求或传递截止时间
+//
+// Deprecated: 请改用 WalkingV5Ctx。
+func (c *Client) WalkingV2Ctx(ctx context.Context, req *walkingV2.WalkingRequestV2) (*walkingV2.WalkingResponseV2, error) {
+	return Do[walkingV2.WalkingResponseV2](ctx, c, req)
 }
 
 // DrivingV2 驾车路径规划API调用方法（v2）
+//
+// Deprecated: 该接口路径并非高德公开的路径规划2.0地址，请改用 DrivingV5。
 func (c *Client) DrivingV2(req *drivingV2.DrivingRequestV2) (*drivingV2.DrivingResponseV2, error) {
-	// 校验必填参数
-	if req.Origin == "" {
-		return nil, amapErr.NewInvalidConfigError("驾车路径规划v2：origin参数不能为空")
-	}
-	if req.Destination == "" {
-		return nil, amapErr.NewInvalidConfigError("驾车路径规划v2：destination参数不能为空")
-	}
-	// 简单校验经纬度格式
-	if !strings.Contains(req.Origin, ",") || !strings.Contains(req.Destination, ",") {
-		return nil, amapErr.NewInvalidConfigError("驾车路径规划v2：坐标格式错误，应为\"经度,纬度\"")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp drivingV2.DrivingResponseV2
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/direction/v2/driving", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.DrivingV2Ctx(context.Background(), req)
+}
 
-	return &resp, nil
+// DrivingV2Ctx 同 DrivingV2，支持通过 ctx 取消请求或传递截止时间
+//
+// Deprecated: 请改用 DrivingV5Ctx。
+func (c *Client) DrivingV2Ctx(ctx context.Context, req *drivingV2.DrivingRequestV2) (*drivingV2.DrivingResponseV2, error) {
+	return Do[drivingV2.DrivingResponseV2](ctx, c, req)
 }
 
 // BicyclingV2 骑行路径规划API调用方法（v2）
+//
+// Deprecated: 该接口路径并非高德公开的路径规划2.0地址，请改用 BicyclingV5。
 func (c *Client) BicyclingV2(req *bicyclingV2.BicyclingRequestV2) (*bicyclingV2.BicyclingResponseV2, error) {
-	// 校验必填参数
-	if req.Origin == "" {
-		return nil, amapErr.NewInvalidConfigError("骑行路径规划v2：origin参数不能为空")
-	}
-	if req.Destination == "" {
-		return nil, amapErr.NewInvalidConfigError("骑行路径规划v2：destination参数不能为空")
-	}
-	// 简单校验经纬度格式
-	if !strings.Contains(req.Origin, ",") || !strings.Contains(req.Destination, ",") {
-		return nil, amapErr.NewInvalidConfigError("骑行路径规划v2：坐标格式错误，应为\"经度,纬度\"")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp bicyclingV2.BicyclingResponseV2
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/direction/v2/bicycling", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.BicyclingV2Ctx(context.Background(), req)
+}
 
-	return &resp, nil
+// BicyclingV2Ctx 同 BicyclingV2，支持通过 ctx 取消请求或传递截止时间
+//
+// Deprecated: 请改用 BicyclingV5Ctx。
+func (c *Client) BicyclingV2Ctx(ctx context.Context, req *bicyclingV2.BicyclingRequestV2) (*bicyclingV2.BicyclingResponseV2, error) {
+	return Do[bicyclingV2.BicyclingResponseV2](ctx, c, req)
 }
 
 // BusV2 公交路线规划API调用方法（v2）
+//
+// Deprecated: 该接口路径并非高德公开的路径规划2.0地址，请改用 TransitV5。
 func (c *Client) BusV2(req *busV2.BusRequestV2) (*busV2.BusResponseV2, error) {
-	// 校验必填参数
-	if req.Origin == "" {
-		return nil, amapErr.NewInvalidConfigError("公交路径规划v2：origin参数不能为空")
-	}
-	if req.Destination == "" {
-		return nil, amapErr.NewInvalidConfigError("公交路径规划v2：destination参数不能为空")
-	}
-	// 简单校验经纬度格式
-	if !strings.Contains(req.Origin, ",") || !strings.Contains(req.Destination, ",") {
-		return nil, amapErr.NewInvalidConfigError("公交路径规划v2：坐标格式错误，应为\"经度,纬度\"")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp busV2.BusResponseV2
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/direction/v2/transit/integrated", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.BusV2Ctx(context.Background(), req)
+}
 
-	return &resp, nil
+// BusV2Ctx 同 BusV2，支持通过 ctx 取消请求或传递截止时间
+//
+// Deprecated: 请改用 TransitV5Ctx。
+func (c *Client) BusV2Ctx(ctx context.Context, req *busV2.BusRequestV2) (*busV2.BusResponseV2, error) {
+	return Do[busV2.BusResponseV2](ctx, c, req)
 }
 
 // ElectricV2 电动车路线规划API调用方法（v2）
+//
+// Deprecated: 该接口路径并非高德公开的路径规划2.0地址，请改用 ElectrobikeV5。
 func (c *Client) ElectricV2(req *electricV2.ElectricRequestV2) (*electricV2.ElectricResponseV2, error) {
-	// 校验必填参数
-	if req.Origin == "" {
-		return nil, amapErr.NewInvalidConfigError("电动车路径规划v2：origin参数不能为空")
-	}
-	if req.Destination == "" {
-		return nil, amapErr.NewInvalidConfigError("电动车路径规划v2：destination参数不能为空")
-	}
-	// 简单校验经纬度格式
-	if !strings.Contains(req.Origin, ",") || !strings.Contains(req.Destination, ",") {
-		return nil, amapErr.NewInvalidConfigError("电动车路径规划v2：坐标格式错误，应为\"经度,纬度\"")
-	}
+	return c.ElectricV2Ctx(context.Background(), req)
+}
 
-	// 转换请求参数为map
-	params := req.ToParams()
+// ElectricV2Ctx 同 ElectricV2，支持通过 ctx 取消请求或传递截止时间
+//
+// Deprecated: 请改用 ElectrobikeV5Ctx。
+func (c *Client) ElectricV2Ctx(ctx context.Context, req *electricV2.ElectricRequestV2) (*electricV2.ElectricResponseV2, error) {
+	return Do[electricV2.ElectricResponseV2](ctx, c, req)
+}
 
-	// 调用核心请求方法
-	var resp electricV2.ElectricResponseV2
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/direction/v2/electric", params, &resp); err != nil {
-		return nil, err
-	}
+// DrivingV5 驾车路径规划API调用方法（路径规划2.0）
+func (c *Client) DrivingV5(req *drivingV5.DrivingRequestV5) (*drivingV5.DrivingResponseV5, error) {
+	return c.DrivingV5Ctx(context.Background(), req)
+}
 
-	return &resp, nil
+// DrivingV5Ctx 同 DrivingV5，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) DrivingV5Ctx(ctx context.Context, req *drivingV5.DrivingRequestV5) (*drivingV5.DrivingResponseV5, error) {
+	return Do[drivingV5.DrivingResponseV5](ctx, c, req)
 }
 
-// ETDDrivingV4 未来驾车路径规划API调用方法（v4）
-func (c *Client) ETDDrivingV4(req *etdDrivingV4.ETDDrivingRequestV4) (*etdDrivingV4.ETDDrivingResponseV4, error) {
-	// 校验必填参数
-	if req.Origin == "" {
-		return nil, amapErr.NewInvalidConfigError("未来驾车路径规划v4：origin参数不能为空")
-	}
-	if req.Destination == "" {
-		return nil, amapErr.NewInvalidConfigError("未来驾车路径规划v4：destination参数不能为空")
-	}
-	if req.DepartureTime == "" {
-		return nil, amapErr.NewInvalidConfigError("未来驾车路径规划v4：departure_time参数不能为空")
-	}
-	// 简单校验经纬度格式
-	if !strings.Contains(req.Origin, ",") || !strings.Contains(req.Destination, ",") {
-		return nil, amapErr.NewInvalidConfigError("未来驾车路径规划v4：坐标格式错误，应为\"经度,纬度\"")
-	}
+// WalkingV5 步行路径规划API调用方法（路径规划2.0）
+func (c *Client) WalkingV5(req *walkingV5.WalkingRequestV5) (*walkingV5.WalkingResponseV5, error) {
+	return c.WalkingV5Ctx(context.Background(), req)
+}
 
-	// 转换请求参数为map
-	params := req.ToParams()
+// WalkingV5Ctx 同 WalkingV5，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) WalkingV5Ctx(ctx context.Context, req *walkingV5.WalkingRequestV5) (*walkingV5.WalkingResponseV5, error) {
+	return Do[walkingV5.WalkingResponseV5](ctx, c, req)
+}
 
-	// 调用核心请求方法
-	var resp etdDrivingV4.ETDDrivingResponseV4
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/v4/etd/driving", params, &resp); err != nil {
-		return nil, err
-	}
+// BicyclingV5 骑行路径规划API调用方法（路径规划2.0）
+func (c *Client) BicyclingV5(req *bicyclingV5.BicyclingRequestV5) (*bicyclingV5.BicyclingResponseV5, error) {
+	return c.BicyclingV5Ctx(context.Background(), req)
+}
 
-	return &resp, nil
+// BicyclingV5Ctx 同 BicyclingV5，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) BicyclingV5Ctx(ctx context.Context, req *bicyclingV5.BicyclingRequestV5) (*bicyclingV5.BicyclingResponseV5, error) {
+	return Do[bicyclingV5.BicyclingResponseV5](ctx, c, req)
 }
 
-// Distance 距离测量API调用方法
-func (c *Client) Distance(req *distance.DistanceRequest) (*distance.DistanceResponse, error) {
-	// 校验必填参数
-	if req.Origins == "" {
-		return nil, amapErr.NewInvalidConfigError("距离测量：origins参数不能为空")
-	}
-	if req.Destination == "" {
-		return nil, amapErr.NewInvalidConfigError("距离测量：destination参数不能为空")
-	}
+// ElectrobikeV5 电动车路径规划API调用方法（路径规划2.0）
+func (c *Client) ElectrobikeV5(req *electrobikeV5.ElectrobikeRequestV5) (*electrobikeV5.ElectrobikeResponseV5, error) {
+	return c.ElectrobikeV5Ctx(context.Background(), req)
+}
 
-	// 简单校验经纬度格式（至少包含一个有效的经纬度对）
-	if !strings.Contains(req.Origins, ",") || !strings.Contains(req.Destination, ",") {
-		return nil, amapErr.NewInvalidConfigError("距离测量：坐标格式错误，应为\"经度,纬度|经度,纬度\"或单个\"经度,纬度\"")
-	}
+// ElectrobikeV5Ctx 同 ElectrobikeV5，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) ElectrobikeV5Ctx(ctx context.Context, req *electrobikeV5.ElectrobikeRequestV5) (*electrobikeV5.ElectrobikeResponseV5, error) {
+	return Do[electrobikeV5.ElectrobikeResponseV5](ctx, c, req)
+}
 
-	// 转换请求参数为map
-	params := req.ToParams()
+// TransitV5 公交路径规划API调用方法（路径规划2.0）
+func (c *Client) TransitV5(req *transitV5.TransitRequestV5) (*transitV5.TransitResponseV5, error) {
+	return c.TransitV5Ctx(context.Background(), req)
+}
 
-	// 调用核心请求方法
-	var resp distance.DistanceResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/direction/distance", params, &resp); err != nil {
-		return nil, err
-	}
+// TransitV5Ctx 同 TransitV5，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) TransitV5Ctx(ctx context.Context, req *transitV5.TransitRequestV5) (*transitV5.TransitResponseV5, error) {
+	return Do[transitV5.TransitResponseV5](ctx, c, req)
+}
+
+// ETDDrivingV4 未来驾车路径规划API调用方法（v4）
+func (c *Client) ETDDrivingV4(req *etdDrivingV4.ETDDrivingRequestV4) (*etdDrivingV4.ETDDrivingResponseV4, error) {
+	return c.ETDDrivingV4Ctx(context.Background(), req)
+}
 
-	return &resp, nil
+// ETDDrivingV4Ctx 同 ETDDrivingV4，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) ETDDrivingV4Ctx(ctx context.Context, req *etdDrivingV4.ETDDrivingRequestV4) (*etdDrivingV4.ETDDrivingResponseV4, error) {
+	return Do[etdDrivingV4.ETDDrivingResponseV4](ctx, c, req)
+}
+
+// Distance 距离测量API调用方法
+func (c *Client) Distance(req *distance.DistanceRequest) (*distance.DistanceResponse, error) {
+	return c.DistanceCtx(context.Background(), req)
+}
+
+// DistanceCtx 同 Distance，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) DistanceCtx(ctx context.Context, req *distance.DistanceRequest) (*distance.DistanceResponse, error) {
+	return Do[distance.DistanceResponse](ctx, c, req)
 }
 
 // District 行政区查询API调用方法
 // 支持通过关键字搜索行政区，可指定返回子级行政区的级别
 func (c *Client) District(req *district.DistrictRequest) (*district.DistrictResponse, error) {
-	// 校验必填参数
-	if req.Keywords == "" {
-		return nil, amapErr.NewInvalidConfigError("行政区查询：keywords参数不能为空")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp district.DistrictResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/config/district", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.DistrictCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// DistrictCtx 同 District，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) DistrictCtx(ctx context.Context, req *district.DistrictRequest) (*district.DistrictResponse, error) {
+	return Do[district.DistrictResponse](ctx, c, req)
 }
 
 // TrafficIncident 交通事件查询API调用方法
 // 支持查询指定区域内的交通事件，可按事件级别和类型筛选
 func (c *Client) TrafficIncident(req *trafficIncident.TrafficIncidentRequest) (*trafficIncident.TrafficIncidentResponse, error) {
-	// 校验必填参数
-	if req.Level == "" {
-		return nil, amapErr.NewInvalidConfigError("交通事件查询：level参数不能为空")
-	}
-	if req.Type == "" {
-		return nil, amapErr.NewInvalidConfigError("交通事件查询：type参数不能为空")
-	}
-	if req.Rectangle == "" {
-		return nil, amapErr.NewInvalidConfigError("交通事件查询：rectangle参数不能为空")
-	}
-
-	// 简单校验矩形区域格式（必须包含3个逗号，如："116.351147,39.904989,116.480317,39.976564"）
-	commaCount := 0
-	for _, char := range req.Rectangle {
-		if char == ',' {
-			commaCount++
-		}
-	}
-	if commaCount != 3 {
-		return nil, amapErr.NewInvalidConfigError("交通事件查询：rectangle格式错误，应为\"左下经度,左下纬度,右上经度,右上纬度\"")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp trafficIncident.TrafficIncidentResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/traffic/status", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.TrafficIncidentCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// TrafficIncidentCtx 同 TrafficIncident，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) TrafficIncidentCtx(ctx context.Context, req *trafficIncident.TrafficIncidentRequest) (*trafficIncident.TrafficIncidentResponse, error) {
+	return Do[trafficIncident.TrafficIncidentResponse](ctx, c, req)
 }
 
 // IPConfig IP定位API调用方法
 // 支持通过IP地址查询地理位置信息，返回省份、城市、区县、ISP等信息
 func (c *Client) IPConfig(req *ipV3.IPConfigRequest) (*ipV3.IPConfigResponse, error) {
-	// 校验必填参数
-	if req.IP == "" {
-		return nil, amapErr.NewInvalidConfigError("IP定位：ip参数不能为空")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp ipV3.IPConfigResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/ip", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.IPConfigCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// IPConfigCtx 同 IPConfig，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) IPConfigCtx(ctx context.Context, req *ipV3.IPConfigRequest) (*ipV3.IPConfigResponse, error) {
+	return Do[ipV3.IPConfigResponse](ctx, c, req)
 }
 
 // IPV5Config IP定位API调用方法（v5）
 // 支持通过IP地址查询地理位置信息，返回省份、城市、区县、ISP等信息
 func (c *Client) IPV5Config(req *ipV5.IPConfigRequest) (*ipV5.IPConfigResponse, error) {
-	// 校验必填参数
-	if req.IP == "" {
-		return nil, amapErr.NewInvalidConfigError("IP定位v5：ip参数不能为空")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp ipV5.IPConfigResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/v5/ip", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.IPV5ConfigCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// IPV5ConfigCtx 同 IPV5Config，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) IPV5ConfigCtx(ctx context.Context, req *ipV5.IPConfigRequest) (*ipV5.IPConfigResponse, error) {
+	return Do[ipV5.IPConfigResponse](ctx, c, req)
 }
 
 // Convert 坐标转换API调用方法
 // 支持将其他坐标系的坐标转换为高德坐标系（GCJ02）
 // 支持批量转换，一次最多转换40对坐标
 func (c *Client) Convert(req *convert.ConvertRequest) (*convert.ConvertResponse, error) {
-	// 校验必填参数
-	if req.Locations == "" {
-		return nil, amapErr.NewInvalidConfigError("坐标转换：locations参数不能为空")
-	}
-	if req.CoordSys == "" {
-		return nil, amapErr.NewInvalidConfigError("坐标转换：coordsys参数不能为空")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp convert.ConvertResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/convert", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.ConvertCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// ConvertCtx 同 Convert，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) ConvertCtx(ctx context.Context, req *convert.ConvertRequest) (*convert.ConvertResponse, error) {
+	return Do[convert.ConvertResponse](ctx, c, req)
 }
 
 // GraspRoad 轨迹纠偏API调用方法
 // 用于将原始轨迹点转换为匹配道路的轨迹点，支持批量处理
 func (c *Client) GraspRoad(req *grasproad.GraspRoadRequest) (*grasproad.GraspRoadResponse, error) {
-	// 校验必填参数
-	if req.SID == "" {
-		return nil, amapErr.NewInvalidConfigError("轨迹纠偏：sid参数不能为空")
-	}
-	if req.Points == "" {
-		return nil, amapErr.NewInvalidConfigError("轨迹纠偏：points参数不能为空")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp grasproad.GraspRoadResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/grasproad", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.GraspRoadCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// GraspRoadCtx 同 GraspRoad，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) GraspRoadCtx(ctx context.Context, req *grasproad.GraspRoadRequest) (*grasproad.GraspRoadResponse, error) {
+	return Do[grasproad.GraspRoadResponse](ctx, c, req)
 }
 
 // PlaceV3ID POI ID查询API调用方法（v3）
 // 根据POI ID查询详细信息
 func (c *Client) PlaceV3ID(req *placev3id.IDRequest) (*placev3id.IDResponse, error) {
-	// 校验必填参数
-	if req.ID == "" {
-		return nil, amapErr.NewInvalidConfigError("POI ID查询：id参数不能为空")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp placev3id.IDResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/place/detail", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.PlaceV3IDCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// PlaceV3IDCtx 同 PlaceV3ID，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) PlaceV3IDCtx(ctx context.Context, req *placev3id.IDRequest) (*placev3id.IDResponse, error) {
+	return Do[placev3id.IDResponse](ctx, c, req)
 }
 
 // PlaceV3Text POI文本搜索API调用方法（v3）
 // 基于关键词的搜索，支持矩形范围搜索
 func (c *Client) PlaceV3Text(req *placev3text.TextSearchRequest) (*placev3text.TextSearchResponse, error) {
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp placev3text.TextSearchResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/place/text", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.PlaceV3TextCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// PlaceV3TextCtx 同 PlaceV3Text，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) PlaceV3TextCtx(ctx context.Context, req *placev3text.TextSearchRequest) (*placev3text.TextSearchResponse, error) {
+	return Do[placev3text.TextSearchResponse](ctx, c, req)
 }
 
 // PlaceV3Around POI周边搜索API调用方法（v3）
 // 基于中心点和半径的搜索，用于查询指定区域内的POI
 func (c *Client) PlaceV3Around(req *placev3around.AroundSearchRequest) (*placev3around.AroundSearchResponse, error) {
-	// 校验必填参数
-	if req.Location == "" {
-		return nil, amapErr.NewInvalidConfigError("POI周边搜索：location参数不能为空")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp placev3around.AroundSearchResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/place/around", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.PlaceV3AroundCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// PlaceV3AroundCtx 同 PlaceV3Around，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) PlaceV3AroundCtx(ctx context.Context, req *placev3around.AroundSearchRequest) (*placev3around.AroundSearchResponse, error) {
+	return Do[placev3around.AroundSearchResponse](ctx, c, req)
 }
 
 // PlaceV3Polygon POI多边形搜索API调用方法（v3）
 // 基于多边形边界的搜索，用于查询指定多边形区域内的POI
 func (c *Client) PlaceV3Polygon(req *placev3polygon.PolygonSearchRequest) (*placev3polygon.PolygonSearchResponse, error) {
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp placev3polygon.PolygonSearchResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/place/polygon", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.PlaceV3PolygonCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// PlaceV3PolygonCtx 同 PlaceV3Polygon，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) PlaceV3PolygonCtx(ctx context.Context, req *placev3polygon.PolygonSearchRequest) (*placev3polygon.PolygonSearchResponse, error) {
+	return Do[placev3polygon.PolygonSearchResponse](ctx, c, req)
 }
 
 // PlaceV3AOI POI AOI查询API调用方法（v3）
 // 用于查询指定AOI区域内的POI
 func (c *Client) PlaceV3AOI(req *placev3aoi.AOISearchRequest) (*placev3aoi.AOISearchResponse, error) {
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp placev3aoi.AOISearchResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/place/aoi", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.PlaceV3AOICtx(context.Background(), req)
+}
 
-	return &resp, nil
+// PlaceV3AOICtx 同 PlaceV3AOI，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) PlaceV3AOICtx(ctx context.Context, req *placev3aoi.AOISearchRequest) (*placev3aoi.AOISearchResponse, error) {
+	return Do[placev3aoi.AOISearchResponse](ctx, c, req)
 }
 
 // PlaceV5ID POI ID查询API调用方法（v5）
 // 根据POI ID查询详细信息
 func (c *Client) PlaceV5ID(req *placev5id.IDRequest) (*placev5id.IDResponse, error) {
-	// 校验必填参数
-	if req.ID == "" {
-		return nil, amapErr.NewInvalidConfigError("POI ID查询v5：id参数不能为空")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp placev5id.IDResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/v5/place/detail", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.PlaceV5IDCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// PlaceV5IDCtx 同 PlaceV5ID，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) PlaceV5IDCtx(ctx context.Context, req *placev5id.IDRequest) (*placev5id.IDResponse, error) {
+	return Do[placev5id.IDResponse](ctx, c, req)
 }
 
 // PlaceV5Text POI文本搜索API调用方法（v5）
 // 基于关键词的搜索，用于查询指定区域内的POI
 func (c *Client) PlaceV5Text(req *placev5text.TextSearchRequest) (*placev5text.TextSearchResponse, error) {
-	// 校验必填参数
-	if req.Keyword == "" {
-		return nil, amapErr.NewInvalidConfigError("POI文本搜索v5：keyword参数不能为空")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp placev5text.TextSearchResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/v5/place/text", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.PlaceV5TextCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// PlaceV5TextCtx 同 PlaceV5Text，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) PlaceV5TextCtx(ctx context.Context, req *placev5text.TextSearchRequest) (*placev5text.TextSearchResponse, error) {
+	return Do[placev5text.TextSearchResponse](ctx, c, req)
 }
 
 // PlaceV5Around POI周边搜索API调用方法（v5）
 // 基于中心点和半径的搜索，用于查询指定区域内的POI
 func (c *Client) PlaceV5Around(req *placev5around.AroundSearchRequest) (*placev5around.AroundSearchResponse, error) {
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp placev5around.AroundSearchResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/v5/place/around", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.PlaceV5AroundCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// PlaceV5AroundCtx 同 PlaceV5Around，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) PlaceV5AroundCtx(ctx context.Context, req *placev5around.AroundSearchRequest) (*placev5around.AroundSearchResponse, error) {
+	return Do[placev5around.AroundSearchResponse](ctx, c, req)
 }
 
 // PlaceV5Polygon POI多边形搜索API调用方法（v5）
 // 基于多边形边界的搜索，用于查询指定多边形区域内的POI
 func (c *Client) PlaceV5Polygon(req *placev5polygon.PolygonSearchRequest) (*placev5polygon.PolygonSearchResponse, error) {
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp placev5polygon.PolygonSearchResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/v5/place/polygon", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.PlaceV5PolygonCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// PlaceV5PolygonCtx 同 PlaceV5Polygon，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) PlaceV5PolygonCtx(ctx context.Context, req *placev5polygon.PolygonSearchRequest) (*placev5polygon.PolygonSearchResponse, error) {
+	return Do[placev5polygon.PolygonSearchResponse](ctx, c, req)
 }
 
 // PlaceV5AOI POI AOI查询API调用方法（v5）
 // 用于查询指定AOI区域内的POI
 func (c *Client) PlaceV5AOI(req *placev5aoi.AOISearchRequest) (*placev5aoi.AOISearchResponse, error) {
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp placev5aoi.AOISearchResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/v5/place/aoi", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.PlaceV5AOICtx(context.Background(), req)
+}
 
-	return &resp, nil
+// PlaceV5AOICtx 同 PlaceV5AOI，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) PlaceV5AOICtx(ctx context.Context, req *placev5aoi.AOISearchRequest) (*placev5aoi.AOISearchResponse, error) {
+	return Do[placev5aoi.AOISearchResponse](ctx, c, req)
 }
 
 // Inputtips 输入提示API调用方法
 // 支持根据关键字获取输入提示，可指定城市、类型等过滤条件
 func (c *Client) Inputtips(req *inputtips.InputtipsRequest) (*inputtips.InputtipsResponse, error) {
-	// 校验必填参数
-	if req.Keywords == "" {
-		return nil, amapErr.NewInvalidConfigError("输入提示：keywords参数不能为空")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp inputtips.InputtipsResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/assistant/inputtips", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.InputtipsCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// InputtipsCtx 同 Inputtips，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) InputtipsCtx(ctx context.Context, req *inputtips.InputtipsRequest) (*inputtips.InputtipsResponse, error) {
+	return Do[inputtips.InputtipsResponse](ctx, c, req)
 }
 
 // Weatherinfo 天气信息API调用方法
 // 支持获取实时天气、预报信息和生活指数建议
 func (c *Client) Weatherinfo(req *weatherinfo.WeatherinfoRequest) (*weatherinfo.WeatherinfoResponse, error) {
-	// 校验必填参数
-	if req.City == "" {
-		return nil, amapErr.NewInvalidConfigError("天气信息：city参数不能为空")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp weatherinfo.WeatherinfoResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/weather/weatherInfo", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.WeatherinfoCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// WeatherinfoCtx 同 Weatherinfo，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) WeatherinfoCtx(ctx context.Context, req *weatherinfo.WeatherinfoRequest) (*weatherinfo.WeatherinfoResponse, error) {
+	return Do[weatherinfo.WeatherinfoResponse](ctx, c, req)
 }
 
 // HardwarePosition 硬件定位API调用方法（v1）
 // 支持通过硬件设备信息（如GPS、基站、WiFi等）获取地理位置信息
 func (c *Client) HardwarePosition(req *positionV1.HardwarePositionRequest) (*positionV1.HardwarePositionResponse, error) {
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp positionV1.HardwarePositionResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/position/v1/hardware", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.HardwarePositionCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// HardwarePositionCtx 同 HardwarePosition，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) HardwarePositionCtx(ctx context.Context, req *positionV1.HardwarePositionRequest) (*positionV1.HardwarePositionResponse, error) {
+	return Do[positionV1.HardwarePositionResponse](ctx, c, req)
 }
 
 // HardwarePositionV5 硬件定位API调用方法（v5）
 // 支持通过硬件设备信息（如GPS、基站、WiFi等）获取地理位置信息，v5版本增强了定位精度和多源数据融合能力
 func (c *Client) HardwarePositionV5(req *positionV5.HardwarePositionRequest) (*positionV5.HardwarePositionResponse, error) {
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp positionV5.HardwarePositionResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/v5/position/hardware", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.HardwarePositionV5Ctx(context.Background(), req)
+}
 
-	return &resp, nil
+// HardwarePositionV5Ctx 同 HardwarePositionV5，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) HardwarePositionV5Ctx(ctx context.Context, req *positionV5.HardwarePositionRequest) (*positionV5.HardwarePositionResponse, error) {
+	return Do[positionV5.HardwarePositionResponse](ctx, c, req)
 }
 
 // LineTrafficStatus 指定线路交通态势查询API调用方法
 // 支持查询指定线路的交通态势信息
 func (c *Client) LineTrafficStatus(req *line.LineTrafficRequest) (*line.LineTrafficResponse, error) {
-	// 校验必填参数
-	if req.Path == "" {
-		return nil, amapErr.NewInvalidConfigError("指定线路交通态势查询：path参数不能为空")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp line.LineTrafficResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/v3/traffic/status/road", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.LineTrafficStatusCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// LineTrafficStatusCtx 同 LineTrafficStatus，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) LineTrafficStatusCtx(ctx context.Context, req *line.LineTrafficRequest) (*line.LineTrafficResponse, error) {
+	return Do[line.LineTrafficResponse](ctx, c, req)
 }
 
 // CircleTrafficStatus 圆形区域内交通态势查询API调用方法
 // 支持查询指定圆形区域内的交通态势信息
 func (c *Client) CircleTrafficStatus(req *circle.CircleTrafficRequest) (*circle.CircleTrafficResponse, error) {
-	// 校验必填参数
-	if req.Center == "" {
-		return nil, amapErr.NewInvalidConfigError("圆形区域交通态势查询：center参数不能为空")
-	}
-	if req.Radius == "" {
-		return nil, amapErr.NewInvalidConfigError("圆形区域交通态势查询：radius参数不能为空")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp circle.CircleTrafficResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/v3/traffic/status/circle", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.CircleTrafficStatusCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// CircleTrafficStatusCtx 同 CircleTrafficStatus，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) CircleTrafficStatusCtx(ctx context.Context, req *circle.CircleTrafficRequest) (*circle.CircleTrafficResponse, error) {
+	return Do[circle.CircleTrafficResponse](ctx, c, req)
 }
 
 // RectangleTrafficStatus 矩形区域内交通态势查询API调用方法
 // 支持查询指定矩形区域内的交通态势信息
 func (c *Client) RectangleTrafficStatus(req *rectangle.RectangleTrafficRequest) (*rectangle.RectangleTrafficResponse, error) {
-	// 校验必填参数
-	if req.Rectangle == "" {
-		return nil, amapErr.NewInvalidConfigError("矩形区域交通态势查询：rectangle参数不能为空")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp rectangle.RectangleTrafficResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/v3/traffic/status/rectangle", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.RectangleTrafficStatusCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// RectangleTrafficStatusCtx 同 RectangleTrafficStatus，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) RectangleTrafficStatusCtx(ctx context.Context, req *rectangle.RectangleTrafficRequest) (*rectangle.RectangleTrafficResponse, error) {
+	return Do[rectangle.RectangleTrafficResponse](ctx, c, req)
 }
 
 // BusStationID 公交站ID查询API调用方法
 // 根据公交站点ID查询经过该站点的所有公交线路详细信息
 func (c *Client) BusStationID(req *busStationID.StationIDRequest) (*busStationID.StationIDResponse, error) {
-	// 校验必填参数
-	if req.ID == "" {
-		return nil, amapErr.NewInvalidConfigError("公交站ID查询：id参数不能为空")
-	}
-	if req.City == "" {
-		return nil, amapErr.NewInvalidConfigError("公交站ID查询：city参数不能为空")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp busStationID.StationIDResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/bus/linename", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.BusStationIDCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// BusStationIDCtx 同 BusStationID，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) BusStationIDCtx(ctx context.Context, req *busStationID.StationIDRequest) (*busStationID.StationIDResponse, error) {
+	return Do[busStationID.StationIDResponse](ctx, c, req)
 }
 
 // BusStationKeyword 公交站关键字查询API调用方法
 // 根据公交站点名称关键字查询公交站点及经过该站点的公交线路信息
 func (c *Client) BusStationKeyword(req *busStationKeyword.StationKeywordRequest) (*busStationKeyword.StationKeywordResponse, error) {
-	// 校验必填参数
-	if req.Keywords == "" {
-		return nil, amapErr.NewInvalidConfigError("公交站关键字查询：keywords参数不能为空")
-	}
-	if req.City == "" {
-		return nil, amapErr.NewInvalidConfigError("公交站关键字查询：city参数不能为空")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp busStationKeyword.StationKeywordResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/bus/station/search", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.BusStationKeywordCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// BusStationKeywordCtx 同 BusStationKeyword，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) BusStationKeywordCtx(ctx context.Context, req *busStationKeyword.StationKeywordRequest) (*busStationKeyword.StationKeywordResponse, error) {
+	return Do[busStationKeyword.StationKeywordResponse](ctx, c, req)
 }
 
 // BusLineID 公交路线ID查询API调用方法
 // 根据公交线路ID查询该线路的详细信息
 func (c *Client) BusLineID(req *busLineID.LineIDRequest) (*busLineID.LineIDResponse, error) {
-	// 校验必填参数
-	if req.ID == "" {
-		return nil, amapErr.NewInvalidConfigError("公交路线ID查询：id参数不能为空")
-	}
-	if req.City == "" {
-		return nil, amapErr.NewInvalidConfigError("公交路线ID查询：city参数不能为空")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp busLineID.LineIDResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/bus/lineid", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.BusLineIDCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// BusLineIDCtx 同 BusLineID，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) BusLineIDCtx(ctx context.Context, req *busLineID.LineIDRequest) (*busLineID.LineIDResponse, error) {
+	return Do[busLineID.LineIDResponse](ctx, c, req)
 }
 
 // BusLineKeyword 公交路线关键字查询API调用方法
 // 根据公交线路名称关键字查询公交线路详细信息
 func (c *Client) BusLineKeyword(req *busLineKeyword.LineKeywordRequest) (*busLineKeyword.LineKeywordResponse, error) {
-	// 校验必填参数
-	if req.Keywords == "" {
-		return nil, amapErr.NewInvalidConfigError("公交路线关键字查询：keywords参数不能为空")
-	}
-	if req.City == "" {
-		return nil, amapErr.NewInvalidConfigError("公交路线关键字查询：city参数不能为空")
-	}
-
-	// 转换请求参数为map
-	params := req.ToParams()
-
-	// 调用核心请求方法
-	var resp busLineKeyword.LineKeywordResponse
-	if err := c.DoRequest(http.MethodGet, "https://restapi.amap.com/v3/bus/line/search", params, &resp); err != nil {
-		return nil, err
-	}
+	return c.BusLineKeywordCtx(context.Background(), req)
+}
 
-	return &resp, nil
+// BusLineKeywordCtx 同 BusLineKeyword，支持通过 ctx 取消请求或传递截止时间
+func (c *Client) BusLineKeywordCtx(ctx context.Context, req *busLineKeyword.LineKeywordRequest) (*busLineKeyword.LineKeywordResponse, error) {
+	return Do[busLineKeyword.LineKeywordResponse](ctx, c, req)
 }
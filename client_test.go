@@ -1,19 +1,25 @@
 package amap
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sort"
 	"strconv"
 	"testing"
 	"time"
 
+	"github.com/enneket/amap/amaptest"
 	busLineID "github.com/enneket/amap/api/bus/line_id"
 	busLineKeyword "github.com/enneket/amap/api/bus/line_keyword"
 	busStationID "github.com/enneket/amap/api/bus/station_id"
 	busStationKeyword "github.com/enneket/amap/api/bus/station_keyword"
 	convert "github.com/enneket/amap/api/convert"
+	"github.com/enneket/amap/api/direction"
 	bicycling "github.com/enneket/amap/api/direction/v1/bicycling"
+	busV1 "github.com/enneket/amap/api/direction/v1/bus"
 	driving "github.com/enneket/amap/api/direction/v1/driving"
 	walking "github.com/enneket/amap/api/direction/v1/walking"
 	bicyclingV2 "github.com/enneket/amap/api/direction/v2/bicycling"
@@ -21,6 +27,8 @@ import (
 	drivingV2 "github.com/enneket/amap/api/direction/v2/driving"
 	electricV2 "github.com/enneket/amap/api/direction/v2/electric"
 	walkingV2 "github.com/enneket/amap/api/direction/v2/walking"
+	drivingV5 "github.com/enneket/amap/api/direction/v5/driving"
+	transitV5 "github.com/enneket/amap/api/direction/v5/transit"
 	distance "github.com/enneket/amap/api/distance"
 	district "github.com/enneket/amap/api/district"
 	etdDrivingV4 "github.com/enneket/amap/api/etd/v4/driving"
@@ -29,6 +37,7 @@ import (
 	inputtips "github.com/enneket/amap/api/input_tips"
 	ipV3 "github.com/enneket/amap/api/ip/v3"
 	ipV5 "github.com/enneket/amap/api/ip/v5"
+	"github.com/enneket/amap/api/place"
 	placev3aoi "github.com/enneket/amap/api/place/v3/aoi"
 	placev3around "github.com/enneket/amap/api/place/v3/around"
 	placev3id "github.com/enneket/amap/api/place/v3/id"
@@ -48,6 +57,9 @@ import (
 	rectangle "github.com/enneket/amap/api/traffic_situation/rectangle"
 	"github.com/enneket/amap/api/weatherinfo"
 	amapErr "github.com/enneket/amap/errors"
+	"github.com/enneket/amap/geo"
+	"github.com/enneket/amap/geojson"
+	amapType "github.com/enneket/amap/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -79,6 +91,7 @@ func TestDoRequest_Success(t *testing.T) {
 
 	// 2. 创建Client实例，使用mock服务器地址
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
@@ -103,6 +116,7 @@ func TestDoRequest_APIError(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -112,9 +126,8 @@ func TestDoRequest_APIError(t *testing.T) {
 	err = client.DoRequest(http.MethodGet, mockServer.URL+"/test/path", nil, &resp)
 
 	// 4. 验证结果
-	assert.Error(t, err)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10001", apiErr.Code)
 	assert.Equal(t, "无效的Key", apiErr.Info)
 }
@@ -134,6 +147,7 @@ func TestPlaceV3ID_Success(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -167,6 +181,7 @@ func TestPlaceV3Text_Success(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -178,7 +193,7 @@ func TestPlaceV3Text_Success(t *testing.T) {
 	// 4. 验证结果
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, "1", resp.Count)
+	assert.EqualValues(t, "1", resp.Count)
 }
 
 // TestPlaceV3Around_Success 测试PlaceV3Around方法成功
@@ -200,18 +215,19 @@ func TestPlaceV3Around_Success(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
 	// 3. 执行请求
-	req := &placev3around.AroundSearchRequest{Location: "116.397428,39.90923"}
+	req := &placev3around.AroundSearchRequest{Location: amapType.LngLat{Lng: 116.397428, Lat: 39.90923}}
 	resp, err := client.PlaceV3Around(req)
 
 	// 4. 验证结果
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, "1", resp.Count)
+	assert.EqualValues(t, "1", resp.Count)
 }
 
 // TestPlaceV3Polygon_Success 测试PlaceV3Polygon方法成功
@@ -233,18 +249,19 @@ func TestPlaceV3Polygon_Success(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
 	// 3. 执行请求
-	req := &placev3polygon.PolygonSearchRequest{}
+	req := &placev3polygon.PolygonSearchRequest{Polygon: amapType.LngLatList{{Lng: 116.397428, Lat: 39.90923}, {Lng: 116.407428, Lat: 39.91923}}}
 	resp, err := client.PlaceV3Polygon(req)
 
 	// 4. 验证结果
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, "1", resp.Count)
+	assert.EqualValues(t, "1", resp.Count)
 }
 
 // TestPlaceV3AOI_Success 测试PlaceV3AOI方法成功
@@ -266,6 +283,7 @@ func TestPlaceV3AOI_Success(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -277,7 +295,7 @@ func TestPlaceV3AOI_Success(t *testing.T) {
 	// 4. 验证结果
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, "1", resp.Count)
+	assert.EqualValues(t, "1", resp.Count)
 }
 
 // TestPlaceV5ID_Success 测试PlaceV5ID方法成功
@@ -298,6 +316,7 @@ func TestPlaceV5ID_Success(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -331,6 +350,7 @@ func TestPlaceV5Text_Success(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -342,7 +362,7 @@ func TestPlaceV5Text_Success(t *testing.T) {
 	// 4. 验证结果
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, "1", resp.Count)
+	assert.EqualValues(t, "1", resp.Count)
 }
 
 // TestPlaceV5Around_Success 测试PlaceV5Around方法成功
@@ -364,18 +384,19 @@ func TestPlaceV5Around_Success(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
 	// 3. 执行请求
-	req := &placev5around.AroundSearchRequest{Location: "116.397428,39.90923"}
+	req := &placev5around.AroundSearchRequest{Location: amapType.LngLat{Lng: 116.397428, Lat: 39.90923}}
 	resp, err := client.PlaceV5Around(req)
 
 	// 4. 验证结果
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, "1", resp.Count)
+	assert.EqualValues(t, "1", resp.Count)
 }
 
 // TestPlaceV5Polygon_Success 测试PlaceV5Polygon方法成功
@@ -397,18 +418,19 @@ func TestPlaceV5Polygon_Success(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
 	// 3. 执行请求
-	req := &placev5polygon.PolygonSearchRequest{Polygon: "116.397428,39.90923;116.407428,39.90923"}
+	req := &placev5polygon.PolygonSearchRequest{Polygon: amapType.LngLatList{{Lng: 116.397428, Lat: 39.90923}, {Lng: 116.407428, Lat: 39.90923}}}
 	resp, err := client.PlaceV5Polygon(req)
 
 	// 4. 验证结果
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, "1", resp.Count)
+	assert.EqualValues(t, "1", resp.Count)
 }
 
 // TestPlaceV5AOI_Success 测试PlaceV5AOI方法成功
@@ -430,6 +452,7 @@ func TestPlaceV5AOI_Success(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -441,7 +464,7 @@ func TestPlaceV5AOI_Success(t *testing.T) {
 	// 4. 验证结果
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, "1", resp.Count)
+	assert.EqualValues(t, "1", resp.Count)
 }
 
 // TestDoRequest_ParseError 测试响应解析错误
@@ -452,6 +475,7 @@ func TestDoRequest_ParseError(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -505,6 +529,7 @@ func TestDoRequest_WithSignature(t *testing.T) {
 
 	// 2. 创建Client实例，配置SecurityKey
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	config.SecurityKey = "test_security_key"
 	client, err := NewClient(config)
@@ -548,6 +573,7 @@ func TestDoRequest_PublicParams(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -583,6 +609,7 @@ func TestDoRequest_InvalidJSON(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -617,6 +644,7 @@ func TestBuildPublicParams(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -682,19 +710,19 @@ func TestGeoCode_Success(t *testing.T) {
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
-	assert.Equal(t, "1", resp.Count)
+	assert.EqualValues(t, "1", resp.Count)
 	assert.Len(t, resp.Geocodes, 1)
-	assert.Equal(t, "北京市朝阳区望京SOHO", resp.Geocodes[0].FormattedAddress)
-	assert.Equal(t, "中国", resp.Geocodes[0].Country)
-	assert.Equal(t, "北京市", resp.Geocodes[0].Province)
-	assert.Equal(t, "北京市", resp.Geocodes[0].City)
-	assert.Equal(t, "110000", resp.Geocodes[0].Citycode)
-	assert.Equal(t, "朝阳区", resp.Geocodes[0].District)
-	assert.Equal(t, "110105", resp.Geocodes[0].Adcode)
-	assert.Equal(t, "望京街", resp.Geocodes[0].Street)
-	assert.Equal(t, "8号", resp.Geocodes[0].Number)
-	assert.Equal(t, "116.48649,39.99947", resp.Geocodes[0].Location)
-	assert.Equal(t, "门牌号", resp.Geocodes[0].Level)
+	assert.EqualValues(t, "北京市朝阳区望京SOHO", resp.Geocodes[0].FormattedAddress)
+	assert.EqualValues(t, "中国", resp.Geocodes[0].Country)
+	assert.EqualValues(t, "北京市", resp.Geocodes[0].Province)
+	assert.EqualValues(t, "北京市", resp.Geocodes[0].City)
+	assert.EqualValues(t, "110000", resp.Geocodes[0].Citycode)
+	assert.EqualValues(t, "朝阳区", resp.Geocodes[0].District)
+	assert.EqualValues(t, "110105", resp.Geocodes[0].Adcode)
+	assert.EqualValues(t, "望京街", resp.Geocodes[0].Street)
+	assert.EqualValues(t, "8号", resp.Geocodes[0].Number)
+	assert.EqualValues(t, "116.48649,39.99947", resp.Geocodes[0].Location)
+	assert.EqualValues(t, "门牌号", resp.Geocodes[0].Level)
 }
 
 // TestGeoCode_MissingAddress 测试GeoCode方法缺少必填参数Address
@@ -747,8 +775,8 @@ func TestGeoCode_APIError(t *testing.T) {
 	// 5. 验证结果
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10001", apiErr.Code)
 	assert.Equal(t, "无效的Key", apiErr.Info)
 }
@@ -771,8 +799,10 @@ func TestReGeocode_Success(t *testing.T) {
 				"adcode": "110105",
 				"township": "望京街道",
 				"towncode": "110105028",
-				"street": "望京街",
-				"number": "8号"
+				"streetNumber": {
+					"street": "望京街",
+					"number": "8号"
+				}
 			},
 			"township": "望京街道"
 		}
@@ -788,7 +818,7 @@ func TestReGeocode_Success(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &reGeoCode.ReGeocodeRequest{
-		Location:   "116.48649,39.99947",
+		Location:   amapType.LngLat{Lng: 116.48649, Lat: 39.99947},
 		Radius:     500,
 		Extensions: "base",
 	}
@@ -803,18 +833,18 @@ func TestReGeocode_Success(t *testing.T) {
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
 	assert.NotNil(t, resp.ReGeocode)
-	assert.Equal(t, "北京市朝阳区望京街道望京SOHO T1", resp.ReGeocode.FormattedAddress)
-	assert.Equal(t, "中国", resp.ReGeocode.AddressComponent.Country)
-	assert.Equal(t, "北京市", resp.ReGeocode.AddressComponent.Province)
+	assert.EqualValues(t, "北京市朝阳区望京街道望京SOHO T1", resp.ReGeocode.FormattedAddress)
+	assert.EqualValues(t, "中国", resp.ReGeocode.AddressComponent.Country)
+	assert.EqualValues(t, "北京市", resp.ReGeocode.AddressComponent.Province)
 	assert.Equal(t, "北京市", resp.ReGeocode.AddressComponent.City[0])
-	assert.Equal(t, "110000", resp.ReGeocode.AddressComponent.Citycode)
-	assert.Equal(t, "朝阳区", resp.ReGeocode.AddressComponent.District)
-	assert.Equal(t, "110105", resp.ReGeocode.AddressComponent.Adcode)
-	assert.Equal(t, "望京街道", resp.ReGeocode.AddressComponent.Township)
-	assert.Equal(t, "110105028", resp.ReGeocode.AddressComponent.Towncode)
-	assert.Equal(t, "望京街", resp.ReGeocode.AddressComponent.StreetNumber.Street)
-	assert.Equal(t, "8号", resp.ReGeocode.AddressComponent.StreetNumber.Number)
-	assert.Equal(t, "望京街道", resp.ReGeocode.AddressComponent.Township)
+	assert.EqualValues(t, "110000", resp.ReGeocode.AddressComponent.Citycode)
+	assert.EqualValues(t, "朝阳区", resp.ReGeocode.AddressComponent.District)
+	assert.EqualValues(t, "110105", resp.ReGeocode.AddressComponent.Adcode)
+	assert.EqualValues(t, "望京街道", resp.ReGeocode.AddressComponent.Township)
+	assert.EqualValues(t, "110105028", resp.ReGeocode.AddressComponent.Towncode)
+	assert.EqualValues(t, "望京街", resp.ReGeocode.AddressComponent.StreetNumber.Street)
+	assert.EqualValues(t, "8号", resp.ReGeocode.AddressComponent.StreetNumber.Number)
+	assert.EqualValues(t, "望京街道", resp.ReGeocode.AddressComponent.Township)
 }
 
 // TestReGeocode_MissingLocation 测试ReGeocode方法缺少必填参数Location
@@ -839,16 +869,16 @@ func TestReGeocode_MissingLocation(t *testing.T) {
 	assert.Contains(t, err.Error(), "逆地理编码：location参数不能为空")
 }
 
-// TestReGeocode_InvalidLocationFormat 测试ReGeocode方法Location格式错误
-func TestReGeocode_InvalidLocationFormat(t *testing.T) {
+// TestReGeocode_InvalidLocation 测试ReGeocode方法Location超出经纬度范围
+func TestReGeocode_InvalidLocation(t *testing.T) {
 	// 1. 创建Client实例
 	config := NewConfig("test_key")
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
-	// 2. 创建格式错误的Location参数
+	// 2. 创建超出范围的Location参数
 	req := &reGeoCode.ReGeocodeRequest{
-		Location: "116.48649 39.99947", // 使用空格分隔而不是逗号
+		Location: amapType.LngLat{Lng: 116.48649, Lat: 99.99947}, // 纬度超出 -90~90
 	}
 
 	// 3. 执行逆地理编码请求
@@ -858,7 +888,7 @@ func TestReGeocode_InvalidLocationFormat(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 	assert.IsType(t, amapErr.InvalidConfigError(""), err)
-	assert.Contains(t, err.Error(), "逆地理编码：location格式错误，应为\"经度,纬度\"")
+	assert.Contains(t, err.Error(), "逆地理编码：location坐标错误")
 }
 
 // TestReGeocode_APIError 测试ReGeocode方法API返回错误
@@ -880,7 +910,7 @@ func TestReGeocode_APIError(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &reGeoCode.ReGeocodeRequest{
-		Location: "116.48649,39.99947",
+		Location: amapType.LngLat{Lng: 116.48649, Lat: 39.99947},
 	}
 
 	// 4. 执行逆地理编码请求
@@ -889,8 +919,8 @@ func TestReGeocode_APIError(t *testing.T) {
 	// 5. 验证结果
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10001", apiErr.Code)
 	assert.Equal(t, "无效的Key", apiErr.Info)
 }
@@ -935,7 +965,7 @@ func TestReGeocode_DefaultValues(t *testing.T) {
 
 	// 3. 创建请求参数，不设置Radius和Extensions
 	req := &reGeoCode.ReGeocodeRequest{
-		Location: "116.48649,39.99947",
+		Location: amapType.LngLat{Lng: 116.48649, Lat: 39.99947},
 		// 不设置Radius和Extensions，测试默认值
 	}
 
@@ -979,8 +1009,8 @@ func TestDistance_Success(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &distance.DistanceRequest{
-		Origins:     "116.351147,39.936871",
-		Destination: "116.410001,39.910113",
+		Origins:     amapType.LngLatList{{Lng: 116.351147, Lat: 39.936871}},
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 		Type:        1,
 	}
 
@@ -994,10 +1024,10 @@ func TestDistance_Success(t *testing.T) {
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
 	assert.Len(t, resp.Results, 1)
-	assert.Equal(t, "3237", resp.Results[0].Distance)
-	assert.Equal(t, "324", resp.Results[0].Duration)
-	assert.Equal(t, "OK", resp.Results[0].Info)
-	assert.Equal(t, "1", resp.Results[0].Status)
+	assert.EqualValues(t, "3237", resp.Results[0].Distance)
+	assert.EqualValues(t, "324", resp.Results[0].Duration)
+	assert.EqualValues(t, "OK", resp.Results[0].Info)
+	assert.EqualValues(t, "1", resp.Results[0].Status)
 }
 
 // TestDistance_MissingOrigins 测试Distance方法缺少必填参数Origins
@@ -1009,7 +1039,7 @@ func TestDistance_MissingOrigins(t *testing.T) {
 
 	// 2. 创建缺少Origins的请求参数
 	req := &distance.DistanceRequest{
-		Destination: "116.410001,39.910113",
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 		Type:        1,
 	}
 
@@ -1032,7 +1062,7 @@ func TestDistance_MissingDestination(t *testing.T) {
 
 	// 2. 创建缺少Destination的请求参数
 	req := &distance.DistanceRequest{
-		Origins: "116.351147,39.936871",
+		Origins: amapType.LngLatList{{Lng: 116.351147, Lat: 39.936871}},
 		Type:    1,
 	}
 
@@ -1046,17 +1076,17 @@ func TestDistance_MissingDestination(t *testing.T) {
 	assert.Contains(t, err.Error(), "destination参数不能为空")
 }
 
-// TestDistance_InvalidCoordinateFormat 测试Distance方法坐标格式错误
-func TestDistance_InvalidCoordinateFormat(t *testing.T) {
+// TestDistance_InvalidCoordinate 测试Distance方法坐标超出经纬度范围
+func TestDistance_InvalidCoordinate(t *testing.T) {
 	// 1. 创建Client实例
 	config := NewConfig("test_key")
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
-	// 2. 创建格式错误的坐标参数
+	// 2. 创建超出范围的坐标参数
 	req := &distance.DistanceRequest{
-		Origins:     "116.351147 39.936871", // 使用空格分隔而不是逗号
-		Destination: "116.410001,39.910113",
+		Origins:     amapType.LngLatList{{Lng: 216.351147, Lat: 39.936871}}, // 经度超出 -180~180
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 		Type:        1,
 	}
 
@@ -1067,7 +1097,7 @@ func TestDistance_InvalidCoordinateFormat(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 	assert.IsType(t, amapErr.InvalidConfigError(""), err)
-	assert.Contains(t, err.Error(), "坐标格式错误")
+	assert.Contains(t, err.Error(), "距离测量：坐标错误")
 }
 
 // TestDistance_APIError 测试Distance方法API返回错误
@@ -1089,8 +1119,8 @@ func TestDistance_APIError(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &distance.DistanceRequest{
-		Origins:     "116.351147,39.936871",
-		Destination: "116.410001,39.910113",
+		Origins:     amapType.LngLatList{{Lng: 116.351147, Lat: 39.936871}},
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 		Type:        1,
 	}
 
@@ -1100,8 +1130,8 @@ func TestDistance_APIError(t *testing.T) {
 	// 5. 验证结果
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10001", apiErr.Code)
 	assert.Equal(t, "无效的Key", apiErr.Info)
 }
@@ -1143,8 +1173,8 @@ func TestDistance_MultiOriginsDestinations(t *testing.T) {
 
 	// 3. 创建请求参数，使用多起点
 	req := &distance.DistanceRequest{
-		Origins:     "116.351147,39.936871|116.481247,39.996746",
-		Destination: "116.410001,39.910113",
+		Origins:     amapType.LngLatList{{Lng: 116.351147, Lat: 39.936871}, {Lng: 116.481247, Lat: 39.996746}},
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 		Type:        2,
 	}
 
@@ -1155,8 +1185,8 @@ func TestDistance_MultiOriginsDestinations(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
 	assert.Len(t, resp.Results, 2)
-	assert.Equal(t, "3237", resp.Results[0].Distance)
-	assert.Equal(t, "8502", resp.Results[1].Distance)
+	assert.EqualValues(t, "3237", resp.Results[0].Distance)
+	assert.EqualValues(t, "8502", resp.Results[1].Distance)
 }
 
 // -------------------------- 步行路径规划测试 --------------------------
@@ -1207,8 +1237,8 @@ func TestWalking_Success(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &walking.WalkingRequest{
-		Origin:      "116.351147,39.936871",
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 4. 执行步行路径规划请求
@@ -1220,16 +1250,16 @@ func TestWalking_Success(t *testing.T) {
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
-	assert.Equal(t, "116.351147,39.936871", resp.Route.Origin)
-	assert.Equal(t, "116.410001,39.910113", resp.Route.Destination)
-	assert.Equal(t, "3237", resp.Route.Distance)
-	assert.Equal(t, "324", resp.Route.Duration)
-	assert.Equal(t, "0", resp.Route.Tolls)
+	assert.EqualValues(t, "116.351147,39.936871", resp.Route.Origin)
+	assert.EqualValues(t, "116.410001,39.910113", resp.Route.Destination)
+	assert.EqualValues(t, "3237", resp.Route.Distance)
+	assert.EqualValues(t, "324", resp.Route.Duration)
+	assert.EqualValues(t, "0", resp.Route.Tolls)
 	assert.Len(t, resp.Route.Paths, 1)
-	assert.Equal(t, "3237", resp.Route.Paths[0].Distance)
-	assert.Equal(t, "324", resp.Route.Paths[0].Duration)
+	assert.EqualValues(t, "3237", resp.Route.Paths[0].Distance)
+	assert.EqualValues(t, "324", resp.Route.Paths[0].Duration)
 	assert.Len(t, resp.Route.Paths[0].Steps, 1)
-	assert.Equal(t, "步行100米，右转进入建国路", resp.Route.Paths[0].Steps[0].Instruction)
+	assert.EqualValues(t, "步行100米，右转进入建国路", resp.Route.Paths[0].Steps[0].Instruction)
 }
 
 // TestWalking_MissingOrigin 测试Walking方法缺少必填参数Origin
@@ -1241,7 +1271,7 @@ func TestWalking_MissingOrigin(t *testing.T) {
 
 	// 2. 创建缺少Origin的请求参数
 	req := &walking.WalkingRequest{
-		Destination: "116.410001,39.910113",
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 3. 执行步行路径规划请求
@@ -1263,7 +1293,7 @@ func TestWalking_MissingDestination(t *testing.T) {
 
 	// 2. 创建缺少Destination的请求参数
 	req := &walking.WalkingRequest{
-		Origin: "116.351147,39.936871",
+		Origin: amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
 	}
 
 	// 3. 执行步行路径规划请求
@@ -1276,17 +1306,17 @@ func TestWalking_MissingDestination(t *testing.T) {
 	assert.Contains(t, err.Error(), "destination参数不能为空")
 }
 
-// TestWalking_InvalidCoordinateFormat 测试Walking方法坐标格式错误
-func TestWalking_InvalidCoordinateFormat(t *testing.T) {
+// TestWalking_InvalidCoordinate 测试Walking方法坐标超出经纬度范围
+func TestWalking_InvalidCoordinate(t *testing.T) {
 	// 1. 创建Client实例
 	config := NewConfig("test_key")
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
-	// 2. 创建格式错误的坐标参数
+	// 2. 创建超出范围的坐标参数
 	req := &walking.WalkingRequest{
-		Origin:      "116.351147 39.936871", // 使用空格分隔而不是逗号
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 216.351147, Lat: 39.936871}, // 经度超出 -180~180
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 3. 执行步行路径规划请求
@@ -1296,7 +1326,7 @@ func TestWalking_InvalidCoordinateFormat(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 	assert.IsType(t, amapErr.InvalidConfigError(""), err)
-	assert.Contains(t, err.Error(), "坐标格式错误")
+	assert.Contains(t, err.Error(), "坐标错误")
 }
 
 // TestLineTrafficStatus_Success 测试线路交通态势查询成功
@@ -1331,31 +1361,32 @@ func TestLineTrafficStatus_Success(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
 	// 3. 执行请求
 	req := &line.LineTrafficRequest{
-		Path: "116.481028,39.989643;116.489028,39.999643",
+		Path: amapType.LngLatList{{Lng: 116.481028, Lat: 39.989643}, {Lng: 116.489028, Lat: 39.999643}},
 	}
 	resp, err := client.LineTrafficStatus(req)
 
 	// 4. 验证结果
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, "1", resp.Status)
-	assert.Equal(t, "OK", resp.Info)
-	assert.Equal(t, "10000", resp.Infocode)
+	assert.EqualValues(t, "1", resp.Status)
+	assert.EqualValues(t, "OK", resp.Info)
+	assert.EqualValues(t, "10000", resp.Infocode)
 	assert.NotNil(t, resp.Trafficinfo)
-	assert.Equal(t, "整体路况良好", resp.Trafficinfo.Description)
-	assert.Equal(t, 1, resp.Trafficinfo.Evaluation.Expedite)
-	assert.Equal(t, 0, resp.Trafficinfo.Evaluation.Congested)
-	assert.Equal(t, "expedite", resp.Trafficinfo.Evaluation.Status)
+	assert.EqualValues(t, "整体路况良好", resp.Trafficinfo.Description)
+	assert.EqualValues(t, 1, resp.Trafficinfo.Evaluation.Expedite)
+	assert.EqualValues(t, 0, resp.Trafficinfo.Evaluation.Congested)
+	assert.EqualValues(t, "expedite", resp.Trafficinfo.Evaluation.Status)
 	assert.Len(t, resp.Trafficinfo.Roads, 1)
-	assert.Equal(t, "测试道路", resp.Trafficinfo.Roads[0].Name)
-	assert.Equal(t, 1, resp.Trafficinfo.Roads[0].Status)
-	assert.Equal(t, 60.0, resp.Trafficinfo.Roads[0].Speed)
+	assert.EqualValues(t, "测试道路", resp.Trafficinfo.Roads[0].Name)
+	assert.EqualValues(t, 1, resp.Trafficinfo.Roads[0].Status)
+	assert.EqualValues(t, 60.0, resp.Trafficinfo.Roads[0].Speed)
 }
 
 // TestCircleTrafficStatus_Success 测试圆形区域交通态势查询成功
@@ -1398,13 +1429,14 @@ func TestCircleTrafficStatus_Success(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
 	// 3. 执行请求
 	req := &circle.CircleTrafficRequest{
-		Center: "116.481028,39.989643",
+		Center: amapType.LngLat{Lng: 116.481028, Lat: 39.989643},
 		Radius: "1000",
 	}
 	resp, err := client.CircleTrafficStatus(req)
@@ -1412,19 +1444,19 @@ func TestCircleTrafficStatus_Success(t *testing.T) {
 	// 4. 验证结果
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, "1", resp.Status)
-	assert.Equal(t, "OK", resp.Info)
-	assert.Equal(t, "10000", resp.Infocode)
+	assert.EqualValues(t, "1", resp.Status)
+	assert.EqualValues(t, "OK", resp.Info)
+	assert.EqualValues(t, "10000", resp.Infocode)
 	assert.NotNil(t, resp.Trafficinfo)
-	assert.Equal(t, "整体路况良好", resp.Trafficinfo.Description)
-	assert.Equal(t, 2, resp.Trafficinfo.Evaluation.Expedite)
-	assert.Equal(t, "expedite", resp.Trafficinfo.Evaluation.Status)
+	assert.EqualValues(t, "整体路况良好", resp.Trafficinfo.Description)
+	assert.EqualValues(t, 2, resp.Trafficinfo.Evaluation.Expedite)
+	assert.EqualValues(t, "expedite", resp.Trafficinfo.Evaluation.Status)
 	assert.Len(t, resp.Trafficinfo.Roads, 2)
-	assert.Equal(t, "测试道路1", resp.Trafficinfo.Roads[0].Name)
-	assert.Equal(t, 1, resp.Trafficinfo.Roads[0].Status)
-	assert.Equal(t, 60.0, resp.Trafficinfo.Roads[0].Speed)
-	assert.Equal(t, "测试道路2", resp.Trafficinfo.Roads[1].Name)
-	assert.Equal(t, 55.0, resp.Trafficinfo.Roads[1].Speed)
+	assert.EqualValues(t, "测试道路1", resp.Trafficinfo.Roads[0].Name)
+	assert.EqualValues(t, 1, resp.Trafficinfo.Roads[0].Status)
+	assert.EqualValues(t, 60.0, resp.Trafficinfo.Roads[0].Speed)
+	assert.EqualValues(t, "测试道路2", resp.Trafficinfo.Roads[1].Name)
+	assert.EqualValues(t, 55.0, resp.Trafficinfo.Roads[1].Speed)
 }
 
 // TestRectangleTrafficStatus_Success 测试矩形区域交通态势查询成功
@@ -1475,34 +1507,35 @@ func TestRectangleTrafficStatus_Success(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
 	// 3. 执行请求
 	req := &rectangle.RectangleTrafficRequest{
-		Rectangle: "116.481028,39.989643;116.489028,39.999643",
+		Rectangle: amapType.Bounds{SouthWest: amapType.LngLat{Lng: 116.481028, Lat: 39.989643}, NorthEast: amapType.LngLat{Lng: 116.489028, Lat: 39.999643}},
 	}
 	resp, err := client.RectangleTrafficStatus(req)
 
 	// 4. 验证结果
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, "1", resp.Status)
-	assert.Equal(t, "OK", resp.Info)
-	assert.Equal(t, "10000", resp.Infocode)
+	assert.EqualValues(t, "1", resp.Status)
+	assert.EqualValues(t, "OK", resp.Info)
+	assert.EqualValues(t, "10000", resp.Infocode)
 	assert.NotNil(t, resp.Trafficinfo)
-	assert.Equal(t, "整体路况良好", resp.Trafficinfo.Description)
-	assert.Equal(t, 3, resp.Trafficinfo.Evaluation.Expedite)
-	assert.Equal(t, "expedite", resp.Trafficinfo.Evaluation.Status)
+	assert.EqualValues(t, "整体路况良好", resp.Trafficinfo.Description)
+	assert.EqualValues(t, 3, resp.Trafficinfo.Evaluation.Expedite)
+	assert.EqualValues(t, "expedite", resp.Trafficinfo.Evaluation.Status)
 	assert.Len(t, resp.Trafficinfo.Roads, 3)
-	assert.Equal(t, "测试道路1", resp.Trafficinfo.Roads[0].Name)
-	assert.Equal(t, 1, resp.Trafficinfo.Roads[0].Status)
-	assert.Equal(t, 60.0, resp.Trafficinfo.Roads[0].Speed)
-	assert.Equal(t, "测试道路2", resp.Trafficinfo.Roads[1].Name)
-	assert.Equal(t, 55.0, resp.Trafficinfo.Roads[1].Speed)
-	assert.Equal(t, "测试道路3", resp.Trafficinfo.Roads[2].Name)
-	assert.Equal(t, 50.0, resp.Trafficinfo.Roads[2].Speed)
+	assert.EqualValues(t, "测试道路1", resp.Trafficinfo.Roads[0].Name)
+	assert.EqualValues(t, 1, resp.Trafficinfo.Roads[0].Status)
+	assert.EqualValues(t, 60.0, resp.Trafficinfo.Roads[0].Speed)
+	assert.EqualValues(t, "测试道路2", resp.Trafficinfo.Roads[1].Name)
+	assert.EqualValues(t, 55.0, resp.Trafficinfo.Roads[1].Speed)
+	assert.EqualValues(t, "测试道路3", resp.Trafficinfo.Roads[2].Name)
+	assert.EqualValues(t, 50.0, resp.Trafficinfo.Roads[2].Speed)
 }
 
 // TestWalking_APIError 测试Walking方法API返回错误
@@ -1524,8 +1557,8 @@ func TestWalking_APIError(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &walking.WalkingRequest{
-		Origin:      "116.351147,39.936871",
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 4. 执行步行路径规划请求
@@ -1534,8 +1567,8 @@ func TestWalking_APIError(t *testing.T) {
 	// 5. 验证结果
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10001", apiErr.Code)
 	assert.Equal(t, "无效的Key", apiErr.Info)
 }
@@ -1586,8 +1619,8 @@ func TestDriving_Success(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &driving.DrivingRequest{
-		Origin:      "116.351147,39.936871",
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 4. 执行驾车路径规划请求
@@ -1599,13 +1632,13 @@ func TestDriving_Success(t *testing.T) {
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
-	assert.Equal(t, "116.351147,39.936871", resp.Route.Origin)
-	assert.Equal(t, "116.410001,39.910113", resp.Route.Destination)
+	assert.EqualValues(t, "116.351147,39.936871", resp.Route.Origin)
+	assert.EqualValues(t, "116.410001,39.910113", resp.Route.Destination)
 	assert.Len(t, resp.Route.Paths, 1)
-	assert.Equal(t, "5237", resp.Route.Paths[0].Distance)
-	assert.Equal(t, "240", resp.Route.Paths[0].Duration)
+	assert.EqualValues(t, "5237", resp.Route.Paths[0].Distance)
+	assert.EqualValues(t, "240", resp.Route.Paths[0].Duration)
 	assert.Len(t, resp.Route.Paths[0].Steps, 1)
-	assert.Equal(t, "沿建国路向东行驶1.2公里", resp.Route.Paths[0].Steps[0].Instruction)
+	assert.EqualValues(t, "沿建国路向东行驶1.2公里", resp.Route.Paths[0].Steps[0].Instruction)
 }
 
 // TestDriving_MissingOrigin 测试Driving方法缺少必填参数Origin
@@ -1617,7 +1650,7 @@ func TestDriving_MissingOrigin(t *testing.T) {
 
 	// 2. 创建缺少Origin的请求参数
 	req := &driving.DrivingRequest{
-		Destination: "116.410001,39.910113",
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 3. 执行驾车路径规划请求
@@ -1639,7 +1672,7 @@ func TestDriving_MissingDestination(t *testing.T) {
 
 	// 2. 创建缺少Destination的请求参数
 	req := &driving.DrivingRequest{
-		Origin: "116.351147,39.936871",
+		Origin: amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
 	}
 
 	// 3. 执行驾车路径规划请求
@@ -1652,17 +1685,17 @@ func TestDriving_MissingDestination(t *testing.T) {
 	assert.Contains(t, err.Error(), "destination参数不能为空")
 }
 
-// TestDriving_InvalidCoordinateFormat 测试Driving方法坐标格式错误
-func TestDriving_InvalidCoordinateFormat(t *testing.T) {
+// TestDriving_InvalidCoordinate 测试Driving方法坐标超出经纬度范围
+func TestDriving_InvalidCoordinate(t *testing.T) {
 	// 1. 创建Client实例
 	config := NewConfig("test_key")
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
-	// 2. 创建格式错误的坐标参数
+	// 2. 创建超出范围的坐标参数
 	req := &driving.DrivingRequest{
-		Origin:      "116.351147 39.936871", // 使用空格分隔而不是逗号
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 216.351147, Lat: 39.936871}, // 经度超出 -180~180
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 3. 执行驾车路径规划请求
@@ -1672,7 +1705,7 @@ func TestDriving_InvalidCoordinateFormat(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 	assert.IsType(t, amapErr.InvalidConfigError(""), err)
-	assert.Contains(t, err.Error(), "坐标格式错误")
+	assert.Contains(t, err.Error(), "坐标错误")
 }
 
 // TestDriving_APIError 测试Driving方法API返回错误
@@ -1694,8 +1727,8 @@ func TestDriving_APIError(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &driving.DrivingRequest{
-		Origin:      "116.351147,39.936871",
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 4. 执行驾车路径规划请求
@@ -1704,8 +1737,8 @@ func TestDriving_APIError(t *testing.T) {
 	// 5. 验证结果
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10001", apiErr.Code)
 	assert.Equal(t, "无效的Key", apiErr.Info)
 }
@@ -1754,8 +1787,8 @@ func TestBicycling_Success(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &bicycling.BicyclingRequest{
-		Origin:      "116.351147,39.936871",
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 4. 执行骑行路径规划请求
@@ -1767,15 +1800,15 @@ func TestBicycling_Success(t *testing.T) {
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
-	assert.Equal(t, "116.351147,39.936871", resp.Route.Origin)
-	assert.Equal(t, "116.410001,39.910113", resp.Route.Destination)
-	assert.Equal(t, "4237", resp.Route.Distance)
-	assert.Equal(t, "280", resp.Route.Duration)
+	assert.EqualValues(t, "116.351147,39.936871", resp.Route.Origin)
+	assert.EqualValues(t, "116.410001,39.910113", resp.Route.Destination)
+	assert.EqualValues(t, "4237", resp.Route.Distance)
+	assert.EqualValues(t, "280", resp.Route.Duration)
 	assert.Len(t, resp.Route.Paths, 1)
-	assert.Equal(t, "4237", resp.Route.Paths[0].Distance)
-	assert.Equal(t, "280", resp.Route.Paths[0].Duration)
+	assert.EqualValues(t, "4237", resp.Route.Paths[0].Distance)
+	assert.EqualValues(t, "280", resp.Route.Paths[0].Duration)
 	assert.Len(t, resp.Route.Paths[0].Steps, 1)
-	assert.Equal(t, "沿骑行专用道向东行驶800米", resp.Route.Paths[0].Steps[0].Instruction)
+	assert.EqualValues(t, "沿骑行专用道向东行驶800米", resp.Route.Paths[0].Steps[0].Instruction)
 }
 
 // TestBicycling_MissingOrigin 测试Bicycling方法缺少必填参数Origin
@@ -1787,7 +1820,7 @@ func TestBicycling_MissingOrigin(t *testing.T) {
 
 	// 2. 创建缺少Origin的请求参数
 	req := &bicycling.BicyclingRequest{
-		Destination: "116.410001,39.910113",
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 3. 执行骑行路径规划请求
@@ -1809,7 +1842,7 @@ func TestBicycling_MissingDestination(t *testing.T) {
 
 	// 2. 创建缺少Destination的请求参数
 	req := &bicycling.BicyclingRequest{
-		Origin: "116.351147,39.936871",
+		Origin: amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
 	}
 
 	// 3. 执行骑行路径规划请求
@@ -1822,17 +1855,17 @@ func TestBicycling_MissingDestination(t *testing.T) {
 	assert.Contains(t, err.Error(), "destination参数不能为空")
 }
 
-// TestBicycling_InvalidCoordinateFormat 测试Bicycling方法坐标格式错误
-func TestBicycling_InvalidCoordinateFormat(t *testing.T) {
+// TestBicycling_InvalidCoordinate 测试Bicycling方法坐标超出经纬度范围
+func TestBicycling_InvalidCoordinate(t *testing.T) {
 	// 1. 创建Client实例
 	config := NewConfig("test_key")
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
-	// 2. 创建格式错误的坐标参数
+	// 2. 创建超出范围的坐标参数
 	req := &bicycling.BicyclingRequest{
-		Origin:      "116.351147 39.936871", // 使用空格分隔而不是逗号
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 216.351147, Lat: 39.936871}, // 经度超出 -180~180
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 3. 执行骑行路径规划请求
@@ -1842,7 +1875,7 @@ func TestBicycling_InvalidCoordinateFormat(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 	assert.IsType(t, amapErr.InvalidConfigError(""), err)
-	assert.Contains(t, err.Error(), "坐标格式错误")
+	assert.Contains(t, err.Error(), "坐标错误")
 }
 
 // TestBicycling_APIError 测试Bicycling方法API返回错误
@@ -1864,8 +1897,8 @@ func TestBicycling_APIError(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &bicycling.BicyclingRequest{
-		Origin:      "116.351147,39.936871",
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 4. 执行骑行路径规划请求
@@ -1874,8 +1907,8 @@ func TestBicycling_APIError(t *testing.T) {
 	// 5. 验证结果
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10001", apiErr.Code)
 	assert.Equal(t, "无效的Key", apiErr.Info)
 }
@@ -1929,8 +1962,8 @@ func TestWalkingV2_Success(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &walkingV2.WalkingRequestV2{
-		Origin:      "116.351147,39.936871",
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 		Strategy:    "0",
 	}
 
@@ -1943,16 +1976,16 @@ func TestWalkingV2_Success(t *testing.T) {
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
-	assert.Equal(t, "116.351147,39.936871", resp.Route.Origin)
-	assert.Equal(t, "116.410001,39.910113", resp.Route.Destination)
-	assert.Equal(t, "3237", resp.Route.Distance)
-	assert.Equal(t, "324", resp.Route.Duration)
+	assert.EqualValues(t, "116.351147,39.936871", resp.Route.Origin)
+	assert.EqualValues(t, "116.410001,39.910113", resp.Route.Destination)
+	assert.EqualValues(t, "3237", resp.Route.Distance)
+	assert.EqualValues(t, "324", resp.Route.Duration)
 	assert.Len(t, resp.Route.Paths, 1)
-	assert.Equal(t, "3237", resp.Route.Paths[0].Distance)
-	assert.Equal(t, "324", resp.Route.Paths[0].Duration)
+	assert.EqualValues(t, "3237", resp.Route.Paths[0].Distance)
+	assert.EqualValues(t, "324", resp.Route.Paths[0].Duration)
 	assert.Len(t, resp.Route.Paths[0].Steps, 1)
-	assert.Equal(t, "步行100米，右转进入建国路", resp.Route.Paths[0].Steps[0].Instruction)
-	assert.Equal(t, "1", resp.Route.Paths[0].Steps[0].WalkType)
+	assert.EqualValues(t, "步行100米，右转进入建国路", resp.Route.Paths[0].Steps[0].Instruction)
+	assert.EqualValues(t, "1", resp.Route.Paths[0].Steps[0].WalkType)
 }
 
 // TestWalkingV2_MissingOrigin 测试WalkingV2方法缺少必填参数Origin
@@ -1964,7 +1997,7 @@ func TestWalkingV2_MissingOrigin(t *testing.T) {
 
 	// 2. 创建缺少Origin的请求参数
 	req := &walkingV2.WalkingRequestV2{
-		Destination: "116.410001,39.910113",
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 3. 执行步行路径规划v2请求
@@ -1986,7 +2019,7 @@ func TestWalkingV2_MissingDestination(t *testing.T) {
 
 	// 2. 创建缺少Destination的请求参数
 	req := &walkingV2.WalkingRequestV2{
-		Origin: "116.351147,39.936871",
+		Origin: amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
 	}
 
 	// 3. 执行步行路径规划v2请求
@@ -1999,17 +2032,17 @@ func TestWalkingV2_MissingDestination(t *testing.T) {
 	assert.Contains(t, err.Error(), "destination参数不能为空")
 }
 
-// TestWalkingV2_InvalidCoordinateFormat 测试WalkingV2方法坐标格式错误
-func TestWalkingV2_InvalidCoordinateFormat(t *testing.T) {
+// TestWalkingV2_InvalidCoordinate 测试WalkingV2方法坐标超出经纬度范围
+func TestWalkingV2_InvalidCoordinate(t *testing.T) {
 	// 1. 创建Client实例
 	config := NewConfig("test_key")
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
-	// 2. 创建格式错误的坐标参数
+	// 2. 创建超出范围的坐标参数
 	req := &walkingV2.WalkingRequestV2{
-		Origin:      "116.351147 39.936871", // 使用空格分隔而不是逗号
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 216.351147, Lat: 39.936871}, // 经度超出 -180~180
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 3. 执行步行路径规划v2请求
@@ -2019,7 +2052,7 @@ func TestWalkingV2_InvalidCoordinateFormat(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 	assert.IsType(t, amapErr.InvalidConfigError(""), err)
-	assert.Contains(t, err.Error(), "坐标格式错误")
+	assert.Contains(t, err.Error(), "坐标错误")
 }
 
 // TestWalkingV2_APIError 测试WalkingV2方法API返回错误
@@ -2041,8 +2074,8 @@ func TestWalkingV2_APIError(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &walkingV2.WalkingRequestV2{
-		Origin:      "116.351147,39.936871",
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 4. 执行步行路径规划v2请求
@@ -2051,8 +2084,8 @@ func TestWalkingV2_APIError(t *testing.T) {
 	// 5. 验证结果
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10001", apiErr.Code)
 	assert.Equal(t, "无效的Key", apiErr.Info)
 }
@@ -2111,15 +2144,15 @@ func TestDistrict_Success(t *testing.T) {
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
-	assert.Equal(t, "1", resp.Count)
+	assert.EqualValues(t, "1", resp.Count)
 	assert.Len(t, resp.Districts, 1)
-	assert.Equal(t, "北京市", resp.Districts[0].Name)
-	assert.Equal(t, "province", resp.Districts[0].Level)
-	assert.Equal(t, "110000", resp.Districts[0].Adcode)
-	assert.Equal(t, "010", resp.Districts[0].Citycode)
-	assert.Equal(t, "116.407413,39.904211", resp.Districts[0].Center)
+	assert.EqualValues(t, "北京市", resp.Districts[0].Name)
+	assert.EqualValues(t, "province", resp.Districts[0].Level)
+	assert.EqualValues(t, "110000", resp.Districts[0].Adcode)
+	assert.EqualValues(t, "010", resp.Districts[0].Citycode)
+	assert.EqualValues(t, "116.407413,39.904211", resp.Districts[0].Center)
 	assert.Len(t, resp.Districts[0].Districts, 1)
-	assert.Equal(t, "东城区", resp.Districts[0].Districts[0].Name)
+	assert.EqualValues(t, "东城区", resp.Districts[0].Districts[0].Name)
 }
 
 // TestDistrict_MissingKeywords 测试District方法缺少必填参数Keywords
@@ -2156,6 +2189,7 @@ func TestDistrict_APIError(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -2171,8 +2205,8 @@ func TestDistrict_APIError(t *testing.T) {
 	// 5. 验证结果
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10001", apiErr.Code)
 	assert.Equal(t, "无效的Key", apiErr.Info)
 }
@@ -2191,6 +2225,7 @@ func TestDistrict_InvalidKeywords(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -2209,7 +2244,7 @@ func TestDistrict_InvalidKeywords(t *testing.T) {
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
-	assert.Equal(t, "0", resp.Count)
+	assert.EqualValues(t, "0", resp.Count)
 	assert.Len(t, resp.Districts, 0)
 }
 
@@ -2254,6 +2289,7 @@ func TestInputtips_Success(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -2273,12 +2309,12 @@ func TestInputtips_Success(t *testing.T) {
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
-	assert.Equal(t, "2", resp.Count)
+	assert.EqualValues(t, "2", resp.Count)
 	assert.Len(t, resp.Tips, 2)
-	assert.Equal(t, "望京SOHO", resp.Tips[0].Name)
-	assert.Equal(t, "北京市", resp.Tips[0].City)
-	assert.Equal(t, "朝阳区", resp.Tips[0].District)
-	assert.Equal(t, "116.48649,39.99947", resp.Tips[0].Location)
+	assert.EqualValues(t, "望京SOHO", resp.Tips[0].Name)
+	assert.EqualValues(t, "北京市", resp.Tips[0].City)
+	assert.EqualValues(t, "朝阳区", resp.Tips[0].District)
+	assert.EqualValues(t, "116.48649,39.99947", resp.Tips[0].Location)
 }
 
 // TestInputtips_MissingKeywords 测试Inputtips方法缺少必填参数Keywords
@@ -2315,6 +2351,7 @@ func TestInputtips_APIError(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -2330,8 +2367,8 @@ func TestInputtips_APIError(t *testing.T) {
 	// 5. 验证结果
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10001", apiErr.Code)
 	assert.Equal(t, "无效的Key", apiErr.Info)
 }
@@ -2350,6 +2387,7 @@ func TestInputtips_NoResult(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -2369,7 +2407,7 @@ func TestInputtips_NoResult(t *testing.T) {
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
-	assert.Equal(t, "0", resp.Count)
+	assert.EqualValues(t, "0", resp.Count)
 	assert.Len(t, resp.Tips, 0)
 }
 
@@ -2406,6 +2444,7 @@ func TestWeatherinfo_Success_Base(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -2426,13 +2465,13 @@ func TestWeatherinfo_Success_Base(t *testing.T) {
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
 	assert.NotNil(t, resp.Weatherinfo)
-	assert.Equal(t, "北京市", resp.Weatherinfo.City)
-	assert.Equal(t, "101010100", resp.Weatherinfo.CityID)
-	assert.Equal(t, "22", resp.Weatherinfo.Temp)
-	assert.Equal(t, "晴", resp.Weatherinfo.Weather)
-	assert.Equal(t, "东南风", resp.Weatherinfo.WD)
-	assert.Equal(t, "1级", resp.Weatherinfo.WS)
-	assert.Equal(t, "40%", resp.Weatherinfo.SD)
+	assert.EqualValues(t, "北京市", resp.Weatherinfo.City)
+	assert.EqualValues(t, "101010100", resp.Weatherinfo.CityID)
+	assert.EqualValues(t, "22", resp.Weatherinfo.Temp)
+	assert.EqualValues(t, "晴", resp.Weatherinfo.Weather)
+	assert.EqualValues(t, "东南风", resp.Weatherinfo.WD)
+	assert.EqualValues(t, "1级", resp.Weatherinfo.WS)
+	assert.EqualValues(t, "40%", resp.Weatherinfo.SD)
 }
 
 // TestWeatherinfo_Success_All 测试Weatherinfo方法正常请求成功（all扩展，包含预报和生活指数）
@@ -2485,6 +2524,7 @@ func TestWeatherinfo_Success_All(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -2507,7 +2547,7 @@ func TestWeatherinfo_Success_All(t *testing.T) {
 	assert.NotNil(t, resp.Weatherinfo)
 	assert.NotEmpty(t, resp.Forecasts)
 	assert.NotNil(t, resp.Suggestion)
-	assert.Equal(t, "舒适", resp.Suggestion.Comf.Brf)
+	assert.EqualValues(t, "舒适", resp.Suggestion.Comf.Brf)
 }
 
 // TestWeatherinfo_MissingCity 测试Weatherinfo方法缺少必填参数City
@@ -2544,6 +2584,7 @@ func TestWeatherinfo_APIError(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -2559,8 +2600,8 @@ func TestWeatherinfo_APIError(t *testing.T) {
 	// 5. 验证结果
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10001", apiErr.Code)
 	assert.Equal(t, "无效的Key", apiErr.Info)
 }
@@ -2577,6 +2618,7 @@ func TestWeatherinfo_InvalidCity(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -2592,8 +2634,8 @@ func TestWeatherinfo_InvalidCity(t *testing.T) {
 	// 5. 验证结果
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10003", apiErr.Code)
 	assert.Equal(t, "无效的城市", apiErr.Info)
 }
@@ -2644,8 +2686,8 @@ func TestDrivingV2_Success(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &drivingV2.DrivingRequestV2{
-		Origin:      "116.351147,39.936871",
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 4. 执行驾车路径规划v2请求
@@ -2657,17 +2699,17 @@ func TestDrivingV2_Success(t *testing.T) {
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
-	assert.Equal(t, "116.351147,39.936871", resp.Route.Origin)
-	assert.Equal(t, "116.410001,39.910113", resp.Route.Destination)
-	assert.Equal(t, "5237", resp.Route.Distance)
-	assert.Equal(t, "240", resp.Route.Duration)
-	assert.Equal(t, "5", resp.Route.Tolls)
+	assert.EqualValues(t, "116.351147,39.936871", resp.Route.Origin)
+	assert.EqualValues(t, "116.410001,39.910113", resp.Route.Destination)
+	assert.EqualValues(t, "5237", resp.Route.Distance)
+	assert.EqualValues(t, "240", resp.Route.Duration)
+	assert.EqualValues(t, "5", resp.Route.Tolls)
 	assert.Len(t, resp.Route.Paths, 1)
-	assert.Equal(t, "5237", resp.Route.Paths[0].Distance)
-	assert.Equal(t, "240", resp.Route.Paths[0].Duration)
-	assert.Equal(t, "5", resp.Route.Paths[0].Tolls)
+	assert.EqualValues(t, "5237", resp.Route.Paths[0].Distance)
+	assert.EqualValues(t, "240", resp.Route.Paths[0].Duration)
+	assert.EqualValues(t, "5", resp.Route.Paths[0].Tolls)
 	assert.Len(t, resp.Route.Paths[0].Steps, 1)
-	assert.Equal(t, "沿建国路向东行驶1.2公里", resp.Route.Paths[0].Steps[0].Instruction)
+	assert.EqualValues(t, "沿建国路向东行驶1.2公里", resp.Route.Paths[0].Steps[0].Instruction)
 }
 
 // TestDrivingV2_MissingOrigin 测试DrivingV2方法缺少必填参数Origin
@@ -2679,7 +2721,7 @@ func TestDrivingV2_MissingOrigin(t *testing.T) {
 
 	// 2. 创建缺少Origin的请求参数
 	req := &drivingV2.DrivingRequestV2{
-		Destination: "116.410001,39.910113",
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 3. 执行驾车路径规划v2请求
@@ -2701,7 +2743,7 @@ func TestDrivingV2_MissingDestination(t *testing.T) {
 
 	// 2. 创建缺少Destination的请求参数
 	req := &drivingV2.DrivingRequestV2{
-		Origin: "116.351147,39.936871",
+		Origin: amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
 	}
 
 	// 3. 执行驾车路径规划v2请求
@@ -2714,17 +2756,17 @@ func TestDrivingV2_MissingDestination(t *testing.T) {
 	assert.Contains(t, err.Error(), "destination参数不能为空")
 }
 
-// TestDrivingV2_InvalidCoordinateFormat 测试DrivingV2方法坐标格式错误
-func TestDrivingV2_InvalidCoordinateFormat(t *testing.T) {
+// TestDrivingV2_InvalidCoordinate 测试DrivingV2方法坐标超出经纬度范围
+func TestDrivingV2_InvalidCoordinate(t *testing.T) {
 	// 1. 创建Client实例
 	config := NewConfig("test_key")
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
-	// 2. 创建格式错误的坐标参数
+	// 2. 创建超出范围的坐标参数
 	req := &drivingV2.DrivingRequestV2{
-		Origin:      "116.351147 39.936871", // 使用空格分隔而不是逗号
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 216.351147, Lat: 39.936871}, // 经度超出 -180~180
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 3. 执行驾车路径规划v2请求
@@ -2734,7 +2776,7 @@ func TestDrivingV2_InvalidCoordinateFormat(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 	assert.IsType(t, amapErr.InvalidConfigError(""), err)
-	assert.Contains(t, err.Error(), "坐标格式错误")
+	assert.Contains(t, err.Error(), "坐标错误")
 }
 
 // TestDrivingV2_APIError 测试DrivingV2方法API返回错误
@@ -2756,8 +2798,8 @@ func TestDrivingV2_APIError(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &drivingV2.DrivingRequestV2{
-		Origin:      "116.351147,39.936871",
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 4. 执行驾车路径规划v2请求
@@ -2766,8 +2808,8 @@ func TestDrivingV2_APIError(t *testing.T) {
 	// 5. 验证结果
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10001", apiErr.Code)
 	assert.Equal(t, "无效的Key", apiErr.Info)
 }
@@ -2820,8 +2862,8 @@ func TestETDDrivingV4_Success(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &etdDrivingV4.ETDDrivingRequestV4{
-		Origin:        "116.351147,39.936871",
-		Destination:   "116.410001,39.910113",
+		Origin:        amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
+		Destination:   amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 		DepartureTime: "2025-01-01 08:00",
 	}
 
@@ -2834,13 +2876,13 @@ func TestETDDrivingV4_Success(t *testing.T) {
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
-	assert.Equal(t, "116.351147,39.936871", resp.Route.Origin)
-	assert.Equal(t, "116.410001,39.910113", resp.Route.Destination)
-	assert.Equal(t, "5237", resp.Route.Distance)
-	assert.Equal(t, "240", resp.Route.Duration)
-	assert.Equal(t, "5", resp.Route.Tolls)
-	assert.Equal(t, "2025-01-01 08:00", resp.Route.ETD)
-	assert.Equal(t, "2025-01-01 08:30", resp.Route.ETA)
+	assert.EqualValues(t, "116.351147,39.936871", resp.Route.Origin)
+	assert.EqualValues(t, "116.410001,39.910113", resp.Route.Destination)
+	assert.EqualValues(t, "5237", resp.Route.Distance)
+	assert.EqualValues(t, "240", resp.Route.Duration)
+	assert.EqualValues(t, "5", resp.Route.Tolls)
+	assert.EqualValues(t, "2025-01-01 08:00", resp.Route.ETD)
+	assert.EqualValues(t, "2025-01-01 08:30", resp.Route.ETA)
 	assert.Len(t, resp.Route.Paths, 1)
 }
 
@@ -2853,7 +2895,7 @@ func TestETDDrivingV4_MissingOrigin(t *testing.T) {
 
 	// 2. 创建缺少Origin的请求参数
 	req := &etdDrivingV4.ETDDrivingRequestV4{
-		Destination:   "116.410001,39.910113",
+		Destination:   amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 		DepartureTime: "2025-01-01 08:00",
 	}
 
@@ -2876,7 +2918,7 @@ func TestETDDrivingV4_MissingDestination(t *testing.T) {
 
 	// 2. 创建缺少Destination的请求参数
 	req := &etdDrivingV4.ETDDrivingRequestV4{
-		Origin:        "116.351147,39.936871",
+		Origin:        amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
 		DepartureTime: "2025-01-01 08:00",
 	}
 
@@ -2899,8 +2941,8 @@ func TestETDDrivingV4_MissingDepartureTime(t *testing.T) {
 
 	// 2. 创建缺少DepartureTime的请求参数
 	req := &etdDrivingV4.ETDDrivingRequestV4{
-		Origin:      "116.351147,39.936871",
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 3. 执行未来驾车路径规划v4请求
@@ -2957,8 +2999,8 @@ func TestBicyclingV2_Success(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &bicyclingV2.BicyclingRequestV2{
-		Origin:      "116.351147,39.936871",
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 4. 执行骑行路径规划v2请求
@@ -2970,15 +3012,15 @@ func TestBicyclingV2_Success(t *testing.T) {
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
-	assert.Equal(t, "116.351147,39.936871", resp.Route.Origin)
-	assert.Equal(t, "116.410001,39.910113", resp.Route.Destination)
-	assert.Equal(t, "4237", resp.Route.Distance)
-	assert.Equal(t, "280", resp.Route.Duration)
+	assert.EqualValues(t, "116.351147,39.936871", resp.Route.Origin)
+	assert.EqualValues(t, "116.410001,39.910113", resp.Route.Destination)
+	assert.EqualValues(t, "4237", resp.Route.Distance)
+	assert.EqualValues(t, "280", resp.Route.Duration)
 	assert.Len(t, resp.Route.Paths, 1)
-	assert.Equal(t, "4237", resp.Route.Paths[0].Distance)
-	assert.Equal(t, "280", resp.Route.Paths[0].Duration)
+	assert.EqualValues(t, "4237", resp.Route.Paths[0].Distance)
+	assert.EqualValues(t, "280", resp.Route.Paths[0].Duration)
 	assert.Len(t, resp.Route.Paths[0].Steps, 1)
-	assert.Equal(t, "沿骑行专用道向东行驶800米", resp.Route.Paths[0].Steps[0].Instruction)
+	assert.EqualValues(t, "沿骑行专用道向东行驶800米", resp.Route.Paths[0].Steps[0].Instruction)
 }
 
 // TestBicyclingV2_MissingOrigin 测试BicyclingV2方法缺少必填参数Origin
@@ -2990,7 +3032,7 @@ func TestBicyclingV2_MissingOrigin(t *testing.T) {
 
 	// 2. 创建缺少Origin的请求参数
 	req := &bicyclingV2.BicyclingRequestV2{
-		Destination: "116.410001,39.910113",
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 3. 执行骑行路径规划v2请求
@@ -3012,7 +3054,7 @@ func TestBicyclingV2_MissingDestination(t *testing.T) {
 
 	// 2. 创建缺少Destination的请求参数
 	req := &bicyclingV2.BicyclingRequestV2{
-		Origin: "116.351147,39.936871",
+		Origin: amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
 	}
 
 	// 3. 执行骑行路径规划v2请求
@@ -3025,17 +3067,17 @@ func TestBicyclingV2_MissingDestination(t *testing.T) {
 	assert.Contains(t, err.Error(), "destination参数不能为空")
 }
 
-// TestBicyclingV2_InvalidCoordinateFormat 测试BicyclingV2方法坐标格式错误
-func TestBicyclingV2_InvalidCoordinateFormat(t *testing.T) {
+// TestBicyclingV2_InvalidCoordinate 测试BicyclingV2方法坐标超出经纬度范围
+func TestBicyclingV2_InvalidCoordinate(t *testing.T) {
 	// 1. 创建Client实例
 	config := NewConfig("test_key")
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
-	// 2. 创建格式错误的坐标参数
+	// 2. 创建超出范围的坐标参数
 	req := &bicyclingV2.BicyclingRequestV2{
-		Origin:      "116.351147 39.936871", // 使用空格分隔而不是逗号
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 216.351147, Lat: 39.936871}, // 经度超出 -180~180
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 3. 执行骑行路径规划v2请求
@@ -3045,7 +3087,7 @@ func TestBicyclingV2_InvalidCoordinateFormat(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 	assert.IsType(t, amapErr.InvalidConfigError(""), err)
-	assert.Contains(t, err.Error(), "坐标格式错误")
+	assert.Contains(t, err.Error(), "坐标错误")
 }
 
 // TestBicyclingV2_APIError 测试BicyclingV2方法API返回错误
@@ -3067,8 +3109,8 @@ func TestBicyclingV2_APIError(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &bicyclingV2.BicyclingRequestV2{
-		Origin:      "116.351147,39.936871",
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 4. 执行骑行路径规划v2请求
@@ -3077,8 +3119,8 @@ func TestBicyclingV2_APIError(t *testing.T) {
 	// 5. 验证结果
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10001", apiErr.Code)
 	assert.Equal(t, "无效的Key", apiErr.Info)
 }
@@ -3130,8 +3172,8 @@ func TestBusV2_Success(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &busV2.BusRequestV2{
-		Origin:      "116.351147,39.936871",
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 4. 执行公交路径规划v2请求
@@ -3144,12 +3186,12 @@ func TestBusV2_Success(t *testing.T) {
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
 	assert.NotNil(t, resp.Route)
-	assert.Equal(t, "116.351147,39.936871", resp.Route.Origin)
-	assert.Equal(t, "116.410001,39.910113", resp.Route.Destination)
+	assert.EqualValues(t, "116.351147,39.936871", resp.Route.Origin)
+	assert.EqualValues(t, "116.410001,39.910113", resp.Route.Destination)
 	assert.Len(t, resp.Route.Paths, 1)
 	assert.Len(t, resp.Route.Paths[0].Transits, 1)
-	assert.Equal(t, "6237", resp.Route.Paths[0].Distance)
-	assert.Equal(t, "480", resp.Route.Paths[0].Duration)
+	assert.EqualValues(t, "6237", resp.Route.Paths[0].Distance)
+	assert.EqualValues(t, "480", resp.Route.Paths[0].Duration)
 }
 
 // TestBusV2_MissingOrigin 测试BusV2方法缺少必填参数Origin
@@ -3161,7 +3203,7 @@ func TestBusV2_MissingOrigin(t *testing.T) {
 
 	// 2. 创建缺少Origin的请求参数
 	req := &busV2.BusRequestV2{
-		Destination: "116.410001,39.910113",
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 3. 执行公交路径规划v2请求
@@ -3183,7 +3225,7 @@ func TestBusV2_MissingDestination(t *testing.T) {
 
 	// 2. 创建缺少Destination的请求参数
 	req := &busV2.BusRequestV2{
-		Origin: "116.351147,39.936871",
+		Origin: amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
 	}
 
 	// 3. 执行公交路径规划v2请求
@@ -3196,17 +3238,17 @@ func TestBusV2_MissingDestination(t *testing.T) {
 	assert.Contains(t, err.Error(), "destination参数不能为空")
 }
 
-// TestBusV2_InvalidCoordinateFormat 测试BusV2方法坐标格式错误
-func TestBusV2_InvalidCoordinateFormat(t *testing.T) {
+// TestBusV2_InvalidCoordinate 测试BusV2方法坐标超出经纬度范围
+func TestBusV2_InvalidCoordinate(t *testing.T) {
 	// 1. 创建Client实例
 	config := NewConfig("test_key")
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
-	// 2. 创建格式错误的坐标参数
+	// 2. 创建超出范围的坐标参数
 	req := &busV2.BusRequestV2{
-		Origin:      "116.351147 39.936871", // 使用空格分隔而不是逗号
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 216.351147, Lat: 39.936871}, // 经度超出 -180~180
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 3. 执行公交路径规划v2请求
@@ -3216,7 +3258,7 @@ func TestBusV2_InvalidCoordinateFormat(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 	assert.IsType(t, amapErr.InvalidConfigError(""), err)
-	assert.Contains(t, err.Error(), "坐标格式错误")
+	assert.Contains(t, err.Error(), "坐标错误")
 }
 
 // TestBusV2_APIError 测试BusV2方法API返回错误
@@ -3238,8 +3280,8 @@ func TestBusV2_APIError(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &busV2.BusRequestV2{
-		Origin:      "116.351147,39.936871",
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 4. 执行公交路径规划v2请求
@@ -3248,8 +3290,8 @@ func TestBusV2_APIError(t *testing.T) {
 	// 5. 验证结果
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10001", apiErr.Code)
 	assert.Equal(t, "无效的Key", apiErr.Info)
 }
@@ -3311,8 +3353,8 @@ func TestElectricV2_Success(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &electricV2.ElectricRequestV2{
-		Origin:      "116.351147,39.936871",
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 4. 执行电动车路径规划v2请求
@@ -3324,19 +3366,19 @@ func TestElectricV2_Success(t *testing.T) {
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
-	assert.Equal(t, "116.351147,39.936871", resp.Route.Origin)
-	assert.Equal(t, "116.410001,39.910113", resp.Route.Destination)
-	assert.Equal(t, "5537", resp.Route.Distance)
-	assert.Equal(t, "320", resp.Route.Duration)
-	assert.Equal(t, "0", resp.Route.Tolls)
+	assert.EqualValues(t, "116.351147,39.936871", resp.Route.Origin)
+	assert.EqualValues(t, "116.410001,39.910113", resp.Route.Destination)
+	assert.EqualValues(t, "5537", resp.Route.Distance)
+	assert.EqualValues(t, "320", resp.Route.Duration)
+	assert.EqualValues(t, "0", resp.Route.Tolls)
 	assert.Len(t, resp.Route.Paths, 1)
-	assert.Equal(t, "5537", resp.Route.Paths[0].Distance)
-	assert.Equal(t, "320", resp.Route.Paths[0].Duration)
-	assert.Equal(t, "0", resp.Route.Paths[0].Tolls)
+	assert.EqualValues(t, "5537", resp.Route.Paths[0].Distance)
+	assert.EqualValues(t, "320", resp.Route.Paths[0].Duration)
+	assert.EqualValues(t, "0", resp.Route.Paths[0].Tolls)
 	assert.Len(t, resp.Route.Paths[0].Steps, 1)
-	assert.Equal(t, "沿电动车专用道向东行驶1.5公里", resp.Route.Paths[0].Steps[0].Instruction)
+	assert.EqualValues(t, "沿电动车专用道向东行驶1.5公里", resp.Route.Paths[0].Steps[0].Instruction)
 	assert.Len(t, resp.Route.Paths[0].ChargeInfo.ChargeStations, 1)
-	assert.Equal(t, "建国门充电站", resp.Route.Paths[0].ChargeInfo.ChargeStations[0].Name)
+	assert.EqualValues(t, "建国门充电站", resp.Route.Paths[0].ChargeInfo.ChargeStations[0].Name)
 }
 
 // TestElectricV2_MissingOrigin 测试ElectricV2方法缺少必填参数Origin
@@ -3348,7 +3390,7 @@ func TestElectricV2_MissingOrigin(t *testing.T) {
 
 	// 2. 创建缺少Origin的请求参数
 	req := &electricV2.ElectricRequestV2{
-		Destination: "116.410001,39.910113",
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 3. 执行电动车路径规划v2请求
@@ -3370,7 +3412,7 @@ func TestElectricV2_MissingDestination(t *testing.T) {
 
 	// 2. 创建缺少Destination的请求参数
 	req := &electricV2.ElectricRequestV2{
-		Origin: "116.351147,39.936871",
+		Origin: amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
 	}
 
 	// 3. 执行电动车路径规划v2请求
@@ -3383,17 +3425,17 @@ func TestElectricV2_MissingDestination(t *testing.T) {
 	assert.Contains(t, err.Error(), "destination参数不能为空")
 }
 
-// TestElectricV2_InvalidCoordinateFormat 测试ElectricV2方法坐标格式错误
-func TestElectricV2_InvalidCoordinateFormat(t *testing.T) {
+// TestElectricV2_InvalidCoordinate 测试ElectricV2方法坐标超出经纬度范围
+func TestElectricV2_InvalidCoordinate(t *testing.T) {
 	// 1. 创建Client实例
 	config := NewConfig("test_key")
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
-	// 2. 创建格式错误的坐标参数
+	// 2. 创建超出范围的坐标参数
 	req := &electricV2.ElectricRequestV2{
-		Origin:      "116.351147 39.936871", // 使用空格分隔而不是逗号
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 216.351147, Lat: 39.936871}, // 经度超出 -180~180
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 3. 执行电动车路径规划v2请求
@@ -3403,7 +3445,7 @@ func TestElectricV2_InvalidCoordinateFormat(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 	assert.IsType(t, amapErr.InvalidConfigError(""), err)
-	assert.Contains(t, err.Error(), "坐标格式错误")
+	assert.Contains(t, err.Error(), "坐标错误")
 }
 
 // TestElectricV2_APIError 测试ElectricV2方法API返回错误
@@ -3425,8 +3467,8 @@ func TestElectricV2_APIError(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &electricV2.ElectricRequestV2{
-		Origin:      "116.351147,39.936871",
-		Destination: "116.410001,39.910113",
+		Origin:      amapType.LngLat{Lng: 116.351147, Lat: 39.936871},
+		Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113},
 	}
 
 	// 4. 执行电动车路径规划v2请求
@@ -3435,8 +3477,8 @@ func TestElectricV2_APIError(t *testing.T) {
 	// 5. 验证结果
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10001", apiErr.Code)
 	assert.Equal(t, "无效的Key", apiErr.Info)
 }
@@ -3502,9 +3544,9 @@ func TestTrafficIncident_Success(t *testing.T) {
 
 	// 3. 创建请求参数
 	req := &trafficIncident.TrafficIncidentRequest{
-		Level:     "1",                                         // 所有级别
-		Type:      "1|3",                                       // 道路施工和交通事故
-		Rectangle: "116.300000,39.900000,116.400000,39.950000", // 北京核心区域
+		Level:     "1",                                                                                                                    // 所有级别
+		Type:      "1|3",                                                                                                                  // 道路施工和交通事故
+		Rectangle: amapType.Bounds{SouthWest: amapType.LngLat{Lng: 116.3, Lat: 39.9}, NorthEast: amapType.LngLat{Lng: 116.4, Lat: 39.95}}, // 北京核心区域
 	}
 
 	// 4. 执行交通事件查询请求
@@ -3519,21 +3561,21 @@ func TestTrafficIncident_Success(t *testing.T) {
 	assert.Len(t, resp.Trafficincidents, 2)
 
 	// 验证第一个事件
-	assert.Equal(t, "12345", resp.Trafficincidents[0].Id)
-	assert.Equal(t, "1", resp.Trafficincidents[0].Type)
-	assert.Equal(t, "道路施工", resp.Trafficincidents[0].TypeDes)
-	assert.Equal(t, "2", resp.Trafficincidents[0].Level)
-	assert.Equal(t, "一般", resp.Trafficincidents[0].LevelDes)
-	assert.Equal(t, "建国路", resp.Trafficincidents[0].Road)
-	assert.Equal(t, "1", resp.Trafficincidents[0].Status)
+	assert.EqualValues(t, "12345", resp.Trafficincidents[0].Id)
+	assert.EqualValues(t, "1", resp.Trafficincidents[0].Type)
+	assert.EqualValues(t, "道路施工", resp.Trafficincidents[0].TypeDes)
+	assert.EqualValues(t, "2", resp.Trafficincidents[0].Level)
+	assert.EqualValues(t, "一般", resp.Trafficincidents[0].LevelDes)
+	assert.EqualValues(t, "建国路", resp.Trafficincidents[0].Road)
+	assert.EqualValues(t, "1", resp.Trafficincidents[0].Status)
 
 	// 验证第二个事件
-	assert.Equal(t, "12346", resp.Trafficincidents[1].Id)
-	assert.Equal(t, "3", resp.Trafficincidents[1].Type)
-	assert.Equal(t, "交通事故", resp.Trafficincidents[1].TypeDes)
-	assert.Equal(t, "3", resp.Trafficincidents[1].Level)
-	assert.Equal(t, "严重", resp.Trafficincidents[1].LevelDes)
-	assert.Equal(t, "长安街", resp.Trafficincidents[1].Road)
+	assert.EqualValues(t, "12346", resp.Trafficincidents[1].Id)
+	assert.EqualValues(t, "3", resp.Trafficincidents[1].Type)
+	assert.EqualValues(t, "交通事故", resp.Trafficincidents[1].TypeDes)
+	assert.EqualValues(t, "3", resp.Trafficincidents[1].Level)
+	assert.EqualValues(t, "严重", resp.Trafficincidents[1].LevelDes)
+	assert.EqualValues(t, "长安街", resp.Trafficincidents[1].Road)
 }
 
 // TestTrafficIncident_MissingLevel 测试TrafficIncident方法缺少必填参数Level
@@ -3546,7 +3588,7 @@ func TestTrafficIncident_MissingLevel(t *testing.T) {
 	// 2. 创建缺少Level的请求参数
 	req := &trafficIncident.TrafficIncidentRequest{
 		Type:      "1",
-		Rectangle: "116.300000,39.900000,116.400000,39.950000",
+		Rectangle: amapType.Bounds{SouthWest: amapType.LngLat{Lng: 116.3, Lat: 39.9}, NorthEast: amapType.LngLat{Lng: 116.4, Lat: 39.95}},
 	}
 
 	// 3. 执行交通事件查询请求
@@ -3569,7 +3611,7 @@ func TestTrafficIncident_MissingType(t *testing.T) {
 	// 2. 创建缺少Type的请求参数
 	req := &trafficIncident.TrafficIncidentRequest{
 		Level:     "1",
-		Rectangle: "116.300000,39.900000,116.400000,39.950000",
+		Rectangle: amapType.Bounds{SouthWest: amapType.LngLat{Lng: 116.3, Lat: 39.9}, NorthEast: amapType.LngLat{Lng: 116.4, Lat: 39.95}},
 	}
 
 	// 3. 执行交通事件查询请求
@@ -3605,18 +3647,21 @@ func TestTrafficIncident_MissingRectangle(t *testing.T) {
 	assert.Contains(t, err.Error(), "rectangle参数不能为空")
 }
 
-// TestTrafficIncident_InvalidRectangleFormat 测试TrafficIncident方法Rectangle格式错误
-func TestTrafficIncident_InvalidRectangleFormat(t *testing.T) {
+// TestTrafficIncident_InvalidRectangle 测试TrafficIncident方法Rectangle左下角大于右上角
+func TestTrafficIncident_InvalidRectangle(t *testing.T) {
 	// 1. 创建Client实例
 	config := NewConfig("test_key")
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
-	// 2. 创建格式错误的Rectangle参数
+	// 2. 创建左下角与右上角颠倒的Rectangle参数
 	req := &trafficIncident.TrafficIncidentRequest{
-		Level:     "1",
-		Type:      "1",
-		Rectangle: "116.300000,39.900000,116.400000", // 缺少一个坐标点
+		Level: "1",
+		Type:  "1",
+		Rectangle: amapType.Bounds{
+			SouthWest: amapType.LngLat{Lng: 116.4, Lat: 39.95},
+			NorthEast: amapType.LngLat{Lng: 116.3, Lat: 39.9},
+		},
 	}
 
 	// 3. 执行交通事件查询请求
@@ -3626,7 +3671,7 @@ func TestTrafficIncident_InvalidRectangleFormat(t *testing.T) {
 	assert.Error(t, err)
 	assert.Nil(t, resp)
 	assert.IsType(t, amapErr.InvalidConfigError(""), err)
-	assert.Contains(t, err.Error(), "rectangle格式错误")
+	assert.Contains(t, err.Error(), "rectangle错误")
 }
 
 // TestTrafficIncident_APIError 测试TrafficIncident方法API返回错误
@@ -3650,7 +3695,7 @@ func TestTrafficIncident_APIError(t *testing.T) {
 	req := &trafficIncident.TrafficIncidentRequest{
 		Level:     "1",
 		Type:      "1",
-		Rectangle: "116.300000,39.900000,116.400000,39.950000",
+		Rectangle: amapType.Bounds{SouthWest: amapType.LngLat{Lng: 116.3, Lat: 39.9}, NorthEast: amapType.LngLat{Lng: 116.4, Lat: 39.95}},
 	}
 
 	// 4. 执行交通事件查询请求
@@ -3659,8 +3704,8 @@ func TestTrafficIncident_APIError(t *testing.T) {
 	// 5. 验证结果
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10001", apiErr.Code)
 	assert.Equal(t, "无效的Key", apiErr.Info)
 }
@@ -3720,7 +3765,7 @@ func TestTrafficIncident_WithExtensionsAll(t *testing.T) {
 	req := &trafficIncident.TrafficIncidentRequest{
 		Level:      "1",
 		Type:       "5",
-		Rectangle:  "116.300000,39.900000,116.400000,39.950000",
+		Rectangle:  amapType.Bounds{SouthWest: amapType.LngLat{Lng: 116.3, Lat: 39.9}, NorthEast: amapType.LngLat{Lng: 116.4, Lat: 39.95}},
 		Extensions: "all", // 返回详细信息
 	}
 
@@ -3732,9 +3777,9 @@ func TestTrafficIncident_WithExtensionsAll(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Len(t, resp.Trafficincidents, 1)
 	assert.Len(t, resp.Trafficincidents[0].Jams, 1) // 包含拥堵信息
-	assert.Equal(t, "1000", resp.Trafficincidents[0].Jams[0].Length)
-	assert.Equal(t, "4", resp.Trafficincidents[0].Jams[0].Level)
-	assert.Equal(t, "10", resp.Trafficincidents[0].Jams[0].Speed)
+	assert.EqualValues(t, "1000", resp.Trafficincidents[0].Jams[0].Length)
+	assert.EqualValues(t, "4", resp.Trafficincidents[0].Jams[0].Level)
+	assert.EqualValues(t, "10", resp.Trafficincidents[0].Jams[0].Speed)
 }
 
 // -------------------------- IP定位测试 --------------------------
@@ -3778,14 +3823,14 @@ func TestIPConfig_Success(t *testing.T) {
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
-	assert.Equal(t, "114.114.114.114", resp.IP)
-	assert.Equal(t, "中国", resp.Country)
-	assert.Equal(t, "江苏省", resp.Province)
-	assert.Equal(t, "南京市", resp.City)
-	assert.Equal(t, "秦淮区", resp.District)
-	assert.Equal(t, "320104", resp.Adcode)
-	assert.Equal(t, "118.796877,32.048458", resp.Center)
-	assert.Equal(t, "江苏省电信", resp.ISP)
+	assert.EqualValues(t, "114.114.114.114", resp.IP)
+	assert.EqualValues(t, "中国", resp.Country)
+	assert.EqualValues(t, "江苏省", resp.Province)
+	assert.EqualValues(t, "南京市", resp.City)
+	assert.EqualValues(t, "秦淮区", resp.District)
+	assert.EqualValues(t, "320104", resp.Adcode)
+	assert.EqualValues(t, "118.796877,32.048458", resp.Center)
+	assert.EqualValues(t, "江苏省电信", resp.ISP)
 }
 
 // TestIPConfig_MissingIP 测试IPConfig方法缺少必填参数IP
@@ -3838,8 +3883,8 @@ func TestIPConfig_APIError(t *testing.T) {
 	// 5. 验证结果
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10001", apiErr.Code)
 	assert.Equal(t, "无效的Key", apiErr.Info)
 }
@@ -3882,14 +3927,14 @@ func TestIPConfig_IPv6(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
-	assert.Equal(t, "2001:4860:4860::8888", resp.IP)
-	assert.Equal(t, "美国", resp.Country)
-	assert.Equal(t, "加利福尼亚州", resp.Province)
-	assert.Equal(t, "山景城", resp.City)
-	assert.Equal(t, "圣克拉拉县", resp.District)
-	assert.Equal(t, "84006085", resp.Adcode)
-	assert.Equal(t, "-122.083851,37.422258", resp.Center)
-	assert.Equal(t, "Google LLC", resp.ISP)
+	assert.EqualValues(t, "2001:4860:4860::8888", resp.IP)
+	assert.EqualValues(t, "美国", resp.Country)
+	assert.EqualValues(t, "加利福尼亚州", resp.Province)
+	assert.EqualValues(t, "山景城", resp.City)
+	assert.EqualValues(t, "圣克拉拉县", resp.District)
+	assert.EqualValues(t, "84006085", resp.Adcode)
+	assert.EqualValues(t, "-122.083851,37.422258", resp.Center)
+	assert.EqualValues(t, "Google LLC", resp.ISP)
 }
 
 // TestIPConfig_WithLocationInfo 测试IPConfig方法返回详细位置信息
@@ -3944,16 +3989,16 @@ func TestIPConfig_WithLocationInfo(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
-	assert.Equal(t, "8.8.8.8", resp.IP)
+	assert.EqualValues(t, "8.8.8.8", resp.IP)
 	assert.NotNil(t, resp.Location)
-	assert.Equal(t, "39.032222", resp.Location.Lat)
-	assert.Equal(t, "-77.438217", resp.Location.Lon)
-	assert.Equal(t, "美国弗吉尼亚州阿什本劳登县", resp.Location.Address)
+	assert.EqualValues(t, "39.032222", resp.Location.Lat)
+	assert.EqualValues(t, "-77.438217", resp.Location.Lon)
+	assert.EqualValues(t, "美国弗吉尼亚州阿什本劳登县", resp.Location.Address)
 	assert.NotNil(t, resp.Location.ISPInfo)
-	assert.Equal(t, "Google LLC", resp.Location.ISPInfo.Name)
-	assert.Equal(t, "ISP", resp.Location.ISPInfo.Type)
-	assert.Equal(t, "310", resp.Location.ISPInfo.MCC)
-	assert.Equal(t, "260", resp.Location.ISPInfo.MNC)
+	assert.EqualValues(t, "Google LLC", resp.Location.ISPInfo.Name)
+	assert.EqualValues(t, "ISP", resp.Location.ISPInfo.Type)
+	assert.EqualValues(t, "310", resp.Location.ISPInfo.MCC)
+	assert.EqualValues(t, "260", resp.Location.ISPInfo.MNC)
 }
 
 // -------------------------- IP v5 测试 --------------------------
@@ -3997,14 +4042,14 @@ func TestIPV5Config_Success(t *testing.T) {
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
-	assert.Equal(t, "114.114.114.114", resp.IP)
-	assert.Equal(t, "中国", resp.Country)
-	assert.Equal(t, "江苏省", resp.Province)
-	assert.Equal(t, "南京市", resp.City)
-	assert.Equal(t, "秦淮区", resp.District)
-	assert.Equal(t, "320104", resp.Adcode)
-	assert.Equal(t, "118.796877,32.048458", resp.Center)
-	assert.Equal(t, "江苏省电信", resp.ISP)
+	assert.EqualValues(t, "114.114.114.114", resp.IP)
+	assert.EqualValues(t, "中国", resp.Country)
+	assert.EqualValues(t, "江苏省", resp.Province)
+	assert.EqualValues(t, "南京市", resp.City)
+	assert.EqualValues(t, "秦淮区", resp.District)
+	assert.EqualValues(t, "320104", resp.Adcode)
+	assert.EqualValues(t, "118.796877,32.048458", resp.Center)
+	assert.EqualValues(t, "江苏省电信", resp.ISP)
 }
 
 // TestIPV5Config_MissingIP 测试IPV5Config方法缺少必填参数IP
@@ -4057,8 +4102,8 @@ func TestIPV5Config_APIError(t *testing.T) {
 	// 5. 验证结果
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10001", apiErr.Code)
 	assert.Equal(t, "无效的Key", apiErr.Info)
 }
@@ -4101,14 +4146,14 @@ func TestIPV5Config_IPv6(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
-	assert.Equal(t, "2001:4860:4860::8888", resp.IP)
-	assert.Equal(t, "美国", resp.Country)
-	assert.Equal(t, "加利福尼亚州", resp.Province)
-	assert.Equal(t, "山景城", resp.City)
-	assert.Equal(t, "圣克拉拉县", resp.District)
-	assert.Equal(t, "84006085", resp.Adcode)
-	assert.Equal(t, "-122.083851,37.422258", resp.Center)
-	assert.Equal(t, "Google LLC", resp.ISP)
+	assert.EqualValues(t, "2001:4860:4860::8888", resp.IP)
+	assert.EqualValues(t, "美国", resp.Country)
+	assert.EqualValues(t, "加利福尼亚州", resp.Province)
+	assert.EqualValues(t, "山景城", resp.City)
+	assert.EqualValues(t, "圣克拉拉县", resp.District)
+	assert.EqualValues(t, "84006085", resp.Adcode)
+	assert.EqualValues(t, "-122.083851,37.422258", resp.Center)
+	assert.EqualValues(t, "Google LLC", resp.ISP)
 }
 
 // TestIPV5Config_WithLocationInfo 测试IPV5Config方法返回详细位置信息
@@ -4164,26 +4209,26 @@ func TestIPV5Config_WithLocationInfo(t *testing.T) {
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
-	assert.Equal(t, "8.8.8.8", resp.IP)
-	assert.Equal(t, "美国", resp.Country)
-	assert.Equal(t, "弗吉尼亚州", resp.Province)
-	assert.Equal(t, "阿什本", resp.City)
-	assert.Equal(t, "劳登县", resp.District)
-	assert.Equal(t, "84051107", resp.Adcode)
-	assert.Equal(t, "-77.438217,39.032222", resp.Center)
-	assert.Equal(t, "Google LLC", resp.ISP)
+	assert.EqualValues(t, "8.8.8.8", resp.IP)
+	assert.EqualValues(t, "美国", resp.Country)
+	assert.EqualValues(t, "弗吉尼亚州", resp.Province)
+	assert.EqualValues(t, "阿什本", resp.City)
+	assert.EqualValues(t, "劳登县", resp.District)
+	assert.EqualValues(t, "84051107", resp.Adcode)
+	assert.EqualValues(t, "-77.438217,39.032222", resp.Center)
+	assert.EqualValues(t, "Google LLC", resp.ISP)
 	assert.NotNil(t, resp.Location)
-	assert.Equal(t, "39.032222", resp.Location.Lat)
-	assert.Equal(t, "-77.438217", resp.Location.Lon)
-	assert.Equal(t, "美国弗吉尼亚州阿什本劳登县", resp.Location.Address)
-	assert.Equal(t, "ASB", resp.Location.CityCode)
-	assert.Equal(t, "VA", resp.Location.ProvinceCode)
-	assert.Equal(t, "LDN", resp.Location.DistrictCode)
+	assert.EqualValues(t, "39.032222", resp.Location.Lat)
+	assert.EqualValues(t, "-77.438217", resp.Location.Lon)
+	assert.EqualValues(t, "美国弗吉尼亚州阿什本劳登县", resp.Location.Address)
+	assert.EqualValues(t, "ASB", resp.Location.CityCode)
+	assert.EqualValues(t, "VA", resp.Location.ProvinceCode)
+	assert.EqualValues(t, "LDN", resp.Location.DistrictCode)
 	assert.NotNil(t, resp.Location.ISPInfo)
-	assert.Equal(t, "Google LLC", resp.Location.ISPInfo.Name)
-	assert.Equal(t, "ISP", resp.Location.ISPInfo.Type)
-	assert.Equal(t, "310", resp.Location.ISPInfo.MCC)
-	assert.Equal(t, "260", resp.Location.ISPInfo.MNC)
+	assert.EqualValues(t, "Google LLC", resp.Location.ISPInfo.Name)
+	assert.EqualValues(t, "ISP", resp.Location.ISPInfo.Type)
+	assert.EqualValues(t, "310", resp.Location.ISPInfo.MCC)
+	assert.EqualValues(t, "260", resp.Location.ISPInfo.MNC)
 }
 
 // -------------------------- IP 定位测试 --------------------------
@@ -4208,6 +4253,7 @@ func TestIPConfig_Success_V3(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -4226,14 +4272,14 @@ func TestIPConfig_Success_V3(t *testing.T) {
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
-	assert.Equal(t, "114.114.114.114", resp.IP)
-	assert.Equal(t, "中国", resp.Country)
-	assert.Equal(t, "江苏省", resp.Province)
-	assert.Equal(t, "南京市", resp.City)
-	assert.Equal(t, "秦淮区", resp.District)
-	assert.Equal(t, "联通", resp.ISP)
-	assert.Equal(t, "320104", resp.Adcode)
-	assert.Equal(t, "118.790587,32.024847", resp.Center)
+	assert.EqualValues(t, "114.114.114.114", resp.IP)
+	assert.EqualValues(t, "中国", resp.Country)
+	assert.EqualValues(t, "江苏省", resp.Province)
+	assert.EqualValues(t, "南京市", resp.City)
+	assert.EqualValues(t, "秦淮区", resp.District)
+	assert.EqualValues(t, "联通", resp.ISP)
+	assert.EqualValues(t, "320104", resp.Adcode)
+	assert.EqualValues(t, "118.790587,32.024847", resp.Center)
 }
 
 // TestIPV5Config_Success_V5 测试IPV5Config (v5)方法正常请求成功
@@ -4256,6 +4302,7 @@ func TestIPV5Config_Success_V5(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -4274,12 +4321,12 @@ func TestIPV5Config_Success_V5(t *testing.T) {
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
-	assert.Equal(t, "8.8.8.8", resp.IP)
-	assert.Equal(t, "美国", resp.Country)
-	assert.Equal(t, "加利福尼亚州", resp.Province)
-	assert.Equal(t, "山景城", resp.City)
-	assert.Equal(t, "谷歌", resp.ISP)
-	assert.Equal(t, "-122.084068,37.421999", resp.Center)
+	assert.EqualValues(t, "8.8.8.8", resp.IP)
+	assert.EqualValues(t, "美国", resp.Country)
+	assert.EqualValues(t, "加利福尼亚州", resp.Province)
+	assert.EqualValues(t, "山景城", resp.City)
+	assert.EqualValues(t, "谷歌", resp.ISP)
+	assert.EqualValues(t, "-122.084068,37.421999", resp.Center)
 }
 
 // -------------------------- 坐标转换测试 --------------------------
@@ -4297,13 +4344,14 @@ func TestConvert_Success(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
 	// 3. 执行请求，转换GPS坐标到高德坐标
 	req := &convert.ConvertRequest{
-		Locations: "116.480656,39.989610;116.30815,39.95965",
+		Locations: "116.480656,39.989610|116.30815,39.95965",
 		CoordSys:  "gps",
 	}
 	resp, err := client.Convert(req)
@@ -4311,7 +4359,7 @@ func TestConvert_Success(t *testing.T) {
 	// 4. 验证结果
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, "116.480656,39.989610;116.30815,39.95965", resp.Locations)
+	assert.EqualValues(t, "116.480656,39.989610;116.30815,39.95965", resp.Locations)
 }
 
 // TestConvert_MissingLocations 测试Convert方法缺少必填参数locations
@@ -4366,6 +4414,7 @@ func TestConvert_APIError(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -4380,8 +4429,8 @@ func TestConvert_APIError(t *testing.T) {
 	// 4. 验证结果
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10003", apiErr.Code)
 	assert.Equal(t, "无效的coordsys参数", apiErr.Info)
 }
@@ -4399,6 +4448,7 @@ func TestConvert_DifferentCoordSys(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -4415,8 +4465,8 @@ func TestConvert_DifferentCoordSys(t *testing.T) {
 	assert.NotNil(t, resp)
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
-	assert.Equal(t, "10003", resp.InfoCode)
-	assert.Equal(t, "116.480656,39.989610", resp.Locations)
+	assert.Equal(t, "10000", resp.InfoCode)
+	assert.EqualValues(t, "116.480656,39.989610", resp.Locations)
 }
 
 // TestConvert_BatchConvert 测试Convert方法批量转换
@@ -4432,13 +4482,14 @@ func TestConvert_BatchConvert(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
 
 	// 3. 执行请求，测试批量转换（3个坐标对）
 	req := &convert.ConvertRequest{
-		Locations: "116.480656,39.989610;116.30815,39.95965;116.407428,39.90923",
+		Locations: "116.480656,39.989610|116.30815,39.95965|116.407428,39.90923",
 		CoordSys:  "gps",
 	}
 	resp, err := client.Convert(req)
@@ -4449,7 +4500,7 @@ func TestConvert_BatchConvert(t *testing.T) {
 	assert.Equal(t, "1", resp.Status)
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "10000", resp.InfoCode)
-	assert.Equal(t, "116.480656,39.989610;116.30815,39.95965;116.407428,39.90923", resp.Locations)
+	assert.EqualValues(t, "116.480656,39.989610;116.30815,39.95965;116.407428,39.90923", resp.Locations)
 }
 
 // TestConvert_InvalidLocationFormat 测试Convert方法无效坐标格式
@@ -4464,6 +4515,7 @@ func TestConvert_InvalidLocationFormat(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -4478,8 +4530,8 @@ func TestConvert_InvalidLocationFormat(t *testing.T) {
 	// 4. 验证结果
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10004", apiErr.Code)
 	assert.Equal(t, "无效的locations参数", apiErr.Info)
 }
@@ -4498,6 +4550,7 @@ func TestBusStationID_Success(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -4514,9 +4567,9 @@ func TestBusStationID_Success(t *testing.T) {
 	// 5. 验证结果
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, "1", resp.Status)
-	assert.Equal(t, "OK", resp.Info)
-	assert.Equal(t, "10000", resp.InfoCode)
+	assert.EqualValues(t, "1", resp.Status)
+	assert.EqualValues(t, "OK", resp.Info)
+	assert.EqualValues(t, "10000", resp.InfoCode)
 }
 
 // TestBusLineID_Success 测试BusLineID方法正常请求成功
@@ -4531,6 +4584,7 @@ func TestBusLineID_Success(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -4547,9 +4601,9 @@ func TestBusLineID_Success(t *testing.T) {
 	// 5. 验证结果
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, "1", resp.Status)
-	assert.Equal(t, "OK", resp.Info)
-	assert.Equal(t, "10000", resp.InfoCode)
+	assert.EqualValues(t, "1", resp.Status)
+	assert.EqualValues(t, "OK", resp.Info)
+	assert.EqualValues(t, "10000", resp.InfoCode)
 }
 
 // TestBusStationKeyword_Success 测试BusStationKeyword方法正常请求成功
@@ -4564,6 +4618,7 @@ func TestBusStationKeyword_Success(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -4580,9 +4635,9 @@ func TestBusStationKeyword_Success(t *testing.T) {
 	// 5. 验证结果
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, "1", resp.Status)
-	assert.Equal(t, "OK", resp.Info)
-	assert.Equal(t, "10000", resp.InfoCode)
+	assert.EqualValues(t, "1", resp.Status)
+	assert.EqualValues(t, "OK", resp.Info)
+	assert.EqualValues(t, "10000", resp.InfoCode)
 }
 
 // TestBusLineKeyword_Success 测试BusLineKeyword方法正常请求成功
@@ -4597,6 +4652,7 @@ func TestBusLineKeyword_Success(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -4613,9 +4669,9 @@ func TestBusLineKeyword_Success(t *testing.T) {
 	// 5. 验证结果
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, "1", resp.Status)
-	assert.Equal(t, "OK", resp.Info)
-	assert.Equal(t, "10000", resp.InfoCode)
+	assert.EqualValues(t, "1", resp.Status)
+	assert.EqualValues(t, "OK", resp.Info)
+	assert.EqualValues(t, "10000", resp.InfoCode)
 }
 
 // TestBusStationID_MissingID 测试BusStationID方法缺少必填参数ID
@@ -4693,6 +4749,7 @@ func TestGraspRoad_Success(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -4708,12 +4765,12 @@ func TestGraspRoad_Success(t *testing.T) {
 	// 4. 验证结果
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, "test_sid", resp.SID)
+	assert.EqualValues(t, "test_sid", resp.SID)
 	assert.Len(t, resp.Paths, 1)
 	assert.Len(t, resp.Paths[0].Points, 2)
-	assert.Equal(t, "116.480656,39.989610", resp.Paths[0].Points[0].Location)
+	assert.EqualValues(t, "116.480656,39.989610", resp.Paths[0].Points[0].Location)
 	assert.Equal(t, int64(1600000000), resp.Paths[0].Points[0].Time)
-	assert.Equal(t, 30.5, resp.Paths[0].Points[0].Speed)
+	assert.EqualValues(t, 30.5, resp.Paths[0].Points[0].Speed)
 }
 
 // TestGraspRoad_MissingSID 测试GraspRoad方法缺少必填参数sid
@@ -4770,6 +4827,7 @@ func TestGraspRoad_APIError(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -4785,8 +4843,8 @@ func TestGraspRoad_APIError(t *testing.T) {
 	// 4. 验证结果
 	assert.Error(t, err)
 	assert.Nil(t, resp)
-	assert.IsType(t, &amapErr.APIError{}, err)
-	apiErr := err.(*amapErr.APIError)
+	var apiErr *amapErr.APIError
+	require.ErrorAs(t, err, &apiErr)
 	assert.Equal(t, "10001", apiErr.Code)
 	assert.Equal(t, "无效的Key", apiErr.Info)
 }
@@ -4847,6 +4905,7 @@ func TestGraspRoad_WithExtensionsAll(t *testing.T) {
 
 	// 2. 创建Client实例
 	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
 
 	client, err := NewClient(config)
 	require.NoError(t, err)
@@ -4863,19 +4922,19 @@ func TestGraspRoad_WithExtensionsAll(t *testing.T) {
 	// 4. 验证结果
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, "test_sid_all", resp.SID)
+	assert.EqualValues(t, "test_sid_all", resp.SID)
 	assert.Len(t, resp.Paths, 1)
-	assert.Equal(t, 200, resp.Paths[0].Distance)
-	assert.Equal(t, 20, resp.Paths[0].Time)
+	assert.EqualValues(t, 200, resp.Paths[0].Distance)
+	assert.EqualValues(t, 20, resp.Paths[0].Time)
 	assert.Len(t, resp.Paths[0].Points, 2)
-	assert.Equal(t, 90, resp.Paths[0].Points[0].Direction)
-	assert.Equal(t, "road123", resp.Paths[0].Points[0].RoadID)
-	assert.Equal(t, "建国路", resp.Paths[0].Points[0].RoadName)
-	assert.Equal(t, 1, resp.Paths[0].Points[0].MatchType)
-	assert.Equal(t, 0, resp.Paths[0].Points[0].Status)
+	assert.EqualValues(t, 90, resp.Paths[0].Points[0].Direction)
+	assert.EqualValues(t, "road123", resp.Paths[0].Points[0].RoadID)
+	assert.EqualValues(t, "建国路", resp.Paths[0].Points[0].RoadName)
+	assert.EqualValues(t, 1, resp.Paths[0].Points[0].MatchType)
+	assert.EqualValues(t, 0, resp.Paths[0].Points[0].Status)
 	assert.Len(t, resp.Paths[0].Steps, 1)
-	assert.Equal(t, "建国路", resp.Paths[0].Steps[0].Road.Name)
-	assert.Equal(t, 60, resp.Paths[0].Steps[0].Road.MaxSpeed)
+	assert.EqualValues(t, "建国路", resp.Paths[0].Steps[0].Road.Name)
+	assert.EqualValues(t, 60, resp.Paths[0].Steps[0].Road.MaxSpeed)
 }
 
 // -------------------------- 输入提示测试 --------------------------
@@ -4967,17 +5026,17 @@ func TestWeatherinfo_Success(t *testing.T) {
 	// 5. 验证结果
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
-	assert.Equal(t, "北京市", resp.Weatherinfo.City)
-	assert.Equal(t, "101010100", resp.Weatherinfo.CityID)
-	assert.Equal(t, "22", resp.Weatherinfo.Temp)
-	assert.Equal(t, "晴", resp.Weatherinfo.Weather)
-	assert.Equal(t, "10~22℃", resp.Weatherinfo.Temperature)
+	assert.EqualValues(t, "北京市", resp.Weatherinfo.City)
+	assert.EqualValues(t, "101010100", resp.Weatherinfo.CityID)
+	assert.EqualValues(t, "22", resp.Weatherinfo.Temp)
+	assert.EqualValues(t, "晴", resp.Weatherinfo.Weather)
+	assert.EqualValues(t, "10~22℃", resp.Weatherinfo.Temperature)
 	assert.Len(t, resp.Forecasts, 1)
 	assert.Len(t, resp.Forecasts[0].Forecast, 1)
-	assert.Equal(t, "2023-05-20", resp.Forecasts[0].Forecast[0].Date)
-	assert.Equal(t, "晴", resp.Forecasts[0].Forecast[0].Dayweather)
-	assert.Equal(t, "舒适", resp.Suggestion.Comf.Brf)
-	assert.Equal(t, "较适宜", resp.Suggestion.Cw.Brf)
+	assert.EqualValues(t, "2023-05-20", resp.Forecasts[0].Forecast[0].Date)
+	assert.EqualValues(t, "晴", resp.Forecasts[0].Forecast[0].Dayweather)
+	assert.EqualValues(t, "舒适", resp.Suggestion.Comf.Brf)
+	assert.EqualValues(t, "较适宜", resp.Suggestion.Cw.Brf)
 }
 
 // TestHardwarePosition_Success 测试硬件定位API调用成功（v1）
@@ -5025,13 +5084,13 @@ func TestHardwarePosition_Success(t *testing.T) {
 	assert.Equal(t, "10000", resp.InfoCode)
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "1", resp.Status)
-	assert.Equal(t, "test_device", resp.DeviceID)
-	assert.Equal(t, 39.908722, resp.Latitude)
-	assert.Equal(t, 116.397496, resp.Longitude)
-	assert.Equal(t, 5.0, resp.Accuracy)
-	assert.Equal(t, "hybrid", resp.LocationType)
+	assert.EqualValues(t, "test_device", resp.DeviceID)
+	assert.EqualValues(t, 39.908722, resp.Latitude)
+	assert.EqualValues(t, 116.397496, resp.Longitude)
+	assert.EqualValues(t, 5.0, resp.Accuracy)
+	assert.EqualValues(t, "hybrid", resp.LocationType)
 	assert.Len(t, resp.POI, 1)
-	assert.Equal(t, "天安门", resp.POI[0].Name)
+	assert.EqualValues(t, "天安门", resp.POI[0].Name)
 }
 
 // TestHardwarePositionV5_Success 测试硬件定位API调用成功（v5）
@@ -5090,20 +5149,592 @@ func TestHardwarePositionV5_Success(t *testing.T) {
 	assert.Equal(t, "10000", resp.InfoCode)
 	assert.Equal(t, "OK", resp.Info)
 	assert.Equal(t, "1", resp.Status)
-	assert.Equal(t, "test_device", resp.DeviceID)
-	assert.Equal(t, 39.908722, resp.Latitude)
-	assert.Equal(t, 116.397496, resp.Longitude)
-	assert.Equal(t, 3.0, resp.Accuracy)
-	assert.Equal(t, "hybrid", resp.LocationType)
+	assert.EqualValues(t, "test_device", resp.DeviceID)
+	assert.EqualValues(t, 39.908722, resp.Latitude)
+	assert.EqualValues(t, 116.397496, resp.Longitude)
+	assert.EqualValues(t, 3.0, resp.Accuracy)
+	assert.EqualValues(t, "hybrid", resp.LocationType)
 	assert.Len(t, resp.POI, 1)
-	assert.Equal(t, "天安门", resp.POI[0].Name)
-	assert.Equal(t, "北京市东城区", resp.POI[0].Address)
-	assert.Equal(t, "010-12345678", resp.POI[0].Phone)
+	assert.EqualValues(t, "天安门", resp.POI[0].Name)
+	assert.EqualValues(t, "北京市东城区", resp.POI[0].Address)
+	assert.EqualValues(t, "010-12345678", resp.POI[0].Phone)
 	assert.True(t, resp.SensorInfo.GPSValid)
 	assert.True(t, resp.SensorInfo.WiFiValid)
 	assert.True(t, resp.SensorInfo.BaseStationValid)
-	assert.Equal(t, 98.5, resp.Confidence)
+	assert.EqualValues(t, 98.5, resp.Confidence)
 	assert.False(t, resp.Indoor)
-	assert.Equal(t, "长安街", resp.MapMatch.RoadName)
-	assert.Equal(t, "test_trace_id_123456", resp.TraceID)
+	assert.EqualValues(t, "长安街", resp.MapMatch.RoadName)
+	assert.EqualValues(t, "test_trace_id_123456", resp.TraceID)
+}
+
+// TestDoRequestCtx_Canceled 测试ctx取消时中断进行中的请求并返回CanceledError
+func TestDoRequestCtx_Canceled(t *testing.T) {
+	// 1. 创建mock服务器，阻塞直到客户端断开
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer mockServer.Close()
+
+	// 2. 创建Client实例
+	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	// 3. 发起请求后取消ctx
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	resp, err := client.GeoCodeCtx(ctx, &geoCode.GeocodeRequest{Address: "北京市朝阳区"})
+
+	// 4. 验证结果
+	assert.Nil(t, resp)
+	var canceledErr *amapErr.CanceledError
+	assert.True(t, errors.As(err, &canceledErr))
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+// TestDoRequestCtx_DeadlineExceeded 测试ctx截止时间传递
+func TestDoRequestCtx_DeadlineExceeded(t *testing.T) {
+	// 1. 创建mock服务器，响应慢于截止时间
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer mockServer.Close()
+
+	// 2. 创建Client实例
+	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	// 3. 执行请求
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	var resp TestResponse
+	err = client.DoRequestCtx(ctx, http.MethodGet, "/test/path", nil, &resp)
+
+	// 4. 验证结果
+	assert.IsType(t, &amapErr.CanceledError{}, err)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+// TestDoRequestCtx_AlreadyCanceled 测试ctx已取消时不发出请求
+func TestDoRequestCtx_AlreadyCanceled(t *testing.T) {
+	// 1. 创建mock服务器，记录请求次数
+	requests := 0
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer mockServer.Close()
+
+	// 2. 创建Client实例
+	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	// 3. 使用已取消的ctx执行请求
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	resp, err := client.DrivingV2Ctx(ctx, &drivingV2.DrivingRequestV2{Origin: amapType.LngLat{Lng: 116.481028, Lat: 39.989643}, Destination: amapType.LngLat{Lng: 116.434446, Lat: 39.90816}})
+
+	// 4. 验证结果
+	assert.Nil(t, resp)
+	assert.IsType(t, &amapErr.CanceledError{}, err)
+	assert.Equal(t, 0, requests)
+}
+
+// TestDoRequest_APIErrorDebugInfo 测试API错误携带请求路径和参数（不含key、sig）
+func TestDoRequest_APIErrorDebugInfo(t *testing.T) {
+	// 1. 创建mock服务器，返回参数错误
+	mockServer := mockResponse(http.StatusOK, `{
+		"status": "0",
+		"info": "INVALID_PARAMS",
+		"infocode": "20000"
+	}`)
+	defer mockServer.Close()
+
+	// 2. 创建Client实例，配置SecurityKey
+	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
+	config.SecurityKey = "test_security_key"
+	config.BaseURL = mockServer.URL
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	// 3. 执行请求
+	resp, err := client.GeoCode(&geoCode.GeocodeRequest{Address: "北京市朝阳区", City: "北京"})
+
+	// 4. 验证结果
+	assert.Nil(t, resp)
+	var apiErr *amapErr.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "/v3/geocode/geo", apiErr.Path)
+	assert.Equal(t, "北京市朝阳区", apiErr.Params["address"])
+	assert.Equal(t, "北京", apiErr.Params["city"])
+	assert.NotContains(t, apiErr.Params, "key")
+	assert.NotContains(t, apiErr.Params, "sig")
+	assert.Equal(t, amapErr.CategoryParameter, apiErr.Category())
+	assert.True(t, amapErr.IsParameterError(err))
+	assert.Contains(t, err.Error(), "/v3/geocode/geo")
+}
+
+// TestGeoCode_LngLat 测试地理编码结果解析为 LngLat
+func TestGeoCode_LngLat(t *testing.T) {
+	// 1. 创建假服务器和Client
+	client, _ := newFakeClient(t)
+
+	// 2. 执行请求
+	resp, err := client.GeoCode(&geoCode.GeocodeRequest{Address: "北京市朝阳区望京SOHO"})
+	require.NoError(t, err)
+
+	// 3. 验证结果
+	location, err := resp.Geocodes[0].LngLat()
+	require.NoError(t, err)
+	assert.InDelta(t, 116.48649, location.Lng, 1e-9)
+	assert.InDelta(t, 39.99947, location.Lat, 1e-9)
+}
+
+// TestWalking_OutOfRangeCoordinate 测试坐标超出经纬度范围时不发出请求
+func TestWalking_OutOfRangeCoordinate(t *testing.T) {
+	client, srv := newFakeClient(t)
+	resp, err := client.Walking(&walking.WalkingRequest{Origin: amapType.LngLat{Lng: 216.351147, Lat: 39.936871}, Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113}})
+	assert.Nil(t, resp)
+	assert.IsType(t, amapErr.InvalidConfigError(""), err)
+	assert.Equal(t, 0, srv.Count(""))
+}
+
+// TestDrivingV2_Points 测试路线坐标解析及长度计算
+func TestDrivingV2_Points(t *testing.T) {
+	// 1. 创建假服务器和Client
+	client, _ := newFakeClient(t)
+
+	// 2. 执行请求
+	resp, err := client.DrivingV2(&drivingV2.DrivingRequestV2{Origin: amapType.LngLat{Lng: 116.351147, Lat: 39.936871}, Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113}})
+	require.NoError(t, err)
+
+	// 3. 验证结果
+	points, err := resp.Route.Paths[0].Points()
+	require.NoError(t, err)
+	assert.Len(t, points, 3)
+	assert.Greater(t, geo.Length(points), 5000.0)
+}
+
+func TestToGeoJSON(t *testing.T) {
+	// 1. 创建假服务器和Client
+	client, _ := newFakeClient(t)
+
+	// 2. 导出路线，转换为 WGS-84
+	route, err := client.DrivingV2(&drivingV2.DrivingRequestV2{Origin: amapType.LngLat{Lng: 116.351147, Lat: 39.936871}, Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113}})
+	require.NoError(t, err)
+	routes, err := route.ToGeoJSON(geojson.WithWGS84())
+	require.NoError(t, err)
+	require.Len(t, routes.Features, 1)
+	assert.Equal(t, geojson.TypeLineString, routes.Features[0].Geometry.Type)
+	assert.Equal(t, 0, routes.Features[0].Properties["index"])
+	assert.NotNil(t, routes.Features[0].Properties["distance"])
+
+	// 3. 导出POI
+	pois, err := client.PlaceV5Text(&placev5text.TextSearchRequest{Keyword: "测试"})
+	require.NoError(t, err)
+	poiFeatures, err := pois.ToGeoJSON()
+	require.NoError(t, err)
+	require.Len(t, poiFeatures.Features, 1)
+	assert.Equal(t, []float64{116.397428, 39.90923}, poiFeatures.Features[0].Geometry.Coordinates)
+	assert.EqualValues(t, "B000A83M61", poiFeatures.Features[0].Properties["id"])
+
+	// 4. 导出路况，按状态着色
+	traffic, err := client.LineTrafficStatus(&line.LineTrafficRequest{Path: amapType.LngLatList{{Lng: 116.481028, Lat: 39.989643}, {Lng: 116.489028, Lat: 39.999643}}})
+	require.NoError(t, err)
+	roads, err := traffic.ToGeoJSON()
+	require.NoError(t, err)
+	require.Len(t, roads.Features, 2)
+	assert.Equal(t, geojson.TrafficColor(geojson.TrafficSmooth), roads.Features[0].Properties["stroke"])
+
+	// 5. 未返回边界的行政区导出为中心点
+	districts, err := client.District(&district.DistrictRequest{Keywords: "北京"})
+	require.NoError(t, err)
+	districtFeatures, err := districts.ToGeoJSON()
+	require.NoError(t, err)
+	assert.Equal(t, geojson.TypePoint, districtFeatures.Features[0].Geometry.Type)
+}
+
+func TestPlace_UnifiedPOI(t *testing.T) {
+	// 1. 创建假服务器，v3 周边搜索返回完整字段
+	client, srv := newFakeClient(t)
+	srv.SetFixture(amaptest.PathPlaceAround, `{"status":"1","info":"OK","infocode":"10000","count":"1","pois":[{
+		"id":"B0FFG1","name":"测试餐厅","type":"餐饮服务","typecode":"050000","location":"116.397428,39.90923",
+		"entrancelocation":"116.3975,39.9093","distance":"120","indoor_map":"1",
+		"indoor_data":{"cpid":"B0FFH1","floor":"2","truefloor":"F2"},
+		"biz_ext":{"rating":"4.5","cost":"88.00","opentime":"10:00-22:00"},
+		"photos":[{"title":"门脸","url":"https://example.com/1.jpg"}],
+		"children":[{"id":"B0FFG2","name":"测试餐厅(东门)","location":"116.3976,39.9094"}]}]}`)
+
+	// 2. 同一个函数处理 v3、v5 接口的结果
+	names := func(pois []place.POI) []string {
+		var result []string
+		for _, poi := range pois {
+			result = append(result, poi.Name)
+		}
+		return result
+	}
+	around, err := client.PlaceV3Around(&placev3around.AroundSearchRequest{Location: amapType.LngLat{Lng: 116.397428, Lat: 39.90923}})
+	require.NoError(t, err)
+	v3POIs, err := around.POIs()
+	require.NoError(t, err)
+	text, err := client.PlaceV5Text(&placev5text.TextSearchRequest{Keyword: "测试"})
+	require.NoError(t, err)
+	v5POIs, err := text.POIs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"测试餐厅"}, names(v3POIs))
+	assert.Equal(t, []string{"测试POI"}, names(v5POIs))
+
+	// 3. 验证字段类型转换
+	poi := v3POIs[0]
+	assert.Equal(t, amapType.LngLat{Lng: 116.397428, Lat: 39.90923}, poi.Location)
+	assert.Equal(t, amapType.LngLat{Lng: 116.3975, Lat: 39.9093}, poi.Entrance)
+	assert.Equal(t, 4.5, poi.Rating)
+	assert.Equal(t, 88.0, poi.Cost)
+	assert.Equal(t, 120.0, poi.Distance)
+	assert.Equal(t, "10:00-22:00", poi.OpenTime)
+	assert.True(t, poi.IndoorMap)
+	assert.Equal(t, "F2", poi.Indoor.TrueFloor)
+	assert.Equal(t, []place.Photo{{Title: "门脸", URL: "https://example.com/1.jpg"}}, poi.Photos)
+	require.Len(t, poi.Children, 1)
+	assert.Equal(t, "B0FFG2", poi.Children[0].ID)
+}
+
+// TestPlace_POIErrors 测试个别POI字段格式错误时不影响其他POI，以及逆地理编码POI的转换
+func TestPlace_POIErrors(t *testing.T) {
+	// 1. 创建假服务器：第二个POI坐标格式错误
+	client, srv := newFakeClient(t)
+	srv.SetFixture(amaptest.PathPlaceText, `{"status":"1","info":"OK","infocode":"10000","count":"3","pois":[
+		{"id":"B0FFG1","name":"正常POI","location":"116.397428,39.90923","biz_ext":{"rating":"4.5"}},
+		{"id":"B0FFG2","name":"坐标错误","location":"116.39,abc","tel":"010-1234"},
+		{"id":"B0FFG3","name":"正常POI2","location":"116.3975,39.9093"}]}`)
+	srv.SetFixture(amaptest.PathRegeocode, `{"status":"1","info":"OK","infocode":"10000","regeocode":{
+		"formatted_address":"北京市东城区天安门","addressComponent":{},
+		"pois":[{"id":"B000A60DA1","name":"天安门","type":"风景名胜","tel":[],"distance":"12.5","direction":"北",
+			"address":"长安街","location":"116.397428,39.90923","businessarea":"天安门"}]}}`)
+
+	// 2. 转换 POI 列表：结果与原列表一一对应，错误按序号汇总
+	resp, err := client.PlaceV3Text(&placev3text.TextSearchRequest{Keyword: "测试"})
+	require.NoError(t, err)
+	pois, err := resp.POIs()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "第 1 个POI（B0FFG2）")
+	var parseErr amapErr.ParseError
+	assert.ErrorAs(t, err, &parseErr)
+	require.Len(t, pois, 3)
+	assert.Equal(t, 4.5, pois[0].Rating)
+	assert.Equal(t, "坐标错误", pois[1].Name)
+	assert.Equal(t, "010-1234", pois[1].Tel)
+	assert.True(t, pois[1].Location.IsZero())
+	assert.Equal(t, amapType.LngLat{Lng: 116.3975, Lat: 39.9093}, pois[2].Location)
+
+	// 3. v3、v5 各接口的 PoiItem 为同一类型
+	var item placev5text.PoiItem = resp.Pois[0]
+	assert.EqualValues(t, "B0FFG1", item.ID)
+
+	// 4. 逆地理编码的POI转换为统一的 place.POI
+	regeo, err := client.ReGeocode(&reGeoCode.ReGeocodeRequest{Location: amapType.LngLat{Lng: 116.397428, Lat: 39.90923}, Extensions: "all"})
+	require.NoError(t, err)
+	regeoPOIs, err := regeo.ReGeocode.POIs()
+	require.NoError(t, err)
+	require.Len(t, regeoPOIs, 1)
+	assert.Equal(t, place.POI{
+		ID:           "B000A60DA1",
+		Name:         "天安门",
+		Type:         "风景名胜",
+		Address:      "长安街",
+		Location:     amapType.LngLat{Lng: 116.397428, Lat: 39.90923},
+		BusinessArea: "天安门",
+		Distance:     12.5,
+		Direction:    "北",
+	}, regeoPOIs[0])
+}
+
+// TestPlaceV5Text_ShowFields 测试 v5 请求参数（show_fields、region、city_limit、分页）及扩展信息的解析
+func TestPlaceV5Text_ShowFields(t *testing.T) {
+	// 1. 创建假服务器，返回 business/navi/indoor 扩展信息
+	client, srv := newFakeClient(t)
+	srv.SetFixture(amaptest.PathPlaceV5Text, `{"status":"1","info":"OK","infocode":"10000","count":"1","pois":[{
+		"id":"B0FFG1","name":"测试餐厅","typecode":"050000","location":"116.397428,39.90923",
+		"business":{"business_area":"王府井","opentime_week":"周一至周日 10:00-22:00","tel":"010-12345678","rating":"4.6","cost":"120.00","parking_type":"地下"},
+		"navi":{"navi_poiid":"H51F1","entr_location":"116.3975,39.9093","gridcode":"5916"},
+		"indoor":{"indoor_map":"1","cpid":"B0FFH1","floor":"1","truefloor":"F1"},
+		"photos":[{"title":"门脸","url":"https://example.com/1.jpg"}]}]}`)
+
+	// 2. 发起请求
+	resp, err := client.PlaceV5Text(&placev5text.TextSearchRequest{
+		Keyword:    "餐厅",
+		Region:     "110000",
+		CityLimit:  true,
+		ShowFields: place.ShowFields{place.ShowFieldBusiness, place.ShowFieldNavi, place.ShowFieldIndoor},
+		PageSize:   20,
+		PageNum:    2,
+	})
+	require.NoError(t, err)
+
+	// 3. 验证请求参数
+	params := srv.Requests()[0].Params
+	assert.Equal(t, "餐厅", params.Get("keywords"))
+	assert.Equal(t, "110000", params.Get("region"))
+	assert.Equal(t, "true", params.Get("city_limit"))
+	assert.Equal(t, "business,navi,indoor", params.Get("show_fields"))
+	assert.Equal(t, "20", params.Get("page_size"))
+	assert.Equal(t, "2", params.Get("page_num"))
+
+	// 4. 验证扩展信息的解析及统一 POI 的转换
+	require.Len(t, resp.Pois, 1)
+	item := resp.Pois[0]
+	require.NotNil(t, item.Business)
+	assert.EqualValues(t, "地下", item.Business.ParkingType)
+	assert.EqualValues(t, "5916", item.Navi.GridCode)
+	assert.EqualValues(t, "F1", item.Indoor.TrueFloor)
+	poi, err := item.POI()
+	require.NoError(t, err)
+	assert.Equal(t, 4.6, poi.Rating)
+	assert.Equal(t, 120.0, poi.Cost)
+	assert.Equal(t, "王府井", poi.BusinessArea)
+	assert.Equal(t, "010-12345678", poi.Tel)
+	assert.Equal(t, amapType.LngLat{Lng: 116.3975, Lat: 39.9093}, poi.Entrance)
+	assert.True(t, poi.IndoorMap)
+	assert.Equal(t, "F1", poi.Indoor.TrueFloor)
+
+	// 5. keywords 和 types 不能同时为空
+	_, err = client.PlaceV5Text(&placev5text.TextSearchRequest{Region: "北京"})
+	assert.IsType(t, amapErr.InvalidConfigError(""), err)
+}
+
+// TestReGeocode_FlexFields 测试高德以 [] 表示空字符串、以数字表示字符串字段时仍能正常解析
+func TestReGeocode_FlexFields(t *testing.T) {
+	// 1. 创建假服务器，返回直辖市的逆地理编码（city、township 为 []，distance 为数字）
+	client, srv := newFakeClient(t)
+	srv.SetFixture(amaptest.PathRegeocode, `{"status":"1","info":"OK","infocode":"10000","regeocode":{
+		"formatted_address":"北京市东城区东华门街道天安门",
+		"addressComponent":{"country":"中国","province":"北京市","city":[],"citycode":"010","district":"东城区",
+			"adcode":"110101","township":[],"towncode":[],"seaArea":[]},
+		"pois":[{"id":"B000A60DA1","name":"天安门","tel":[],"distance":12.5,"location":"116.397428,39.90923"}]}}`)
+
+	// 2. 发起请求
+	resp, err := client.ReGeocode(&reGeoCode.ReGeocodeRequest{Location: amapType.LngLat{Lng: 116.397428, Lat: 39.90923}, Extensions: "all"})
+
+	// 3. 验证结果：[] 解析为空值，数字解析为字符串
+	require.NoError(t, err)
+	component := resp.ReGeocode.AddressComponent
+	assert.Empty(t, component.City)
+	assert.Empty(t, component.Township)
+	assert.Equal(t, amapType.FlexString("东城区"), component.District)
+	require.Len(t, resp.ReGeocode.Pois, 1)
+	assert.Empty(t, resp.ReGeocode.Pois[0].Tel)
+	distance, err := resp.ReGeocode.Pois[0].Distance.Float64()
+	require.NoError(t, err)
+	assert.Equal(t, 12.5, distance)
+}
+
+// TestDirection_Plans 测试路线的数值访问方法及跨出行方式的统一排序
+func TestDirection_Plans(t *testing.T) {
+	// 1. 所有路径规划响应都实现 direction.Route
+	var _ = []direction.Route{
+		&walking.WalkingResponse{}, &driving.DrivingResponse{}, &bicycling.BicyclingResponse{}, &busV1.BusResponse{},
+		&walkingV2.WalkingResponseV2{}, &drivingV2.DrivingResponseV2{}, &bicyclingV2.BicyclingResponseV2{},
+		&busV2.BusResponseV2{}, &electricV2.ElectricResponseV2{}, &etdDrivingV4.ETDDrivingResponseV4{},
+	}
+
+	// 2. 创建假服务器：驾车两个方案，步行一个方案
+	client, srv := newFakeClient(t)
+	srv.SetFixture(amaptest.PathDrivingV2, `{"status":"1","info":"OK","infocode":"10000","route":{"paths":[
+		{"distance":"12500","duration":"1800","tolls":"15.5","toll_distance":"8000","traffic_light":"12",
+			"steps":[{"distance":"500","duration":"60","tolls":"0","traffic_light":1}]},
+		{"distance":11000,"duration":2400,"tolls":[],"traffic_light":"20"}]}}`)
+	srv.SetFixture(amaptest.PathWalkingV2, `{"status":"1","info":"OK","infocode":"10000","route":{"paths":[
+		{"distance":"3000","duration":"2100.5"}]}}`)
+	driveResp, err := client.DrivingV2(&drivingV2.DrivingRequestV2{Origin: amapType.LngLat{Lng: 116.351147, Lat: 39.936871}, Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113}})
+	require.NoError(t, err)
+	walkResp, err := client.WalkingV2(&walkingV2.WalkingRequestV2{Origin: amapType.LngLat{Lng: 116.351147, Lat: 39.936871}, Destination: amapType.LngLat{Lng: 116.410001, Lat: 39.910113}})
+	require.NoError(t, err)
+
+	// 3. 验证数值访问方法
+	metrics := driveResp.Route.Paths[0].Metrics()
+	distance, err := metrics.DistanceMeters()
+	require.NoError(t, err)
+	assert.Equal(t, 12500.0, distance)
+	duration, err := metrics.TravelTime()
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, duration)
+	tolls, err := metrics.TollsFen()
+	require.NoError(t, err)
+	assert.Equal(t, int64(1550), tolls)
+	tollDistance, err := metrics.TollDistanceMeters()
+	require.NoError(t, err)
+	assert.Equal(t, 8000.0, tollDistance)
+	lights, err := metrics.TrafficLightCount()
+	require.NoError(t, err)
+	assert.Equal(t, 12, lights)
+	lights, err = driveResp.Route.Paths[0].Steps[0].Metrics().TrafficLightCount()
+	require.NoError(t, err)
+	assert.Equal(t, 1, lights)
+	cost, err := driveResp.Route.Paths[1].Metrics().CostFen()
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), cost)
+
+	// 4. 跨出行方式按耗时排序
+	var plans []direction.Plan
+	durations := make(map[direction.Plan]time.Duration)
+	for _, route := range []direction.Route{driveResp, walkResp} {
+		for _, plan := range route.Plans() {
+			duration, err := plan.Metrics().TravelTime()
+			require.NoError(t, err)
+			plans = append(plans, plan)
+			durations[plan] = duration
+		}
+	}
+	require.Len(t, plans, 3)
+	sort.Slice(plans, func(i, j int) bool { return durations[plans[i]] < durations[plans[j]] })
+	assert.Equal(t, amapType.FlexString("12500"), plans[0].Metrics().Distance)
+	assert.Equal(t, amapType.FlexString("3000"), plans[1].Metrics().Distance)
+	assert.Equal(t, 2100500*time.Millisecond, durations[plans[1]])
+	assert.Equal(t, amapType.FlexString("11000"), plans[2].Metrics().Distance)
+	assert.IsType(t, &drivingV2.PathV2{}, plans[0])
+
+	// 5. 字段格式错误时访问方法返回 ParseError，而不是按 0 处理
+	_, err = direction.Metrics{Duration: "30min"}.TravelTime()
+	var parseErr amapErr.ParseError
+	assert.ErrorAs(t, err, &parseErr)
+	_, err = direction.Metrics{Tolls: "免费"}.CostFen()
+	assert.ErrorAs(t, err, &parseErr)
+}
+
+// TestDirection_ParseNumbers 测试路线数值字段的带错误解析：金额按十进制换算为分，格式错误时返回 ParseError
+func TestDirection_ParseNumbers(t *testing.T) {
+	// 1. 金额换算为分（分以下四舍五入）
+	for text, expected := range map[amapType.FlexString]int64{
+		"": 0, "0": 0, "15.5": 1550, "0.1": 10, "4.0": 400, "12.345": 1235, "-2.5": -250, "1000": 100000,
+	} {
+		fen, err := direction.ParseFen(text)
+		require.NoError(t, err, text)
+		assert.Equal(t, expected, fen, text)
+	}
+
+	// 2. 格式错误返回错误
+	for _, text := range []amapType.FlexString{"abc", "1.2x", "1,5"} {
+		_, err := direction.ParseFen(text)
+		var parseErr amapErr.ParseError
+		assert.ErrorAs(t, err, &parseErr, text)
+	}
+	_, err := direction.ParseMeters("12km")
+	assert.Error(t, err)
+	_, err = direction.ParseSeconds("1h")
+	assert.Error(t, err)
+	_, err = direction.ParseCount("many")
+	assert.Error(t, err)
+
+	// 3. 合法值正常解析
+	seconds, err := direction.ParseSeconds("90")
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Second, seconds)
+	count, err := direction.ParseCount("12")
+	require.NoError(t, err)
+	assert.Equal(t, 12, count)
+}
+
+// TestDirectionV5 测试路径规划2.0：show_fields 参数、多方案和 v5 公交响应结构
+func TestDirectionV5(t *testing.T) {
+	// 1. 创建假服务器和Client（使用内置的 v5 响应）
+	client, srv := newFakeClient(t)
+	origin, destination := amapType.LngLat{Lng: 116.351147, Lat: 39.936871}, amapType.LngLat{Lng: 116.410001, Lat: 39.910113}
+
+	// 2. 驾车：验证请求参数（坐标格式化为高德字符串）和备选方案
+	driveResp, err := client.DrivingV5(&drivingV5.DrivingRequestV5{
+		Origin:      origin,
+		Destination: destination,
+		Waypoints:   amapType.LngLatList{{Lng: 116.38, Lat: 39.92}, {Lng: 116.39, Lat: 39.915}},
+		AvoidPolygons: []amapType.LngLatList{
+			{{Lng: 116.36, Lat: 39.93}, {Lng: 116.37, Lat: 39.93}, {Lng: 116.37, Lat: 39.92}},
+			{{Lng: 116.40, Lat: 39.91}, {Lng: 116.41, Lat: 39.91}, {Lng: 116.41, Lat: 39.90}},
+		},
+		ShowFields: direction.ShowFields{direction.ShowFieldCost, direction.ShowFieldTmcs, direction.ShowFieldPolyline},
+	})
+	require.NoError(t, err)
+	params := srv.Requests()[0].Params
+	assert.Equal(t, "cost,tmcs,polyline", params.Get("show_fields"))
+	assert.Equal(t, "116.351147,39.936871", params.Get("origin"))
+	assert.Equal(t, "116.410001,39.910113", params.Get("destination"))
+	assert.Equal(t, "116.38,39.92;116.39,39.915", params.Get("waypoints"))
+	assert.Equal(t, "116.36,39.93;116.37,39.93;116.37,39.92|116.4,39.91;116.41,39.91;116.41,39.9", params.Get("avoidpolygons"))
+	require.Len(t, driveResp.Plans(), 2)
+	taxiCost, err := driveResp.Route.Metrics().TaxiCostFen()
+	require.NoError(t, err)
+	assert.Equal(t, int64(2800), taxiCost)
+	first, second := driveResp.Route.Paths[0], driveResp.Route.Paths[1]
+	distance, err := first.Metrics().DistanceMeters()
+	require.NoError(t, err)
+	assert.Equal(t, 8012.0, distance)
+	duration, err := first.Metrics().TravelTime()
+	require.NoError(t, err)
+	assert.Equal(t, 21*time.Minute, duration)
+	lights, err := first.Metrics().TrafficLightCount()
+	require.NoError(t, err)
+	assert.Equal(t, 12, lights)
+	tolls, err := second.Metrics().TollsFen()
+	require.NoError(t, err)
+	assert.Equal(t, int64(500), tolls)
+	assert.EqualValues(t, "京开高速", second.Cost.TollRoad)
+	assert.EqualValues(t, "畅通", first.Steps[0].Tmcs[0].TmcStatus)
+	assert.EqualValues(t, "西城区", first.Steps[0].Cities[0].Districts[0].Name)
+	points, err := first.Points()
+	require.NoError(t, err)
+	assert.Len(t, points, 3)
+
+	// 3. 坐标缺失或超出范围时不发出请求
+	_, err = client.DrivingV5(&drivingV5.DrivingRequestV5{Destination: destination})
+	assert.IsType(t, amapErr.InvalidConfigError(""), err)
+	_, err = client.DrivingV5(&drivingV5.DrivingRequestV5{Origin: origin, Destination: destination, Waypoints: amapType.LngLatList{{Lng: 200, Lat: 39.9}}})
+	assert.IsType(t, amapErr.InvalidConfigError(""), err)
+	assert.Equal(t, 1, srv.Count(amaptest.PathDrivingV5))
+
+	// 4. 公交：验证必填城市和 v5 分段结构
+	_, err = client.TransitV5(&transitV5.TransitRequestV5{Origin: origin, Destination: destination})
+	assert.Error(t, err)
+	transitResp, err := client.TransitV5(&transitV5.TransitRequestV5{
+		Origin:           origin,
+		Destination:      destination,
+		City1:            "010",
+		City2:            "010",
+		AlternativeRoute: 3,
+		ShowFields:       direction.ShowFields{direction.ShowFieldCost, direction.ShowFieldPolyline},
+	})
+	require.NoError(t, err)
+	params = srv.Requests()[1].Params
+	assert.Equal(t, "3", params.Get("AlternativeRoute"))
+	assert.Equal(t, "010", params.Get("city2"))
+	assert.Equal(t, 1, srv.Count(amaptest.PathTransitV5))
+	require.Len(t, transitResp.Route.Transits, 1)
+	transit := transitResp.Route.Transits[0]
+	duration, err = transit.Metrics().TravelTime()
+	require.NoError(t, err)
+	assert.Equal(t, 32*time.Minute, duration)
+	fare, err := transit.Metrics().CostFen()
+	require.NoError(t, err)
+	assert.Equal(t, int64(400), fare)
+	walkingDistance, err := transit.Metrics().WalkingDistanceMeters()
+	require.NoError(t, err)
+	assert.Equal(t, 820.0, walkingDistance)
+	require.Len(t, transit.Segments, 3)
+	assert.NotNil(t, transit.Segments[0].Walking)
+	assert.Nil(t, transit.Segments[0].Bus)
+	busline := transit.Segments[1].Bus.Buslines[0]
+	assert.EqualValues(t, "车公庄", busline.DepartureStop.Name)
+	assert.EqualValues(t, "王府井", busline.ArrivalStop.Name)
+	assert.EqualValues(t, "1080", busline.Cost.Duration)
+	points, err = transit.Points()
+	require.NoError(t, err)
+	assert.Len(t, points, 5)
+	fc, err := transitResp.ToGeoJSON()
+	require.NoError(t, err)
+	assert.Len(t, fc.Features, 1)
 }
@@ -1,15 +1,37 @@
 package amap
 
-import "time"
+import (
+	"net/http"
+	"time"
+
+	"github.com/enneket/amap/cache"
+)
 
 // Config 高德 API 全局配置
 type Config struct {
-	Key         string        // 高德 API Key（必填）
+	Key         string        // 高德 API Key（必填，配置了 Keys 时可为空）
 	SecurityKey string        // 安全密钥（可选，用于签名）
 	Timeout     time.Duration // 请求超时（默认 5s）
-	Proxy       string        // HTTP 代理地址（可选）
+	Proxy       string        // HTTP 代理地址（可选，配置了 Transport 时不生效）
 	UserAgent   string        // 请求 UA（默认 amap-go/1.0）
-	BaseURL     string        // API 根路径（可选，用于测试）
+	BaseURL     string        // API 根路径（默认 DefaultBaseURL，可指向代理或测试服务器）
+	Retry       *RetryPolicy  // 重试策略（可选，nil 表示不重试）
+
+	ServiceBaseURLs map[string]string // 按服务族覆盖 API 根路径（可选，key 为 ServiceGeocode 等，未配置的服务使用 BaseURL）
+
+	Keys         []KeyPair    // 额外的 Key 池（可选，与 Key 合并，Key 无效或配额耗尽时自动切换）
+	KeySelection KeySelection // Key 选择策略（默认轮询）
+
+	RateLimits map[string]RateLimit // 按服务族的客户端限流（可选，key 为 ServiceGeocode 等，ServiceDefault 作用于其余服务）
+
+	Cache     cache.Cache              // 响应缓存（可选，如 cache.NewMemoryCache、cache.NewFileCache）
+	CacheTTLs map[string]time.Duration // 按服务族的缓存时间（未配置的服务不缓存，可使用 DefaultCacheTTLs）
+
+	Middlewares []Middleware // 请求中间件（可选，按顺序由外到内包裹每次 HTTP 请求）
+
+	Transport http.RoundTripper // 自定义 HTTP 传输层（可选，如 recorder.New 录制/回放请求）
+
+	BatchConcurrency int // 批量接口（如 GeoCodeBatch）的最大并发请求数（默认 4）
 }
 
 // NewConfig 创建默认配置（只需传入必填的 Key）
@@ -18,6 +40,6 @@ func NewConfig(key string) *Config {
 		Key:       key,
 		Timeout:   5 * time.Second,
 		UserAgent: "amap-go/1.0",
-		BaseURL:   "https://restapi.amap.com",
+		BaseURL:   DefaultBaseURL,
 	}
 }
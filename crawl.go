@@ -0,0 +1,216 @@
+package amap
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/enneket/amap/api/place"
+	placev5polygon "github.com/enneket/amap/api/place/v5/polygon"
+	amapErr "github.com/enneket/amap/errors"
+	"github.com/enneket/amap/geo"
+	amapType "github.com/enneket/amap/types"
+)
+
+const (
+	// DefaultCrawlCap 单个网格触发细分的结果数（高德POI搜索单次查询最多返回约 200 条）
+	DefaultCrawlCap = 200
+	// DefaultCrawlMinCellSize 网格最小边长（度，约 100 米），达到后不再细分
+	DefaultCrawlMinCellSize = 0.001
+)
+
+// CrawlRequest POI网格遍历请求：基于 PlaceV5Polygon 对区域内的POI进行完整枚举
+type CrawlRequest struct {
+	Keyword     string              // 搜索关键词（必填）
+	Types       string              // POI类型（可选，多个类型用|分隔）
+	ShowFields  place.ShowFields    // 返回的扩展信息（可选）
+	Bounds      amapType.Bounds     // 矩形区域（与 Polygon 二选一）
+	Polygon     amapType.LngLatList // 多边形区域（与 Bounds 二选一，只保留多边形内的POI）
+	Cap         int                 // 单个网格结果数达到该值时细分为 4 个子网格（可选，默认 200）
+	MinCellSize float64             // 网格最小边长（度，可选，默认 0.001）
+	Checkpoint  string              // 断点文件路径（可选，每完成一个网格或网格出错时保存一次，存在时从断点继续）
+}
+
+// CrawlResult POI网格遍历结果
+type CrawlResult struct {
+	POIs      []placev5polygon.PoiItem `json:"pois"`      // 去重后的POI（按首次发现的顺序）
+	Requests  int                      `json:"requests"`  // 已消耗的请求次数（含失败网格及断点之前的请求，即已用配额）
+	Cells     int                      `json:"cells"`     // 已完成的网格数
+	Splits    int                      `json:"splits"`    // 细分次数
+	Truncated int                      `json:"truncated"` // 达到最小边长仍满额的网格数（这些网格的结果可能不完整）
+}
+
+// crawlCheckpoint 断点文件内容
+type crawlCheckpoint struct {
+	Fingerprint string            `json:"fingerprint"` // 请求指纹，防止用不同的请求继续断点
+	Pending     []amapType.Bounds `json:"pending"`     // 待处理的网格（栈，末尾优先）
+	CrawlResult
+}
+
+// CrawlPOIs 网格遍历区域内的POI：网格结果数达到 Cap 时递归细分为 4 个子网格，合并并按 ID 去重；
+// 多边形区域跳过不相交的网格；设置 Checkpoint 时可在中断（错误、ctx 取消）后以相同请求继续。
+// 出错时返回已完成部分的结果和错误
+func (c *Client) CrawlPOIs(ctx context.Context, req *CrawlRequest) (*CrawlResult, error) {
+	// 1. 校验参数，加载断点
+	region, area, err := req.region()
+	if err != nil {
+		return nil, err
+	}
+	state := &crawlCheckpoint{Fingerprint: req.fingerprint(), Pending: []amapType.Bounds{region}}
+	if req.Checkpoint != "" {
+		if err := state.load(req.Checkpoint); err != nil {
+			return nil, err
+		}
+	}
+	seen := make(map[string]bool, len(state.POIs))
+	for _, poi := range state.POIs {
+		seen[poi.ID.String()] = true
+	}
+	capacity, minSize := req.Cap, req.MinCellSize
+	if capacity <= 0 {
+		capacity = DefaultCrawlCap
+	}
+	if minSize <= 0 {
+		minSize = DefaultCrawlMinCellSize
+	}
+
+	// 2. 逐个处理网格，完成后保存断点
+	for len(state.Pending) > 0 {
+		cell := state.Pending[len(state.Pending)-1]
+		var pois []placev5polygon.PoiItem
+		if area == nil || area.IntersectsBounds(cell) {
+			pois, err = c.crawlCell(ctx, req, cell, capacity, &state.Requests)
+			if err != nil {
+				// 网格保留在待处理列表中，但已消耗的请求次数计入断点
+				if req.Checkpoint != "" {
+					if saveErr := state.save(req.Checkpoint); saveErr != nil {
+						err = errors.Join(err, saveErr)
+					}
+				}
+				return &state.CrawlResult, err
+			}
+		}
+		state.Pending = state.Pending[:len(state.Pending)-1]
+		state.Cells++
+		if len(pois) >= capacity {
+			if cell.NorthEast.Lng-cell.SouthWest.Lng > minSize || cell.NorthEast.Lat-cell.SouthWest.Lat > minSize {
+				state.Pending = append(state.Pending, splitCell(cell)...)
+				state.Splits++
+			} else {
+				state.Truncated++
+			}
+		}
+		for _, poi := range pois {
+			if seen[poi.ID.String()] {
+				continue
+			}
+			if p, err := poi.LngLat(); area != nil && (err != nil || !area.Contains(p)) {
+				continue
+			}
+			seen[poi.ID.String()] = true
+			state.POIs = append(state.POIs, poi)
+		}
+		if req.Checkpoint != "" {
+			if err := state.save(req.Checkpoint); err != nil {
+				return &state.CrawlResult, err
+			}
+		}
+	}
+	return &state.CrawlResult, nil
+}
+
+// crawlCell 查询单个网格的POI（最多 capacity 条），累计请求次数
+func (c *Client) crawlCell(ctx context.Context, req *CrawlRequest, cell amapType.Bounds, capacity int, requests *int) ([]placev5polygon.PoiItem, error) {
+	fetch := c.placeV5PolygonPages(&placev5polygon.PolygonSearchRequest{
+		Keyword:    req.Keyword,
+		Types:      req.Types,
+		ShowFields: req.ShowFields,
+		Polygon:    amapType.LngLatList{cell.SouthWest, cell.NorthEast}, // 矩形：左下|右上
+	})
+	counted := func(ctx context.Context, page, size int) ([]placev5polygon.PoiItem, int, error) {
+		*requests++
+		return fetch(ctx, page, size)
+	}
+	return Collect(paginate(ctx, &PageOptions{MaxResults: capacity}, counted, placeV5PolygonID))
+}
+
+// splitCell 将网格等分为 4 个子网格
+func splitCell(b amapType.Bounds) []amapType.Bounds {
+	mid := b.Center()
+	return []amapType.Bounds{
+		{SouthWest: b.SouthWest, NorthEast: mid},
+		{SouthWest: amapType.LngLat{Lng: mid.Lng, Lat: b.SouthWest.Lat}, NorthEast: amapType.LngLat{Lng: b.NorthEast.Lng, Lat: mid.Lat}},
+		{SouthWest: amapType.LngLat{Lng: b.SouthWest.Lng, Lat: mid.Lat}, NorthEast: amapType.LngLat{Lng: mid.Lng, Lat: b.NorthEast.Lat}},
+		{SouthWest: mid, NorthEast: b.NorthEast},
+	}
+}
+
+// region 遍历的外包矩形及多边形区域（未设置 Polygon 时 area 为 nil）
+func (req *CrawlRequest) region() (amapType.Bounds, geo.MultiPolygon, error) {
+	if req.Keyword == "" && req.Types == "" {
+		return amapType.Bounds{}, nil, amapErr.NewInvalidConfigError("POI网格遍历：keyword和types不能同时为空")
+	}
+	if len(req.Polygon) > 0 {
+		if len(req.Polygon) < 3 {
+			return amapType.Bounds{}, nil, amapErr.NewInvalidConfigError("POI网格遍历：多边形至少需要 3 个坐标")
+		}
+		for _, p := range req.Polygon {
+			if err := p.Validate(); err != nil {
+				return amapType.Bounds{}, nil, err
+			}
+		}
+		return amapType.BoundsOf(req.Polygon), geo.MultiPolygon{req.Polygon}, nil
+	}
+	if req.Bounds == (amapType.Bounds{}) {
+		return amapType.Bounds{}, nil, amapErr.NewInvalidConfigError("POI网格遍历：bounds和polygon不能同时为空")
+	}
+	if err := req.Bounds.Validate(); err != nil {
+		return amapType.Bounds{}, nil, err
+	}
+	return req.Bounds, nil, nil
+}
+
+// fingerprint 请求指纹（不含断点路径）
+func (req *CrawlRequest) fingerprint() string {
+	return fmt.Sprintf("%s|%s|%s|%s|%s|%d|%g", req.Keyword, req.Types, req.ShowFields, req.Bounds, req.Polygon, req.Cap, req.MinCellSize)
+}
+
+// load 加载断点文件（不存在时保持初始状态）
+func (cp *crawlCheckpoint) load(file string) error {
+	data, err := os.ReadFile(file)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var saved crawlCheckpoint
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return amapErr.NewParseError(fmt.Sprintf("POI网格遍历：断点文件格式错误：%v", err))
+	}
+	if saved.Fingerprint != cp.Fingerprint {
+		return amapErr.NewInvalidConfigError("POI网格遍历：断点文件与当前请求不一致：" + file)
+	}
+	*cp = saved
+	return nil
+}
+
+// save 保存断点文件（先写临时文件再重命名，避免中断时损坏）
+func (cp *crawlCheckpoint) save(file string) error {
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return err
+	}
+	tmp := file + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, file)
+}
@@ -0,0 +1,119 @@
+package amap
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/enneket/amap/amaptest"
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// crawlFixture 在 116.0~116.4,39.8~40.0 范围内按 20x20 网格生成 400 个POI，按请求的矩形过滤并分页返回
+func crawlFixture(params url.Values) string {
+	bounds, err := amapType.ParseBounds(strings.ReplaceAll(params.Get("polygon"), "|", ";"))
+	if err != nil {
+		return `{"status":"0","info":"INVALID_PARAMS","infocode":"20000"}`
+	}
+	var matched []string
+	for i := 0; i < 20; i++ {
+		for j := 0; j < 20; j++ {
+			p := amapType.LngLat{Lng: 116.005 + float64(i)*0.02, Lat: 39.8025 + float64(j)*0.01}
+			if bounds.Contains(p) {
+				matched = append(matched, fmt.Sprintf(`{"id":"B%02d%02d","name":"POI","location":"%s"}`, i, j, p))
+			}
+		}
+	}
+	page, size := pageParams(params)
+	start, end := min((page-1)*size, len(matched)), min(page*size, len(matched))
+	return fmt.Sprintf(`{"status":"1","info":"OK","infocode":"10000","count":"%d","pois":[%s]}`, len(matched), strings.Join(matched[start:end], ","))
+}
+
+// newCrawlRequest 覆盖整个测试区域、每个网格最多 60 个结果的遍历请求
+func newCrawlRequest(checkpoint string) *CrawlRequest {
+	bounds, _ := amapType.ParseBounds("116,39.8;116.4,40")
+	return &CrawlRequest{Keyword: "餐厅", Bounds: bounds, Cap: 60, Checkpoint: checkpoint}
+}
+
+// TestCrawlPOIs_Split 测试网格结果满额时细分，合并去重后得到全部POI
+func TestCrawlPOIs_Split(t *testing.T) {
+	// 1. 创建假服务器和Client
+	client, srv := newFakeClient(t)
+	srv.SetFixtureFunc(amaptest.PathPlaceV5Polygon, crawlFixture)
+
+	// 2. 执行遍历
+	result, err := client.CrawlPOIs(context.Background(), newCrawlRequest(""))
+
+	// 3. 验证结果：全部 400 个POI，请求次数与服务端一致
+	require.NoError(t, err)
+	assert.Len(t, result.POIs, 400)
+	assert.Greater(t, result.Splits, 0)
+	assert.Equal(t, 0, result.Truncated)
+	assert.Equal(t, srv.Count(amaptest.PathPlaceV5Polygon), result.Requests)
+}
+
+// TestCrawlPOIs_Polygon 测试多边形区域只保留区域内的POI，并跳过不相交的网格
+func TestCrawlPOIs_Polygon(t *testing.T) {
+	client, srv := newFakeClient(t)
+	srv.SetFixtureFunc(amaptest.PathPlaceV5Polygon, crawlFixture)
+
+	// 三角形区域：左下、右下、左上
+	polygon, _ := amapType.ParseLngLatList("116,39.8;116.4,39.8;116,40", ";")
+	result, err := client.CrawlPOIs(context.Background(), &CrawlRequest{Keyword: "餐厅", Polygon: polygon, Cap: 60})
+
+	require.NoError(t, err)
+	assert.Greater(t, len(result.POIs), 150)
+	assert.Less(t, len(result.POIs), 250)
+	for _, poi := range result.POIs {
+		p, _ := poi.LngLat()
+		assert.LessOrEqual(t, (p.Lng-116)/0.4+(p.Lat-39.8)/0.2, 1.0, poi.ID)
+	}
+}
+
+// TestCrawlPOIs_Resume 测试中断后从断点继续，不重复已完成的请求
+func TestCrawlPOIs_Resume(t *testing.T) {
+	// 1. 第一次遍历在第 5 次请求时失败
+	client, srv := newFakeClient(t)
+	srv.SetFixtureFunc(amaptest.PathPlaceV5Polygon, crawlFixture)
+	checkpoint := filepath.Join(t.TempDir(), "crawl.json")
+	calls := 0
+	client.Use(func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*amapType.BaseResponse, error) {
+			calls++
+			if calls == 5 {
+				return nil, amapErr.NewAPIError(string(amapErr.InfoCodeDailyQueryOverLimit), "DAILY_QUERY_OVER_LIMIT")
+			}
+			return next(ctx, call)
+		}
+	})
+	partial, err := client.CrawlPOIs(context.Background(), newCrawlRequest(checkpoint))
+	require.True(t, amapErr.IsQuotaExceeded(err))
+	assert.Less(t, len(partial.POIs), 400)
+	assert.Equal(t, 5, partial.Requests)
+
+	// 2. 以相同请求继续（新的Client，不再注入错误）
+	resumed, resumedSrv := newFakeClient(t)
+	resumedSrv.SetFixtureFunc(amaptest.PathPlaceV5Polygon, crawlFixture)
+	result, err := resumed.CrawlPOIs(context.Background(), newCrawlRequest(checkpoint))
+	require.NoError(t, err)
+	assert.Len(t, result.POIs, 400)
+
+	// 3. 继续后只请求剩余的网格（含失败的网格），累计请求次数包含失败网格已消耗的请求
+	fresh, err := client.CrawlPOIs(context.Background(), newCrawlRequest(""))
+	require.NoError(t, err)
+	assert.Less(t, resumedSrv.Count(amaptest.PathPlaceV5Polygon), fresh.Requests)
+	assert.Equal(t, partial.Requests+resumedSrv.Count(amaptest.PathPlaceV5Polygon), result.Requests)
+	assert.GreaterOrEqual(t, result.Requests, fresh.Requests)
+
+	// 4. 不同的请求不能使用同一个断点文件
+	other := newCrawlRequest(checkpoint)
+	other.Keyword = "酒店"
+	_, err = resumed.CrawlPOIs(context.Background(), other)
+	assert.IsType(t, amapErr.InvalidConfigError(""), err)
+}
@@ -0,0 +1,180 @@
+package amap
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	amapErr "github.com/enneket/amap/errors"
+)
+
+// DefaultBaseURL 高德 Web 服务 API 的默认根路径
+const DefaultBaseURL = "https://restapi.amap.com"
+
+// 接口名称（Endpoints 的 Name，按 "服务族.接口" 命名）
+const (
+	EndpointGeocode          = "geocode.geo"
+	EndpointRegeocode        = "geocode.regeo"
+	EndpointWalking          = "direction.walking"
+	EndpointDriving          = "direction.driving"
+	EndpointBicycling        = "direction.bicycling"
+	EndpointTransit          = "direction.transit"
+	EndpointWalkingV2        = "direction.v2.walking"
+	EndpointDrivingV2        = "direction.v2.driving"
+	EndpointBicyclingV2      = "direction.v2.bicycling"
+	EndpointTransitV2        = "direction.v2.transit"
+	EndpointElectricV2       = "direction.v2.electric"
+	EndpointDrivingV5        = "direction.v5.driving"
+	EndpointWalkingV5        = "direction.v5.walking"
+	EndpointBicyclingV5      = "direction.v5.bicycling"
+	EndpointElectrobikeV5    = "direction.v5.electrobike"
+	EndpointTransitV5        = "direction.v5.transit"
+	EndpointETDDrivingV4     = "direction.v4.etd_driving"
+	EndpointDistance         = "distance"
+	EndpointDistrict         = "district"
+	EndpointTrafficIncident  = "traffic.incident"
+	EndpointTrafficRoad      = "traffic.road"
+	EndpointTrafficCircle    = "traffic.circle"
+	EndpointTrafficRectangle = "traffic.rectangle"
+	EndpointIP               = "ip"
+	EndpointIPV5             = "ip.v5"
+	EndpointConvert          = "convert"
+	EndpointGraspRoad        = "grasproad"
+	EndpointPlaceDetail      = "place.detail"
+	EndpointPlaceText        = "place.text"
+	EndpointPlaceAround      = "place.around"
+	EndpointPlacePolygon     = "place.polygon"
+	EndpointPlaceAOI         = "place.aoi"
+	EndpointPlaceV5Detail    = "place.v5.detail"
+	EndpointPlaceV5Text      = "place.v5.text"
+	EndpointPlaceV5Around    = "place.v5.around"
+	EndpointPlaceV5Polygon   = "place.v5.polygon"
+	EndpointPlaceV5AOI       = "place.v5.aoi"
+	EndpointInputtips        = "inputtips"
+	EndpointWeather          = "weather"
+	EndpointPosition         = "position"
+	EndpointPositionV5       = "position.v5"
+	EndpointBusLineName      = "bus.linename"
+	EndpointBusStationSearch = "bus.station_search"
+	EndpointBusLineID        = "bus.lineid"
+	EndpointBusLineSearch    = "bus.line_search"
+)
+
+// Endpoint 接口定义：Client 的各个方法按名称从接口表取请求方法和路径
+type Endpoint struct {
+	Name    string // 接口名称（如 EndpointGeocode）
+	Service string // 所属服务族（ServiceGeocode 等，决定限流、缓存和 Config.ServiceBaseURLs 的归属）
+	Method  string // 请求方法
+	Path    string // 相对路径（如 /v3/geocode/geo），发送时拼接在根路径之后
+	Version string // 高德接口版本（v3、v4、v5）
+}
+
+// endpointList 接口表
+var endpointList = []Endpoint{
+	{EndpointGeocode, ServiceGeocode, http.MethodGet, "/v3/geocode/geo", "v3"},
+	{EndpointRegeocode, ServiceGeocode, http.MethodGet, "/v3/geocode/regeo", "v3"},
+	{EndpointWalking, ServiceDirection, http.MethodGet, "/v3/direction/walking", "v3"},
+	{EndpointDriving, ServiceDirection, http.MethodGet, "/v3/direction/driving", "v3"},
+	{EndpointBicycling, ServiceDirection, http.MethodGet, "/v3/direction/bicycling", "v3"},
+	{EndpointTransit, ServiceDirection, http.MethodGet, "/v3/direction/transit/integrated", "v3"},
+	{EndpointWalkingV2, ServiceDirection, http.MethodGet, "/v3/direction/v2/walking", "v3"},
+	{EndpointDrivingV2, ServiceDirection, http.MethodGet, "/v3/direction/v2/driving", "v3"},
+	{EndpointBicyclingV2, ServiceDirection, http.MethodGet, "/v3/direction/v2/bicycling", "v3"},
+	{EndpointTransitV2, ServiceDirection, http.MethodGet, "/v3/direction/v2/transit/integrated", "v3"},
+	{EndpointElectricV2, ServiceDirection, http.MethodGet, "/v3/direction/v2/electric", "v3"},
+	{EndpointDrivingV5, ServiceDirection, http.MethodGet, "/v5/direction/driving", "v5"},
+	{EndpointWalkingV5, ServiceDirection, http.MethodGet, "/v5/direction/walking", "v5"},
+	{EndpointBicyclingV5, ServiceDirection, http.MethodGet, "/v5/direction/bicycling", "v5"},
+	{EndpointElectrobikeV5, ServiceDirection, http.MethodGet, "/v5/direction/electrobike", "v5"},
+	{EndpointTransitV5, ServiceDirection, http.MethodGet, "/v5/direction/transit/integrated", "v5"},
+	{EndpointETDDrivingV4, ServiceDirection, http.MethodGet, "/v4/etd/driving", "v4"},
+	{EndpointDistance, ServiceDistance, http.MethodGet, "/v3/direction/distance", "v3"},
+	{EndpointDistrict, ServiceDistrict, http.MethodGet, "/v3/config/district", "v3"},
+	{EndpointTrafficIncident, ServiceTraffic, http.MethodGet, "/v3/traffic/status", "v3"},
+	{EndpointTrafficRoad, ServiceTraffic, http.MethodGet, "/v3/traffic/status/road", "v3"},
+	{EndpointTrafficCircle, ServiceTraffic, http.MethodGet, "/v3/traffic/status/circle", "v3"},
+	{EndpointTrafficRectangle, ServiceTraffic, http.MethodGet, "/v3/traffic/status/rectangle", "v3"},
+	{EndpointIP, ServiceIP, http.MethodGet, "/v3/ip", "v3"},
+	{EndpointIPV5, ServiceIP, http.MethodGet, "/v5/ip", "v5"},
+	{EndpointConvert, ServiceConvert, http.MethodGet, "/v3/convert", "v3"},
+	{EndpointGraspRoad, ServiceGraspRoad, http.MethodGet, "/v3/grasproad", "v3"},
+	{EndpointPlaceDetail, ServicePlace, http.MethodGet, "/v3/place/detail", "v3"},
+	{EndpointPlaceText, ServicePlace, http.MethodGet, "/v3/place/text", "v3"},
+	{EndpointPlaceAround, ServicePlace, http.MethodGet, "/v3/place/around", "v3"},
+	{EndpointPlacePolygon, ServicePlace, http.MethodGet, "/v3/place/polygon", "v3"},
+	{EndpointPlaceAOI, ServicePlace, http.MethodGet, "/v3/place/aoi", "v3"},
+	{EndpointPlaceV5Detail, ServicePlace, http.MethodGet, "/v5/place/detail", "v5"},
+	{EndpointPlaceV5Text, ServicePlace, http.MethodGet, "/v5/place/text", "v5"},
+	{EndpointPlaceV5Around, ServicePlace, http.MethodGet, "/v5/place/around", "v5"},
+	{EndpointPlaceV5Polygon, ServicePlace, http.MethodGet, "/v5/place/polygon", "v5"},
+	{EndpointPlaceV5AOI, ServicePlace, http.MethodGet, "/v5/place/aoi", "v5"},
+	{EndpointInputtips, ServiceInputtips, http.MethodGet, "/v3/assistant/inputtips", "v3"},
+	{EndpointWeather, ServiceWeather, http.MethodGet, "/v3/weather/weatherInfo", "v3"},
+	{EndpointPosition, ServicePosition, http.MethodGet, "/v3/position/v1/hardware", "v3"},
+	{EndpointPositionV5, ServicePosition, http.MethodGet, "/v5/position/hardware", "v5"},
+	{EndpointBusLineName, ServiceBus, http.MethodGet, "/v3/bus/linename", "v3"},
+	{EndpointBusStationSearch, ServiceBus, http.MethodGet, "/v3/bus/station/search", "v3"},
+	{EndpointBusLineID, ServiceBus, http.MethodGet, "/v3/bus/lineid", "v3"},
+	{EndpointBusLineSearch, ServiceBus, http.MethodGet, "/v3/bus/line/search", "v3"},
+}
+
+// endpoints 接口名称 → 接口定义
+var endpoints = func() map[string]Endpoint {
+	m := make(map[string]Endpoint, len(endpointList))
+	for _, ep := range endpointList {
+		m[ep.Name] = ep
+	}
+	return m
+}()
+
+// Endpoints 返回全部接口定义（按名称排序）
+func Endpoints() []Endpoint {
+	list := append([]Endpoint(nil), endpointList...)
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// LookupEndpoint 按名称查找接口定义
+func LookupEndpoint(name string) (Endpoint, bool) {
+	ep, ok := endpoints[name]
+	return ep, ok
+}
+
+// baseURL 服务族的根路径：优先 Config.ServiceBaseURLs，其次 Config.BaseURL，均未配置时为空
+func (c *Client) baseURL(service string) string {
+	if base := c.config.ServiceBaseURLs[service]; base != "" {
+		return base
+	}
+	return c.config.BaseURL
+}
+
+// resolveURL 构建完整请求 URL：根路径 + path 中的路径部分
+// 未配置根路径时完整 URL 原样使用，相对路径使用 DefaultBaseURL
+func (c *Client) resolveURL(service, path string) string {
+	parsed, err := url.Parse(path)
+	if err != nil {
+		return path
+	}
+	base := c.baseURL(service)
+	if base == "" {
+		if parsed.IsAbs() {
+			return path
+		}
+		base = DefaultBaseURL
+	}
+	return strings.TrimRight(base, "/") + parsed.Path
+}
+
+// callEndpoint 按接口表发送请求（服务族、请求方法和路径取自接口定义）
+// name 不在接口表中但以 "/" 开头或为完整 URL 时，视为未登记接口的路径，以 GET 请求发送
+func (c *Client) callEndpoint(ctx context.Context, name string, params map[string]string, resp interface{}) error {
+	if ep, ok := endpoints[name]; ok {
+		return c.do(ctx, ep.Service, ep.Method, ep.Path, params, resp)
+	}
+	if strings.HasPrefix(name, "/") || strings.HasPrefix(name, "http://") || strings.HasPrefix(name, "https://") {
+		return c.DoRequestCtx(ctx, http.MethodGet, name, params, resp)
+	}
+	return amapErr.NewInvalidConfigError("未知的接口：" + name)
+}
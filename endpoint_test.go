@@ -0,0 +1,118 @@
+package amap
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/enneket/amap/amaptest"
+	geoCode "github.com/enneket/amap/api/geo_code"
+	placev5text "github.com/enneket/amap/api/place/v5/text"
+	amapType "github.com/enneket/amap/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEndpoints 测试接口表：名称唯一、服务族与路径一致，且假服务器覆盖全部接口路径
+func TestEndpoints(t *testing.T) {
+	// 1. 逐个校验接口定义
+	names := map[string]bool{}
+	var paths []string
+	for _, ep := range Endpoints() {
+		assert.False(t, names[ep.Name], "接口名称重复：%s", ep.Name)
+		names[ep.Name] = true
+		assert.Equal(t, http.MethodGet, ep.Method, ep.Name)
+		assert.Equal(t, ep.Service, ServiceOf(ep.Path), ep.Name)
+		assert.True(t, strings.HasPrefix(ep.Path, "/"+ep.Version+"/"), "%s 的路径与版本不一致：%s", ep.Name, ep.Path)
+		paths = append(paths, ep.Path)
+	}
+
+	// 2. 按名称查找
+	ep, ok := LookupEndpoint(EndpointPlaceV5Text)
+	require.True(t, ok)
+	assert.Equal(t, "/v5/place/text", ep.Path)
+	_, ok = LookupEndpoint("unknown")
+	assert.False(t, ok)
+
+	// 3. 假服务器的接口路径与接口表一致
+	sort.Strings(paths)
+	assert.Equal(t, amaptest.Paths(), paths)
+}
+
+// TestServiceBaseURLs 测试按服务族覆盖根路径：配置的服务发往覆盖的地址，其余服务使用 BaseURL
+func TestServiceBaseURLs(t *testing.T) {
+	// 1. 创建两个假服务器：默认服务器和 POI 搜索专用服务器
+	direct := amaptest.NewServer()
+	defer direct.Close()
+	proxy := amaptest.NewServer()
+	defer proxy.Close()
+	config := NewConfig("test_key")
+	config.BaseURL = direct.URL
+	config.ServiceBaseURLs = map[string]string{ServicePlace: proxy.URL}
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	// 2. 地理编码使用 BaseURL
+	_, err = client.GeoCode(&geoCode.GeocodeRequest{Address: "北京市朝阳区望京SOHO"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, direct.Count(amaptest.PathGeocode))
+
+	// 3. POI 搜索发往覆盖的根路径
+	_, err = client.PlaceV5Text(&placev5text.TextSearchRequest{Keyword: "北京大学"})
+	require.NoError(t, err)
+	assert.Equal(t, 0, direct.Count(amaptest.PathPlaceV5Text))
+	assert.Equal(t, 1, proxy.Count(amaptest.PathPlaceV5Text))
+	assert.Equal(t, 1, proxy.Count(""))
+}
+
+// TestResolveURL 测试请求地址的拼接规则
+func TestResolveURL(t *testing.T) {
+	// 1. 未配置根路径：完整 URL 原样使用，相对路径使用默认根路径
+	client := &Client{config: &Config{}}
+	assert.Equal(t, "https://example.com/v3/ip", client.resolveURL(ServiceIP, "https://example.com/v3/ip"))
+	assert.Equal(t, DefaultBaseURL+"/v3/ip", client.resolveURL(ServiceIP, "/v3/ip"))
+
+	// 2. 配置了根路径：替换完整 URL 的基础地址
+	client.config.BaseURL = "http://127.0.0.1:8080"
+	assert.Equal(t, "http://127.0.0.1:8080/v3/ip", client.resolveURL(ServiceIP, "https://restapi.amap.com/v3/ip"))
+	client.config.ServiceBaseURLs = map[string]string{ServiceIP: "http://cache.internal/amap/"}
+	assert.Equal(t, "http://cache.internal/amap/v3/ip", client.resolveURL(ServiceIP, "/v3/ip"))
+	assert.Equal(t, "http://127.0.0.1:8080/v3/weather/weatherInfo", client.resolveURL(ServiceWeather, "/v3/weather/weatherInfo"))
+}
+
+// TestCallEndpoint_Service 测试按接口表发送的请求使用 Endpoint.Service，而不是按路径重新识别
+func TestCallEndpoint_Service(t *testing.T) {
+	// 1. 登记一个服务族与路径不一致的接口
+	endpoints["test.geocode_as_place"] = Endpoint{"test.geocode_as_place", ServicePlace, http.MethodGet, amaptest.PathGeocode, "v3"}
+	t.Cleanup(func() { delete(endpoints, "test.geocode_as_place") })
+
+	// 2. POI 搜索服务族配置了单独的根路径，中间件记录服务族
+	direct := amaptest.NewServer()
+	defer direct.Close()
+	proxy := amaptest.NewServer()
+	defer proxy.Close()
+	config := NewConfig("test_key")
+	config.BaseURL = direct.URL
+	config.ServiceBaseURLs = map[string]string{ServicePlace: proxy.URL}
+	client, err := NewClient(config)
+	require.NoError(t, err)
+	var services []string
+	client.Use(func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*amapType.BaseResponse, error) {
+			services = append(services, call.Service)
+			return next(ctx, call)
+		}
+	})
+
+	// 3. 按接口表发送：服务族取自接口定义
+	var resp geoCode.GeoCodeResponse
+	require.NoError(t, client.callEndpoint(context.Background(), "test.geocode_as_place", map[string]string{"address": "北京"}, &resp))
+	assert.Equal(t, 1, proxy.Count(amaptest.PathGeocode))
+
+	// 4. 直接按路径发送：服务族由 ServiceOf 识别
+	require.NoError(t, client.DoRequestCtx(context.Background(), http.MethodGet, amaptest.PathGeocode, map[string]string{"address": "北京"}, &resp))
+	assert.Equal(t, 1, direct.Count(amaptest.PathGeocode))
+	assert.Equal(t, []string{ServicePlace, ServiceGeocode}, services)
+}
@@ -1,14 +1,22 @@
 package errors
 
-import "fmt"
+import (
+	"fmt"
+	"net/http"
+)
 
 // APIError 高德 API 原生错误（包含错误码和描述）
 type APIError struct {
-	Code string // 错误码（如 10001：Key 无效）
-	Info string // 错误描述
+	Code   string            // 错误码（如 10001：Key 无效）
+	Info   string            // 错误描述
+	Path   string            // 请求路径（如 /v3/geocode/geo，便于排查）
+	Params map[string]string // 请求参数（已去除 key、sig 等敏感参数）
 }
 
 func (e *APIError) Error() string {
+	if e.Path != "" {
+		return fmt.Sprintf("amap api error [code:%s]: %s (path: %s)", e.Code, e.Info, e.Path)
+	}
 	return fmt.Sprintf("amap api error [code:%s]: %s", e.Code, e.Info)
 }
 
@@ -26,3 +34,39 @@ func (e NetworkError) Error() string   { return "network err: " + string(e) }
 
 func NewParseError(msg string) error { return ParseError(msg) }
 func (e ParseError) Error() string   { return "parser err: " + string(e) }
+
+// CanceledError 请求被 context 取消或超过截止时间（可通过 errors.Is 判断 context.Canceled / context.DeadlineExceeded）
+type CanceledError struct {
+	Err error // 原始 context 错误
+}
+
+func NewCanceledError(err error) error { return &CanceledError{Err: err} }
+func (e *CanceledError) Error() string { return "request canceled: " + e.Err.Error() }
+func (e *CanceledError) Unwrap() error { return e.Err }
+
+// HTTPStatusError 非预期的 HTTP 状态码（如 429/503，通常由重试策略处理）
+type HTTPStatusError struct {
+	StatusCode int // HTTP 状态码
+}
+
+func NewHTTPStatusError(code int) error { return &HTTPStatusError{StatusCode: code} }
+func (e *HTTPStatusError) Error() string {
+	return fmt.Sprintf("http status err: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+}
+
+// RetryError 经重试策略处理后的最终错误（Attempts 为实际尝试次数，1 表示首次即失败且未重试）
+type RetryError struct {
+	Attempts int   // 实际尝试次数（含首次）
+	Err      error // 最后一次尝试的错误
+}
+
+func NewRetryError(attempts int, err error) error { return &RetryError{Attempts: attempts, Err: err} }
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("%s (after %d attempts)", e.Err.Error(), e.Attempts)
+}
+func (e *RetryError) Unwrap() error { return e.Err }
+
+type QuotaExceededError string // 客户端配额耗尽（本地限流器拦截，请求未发出）
+
+func NewQuotaExceededError(msg string) error { return QuotaExceededError(msg) }
+func (e QuotaExceededError) Error() string   { return "quota exceeded: " + string(e) }
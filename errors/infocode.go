@@ -0,0 +1,246 @@
+package errors
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// InfoCode 高德 API 错误码（infocode）
+// 参考：https://lbs.amap.com/api/webservice/guide/tools/info
+type InfoCode string
+
+// Category 错误类别
+type Category string
+
+const (
+	CategoryOK        Category = "ok"        // 请求成功
+	CategoryAuth      Category = "auth"      // Key/签名/权限问题
+	CategoryQuota     Category = "quota"     // 配额或 QPS 超限
+	CategoryParameter Category = "parameter" // 请求参数问题
+	CategoryServer    Category = "server"    // 高德服务端问题
+	CategoryNetwork   Category = "network"   // 网络、HTTP 状态码、请求取消
+	CategoryUnknown   Category = "unknown"   // 未知错误
+)
+
+const (
+	InfoCodeOK                            InfoCode = "10000"
+	InfoCodeInvalidUserKey                InfoCode = "10001"
+	InfoCodeServiceNotAvailable           InfoCode = "10002"
+	InfoCodeDailyQueryOverLimit           InfoCode = "10003"
+	InfoCodeAccessTooFrequent             InfoCode = "10004"
+	InfoCodeInvalidUserIP                 InfoCode = "10005"
+	InfoCodeInvalidUserDomain             InfoCode = "10006"
+	InfoCodeInvalidUserSignature          InfoCode = "10007"
+	InfoCodeInvalidUserScode              InfoCode = "10008"
+	InfoCodeUserKeyPlatNoMatch            InfoCode = "10009"
+	InfoCodeIPQueryOverLimit              InfoCode = "10010"
+	InfoCodeNotSupportHTTPS               InfoCode = "10011"
+	InfoCodeInsufficientPrivileges        InfoCode = "10012"
+	InfoCodeUserKeyRecycled               InfoCode = "10013"
+	InfoCodeQPSHasExceededTheLimit        InfoCode = "10014"
+	InfoCodeGatewayTimeout                InfoCode = "10015"
+	InfoCodeServerIsBusy                  InfoCode = "10016"
+	InfoCodeResourceUnavailable           InfoCode = "10017"
+	InfoCodeCQPSHasExceededTheLimit       InfoCode = "10019"
+	InfoCodeCKQPSHasExceededTheLimit      InfoCode = "10020"
+	InfoCodeCUQPSHasExceededTheLimit      InfoCode = "10021"
+	InfoCodeInvalidRequest                InfoCode = "10026"
+	InfoCodeAbroadDailyQueryOverLimit     InfoCode = "10029"
+	InfoCodeNoEffectiveInterface          InfoCode = "10041"
+	InfoCodeUserDailyQueryOverLimit       InfoCode = "10044"
+	InfoCodeUserAbroadDailyQueryOverLimit InfoCode = "10045"
+	InfoCodeInvalidParams                 InfoCode = "20000"
+	InfoCodeMissingRequiredParams         InfoCode = "20001"
+	InfoCodeIllegalRequest                InfoCode = "20002"
+	InfoCodeUnknownError                  InfoCode = "20003"
+	InfoCodeInsufficientAbroadPrivileges  InfoCode = "20011"
+	InfoCodeIllegalContent                InfoCode = "20012"
+	InfoCodeOutOfService                  InfoCode = "20800"
+	InfoCodeNoRoadsNearby                 InfoCode = "20801"
+	InfoCodeRouteFail                     InfoCode = "20802"
+	InfoCodeOverDirectionRange            InfoCode = "20803"
+	InfoCodeQuotaPlanRunOut               InfoCode = "40000"
+	InfoCodeGeofenceMaxCountReached       InfoCode = "40001"
+	InfoCodeServiceExpired                InfoCode = "40002"
+	InfoCodeAbroadQuotaPlanRunOut         InfoCode = "40003"
+)
+
+// engineErrorPrefix 300** 引擎返回数据异常（ENGINE_RESPONSE_DATA_ERROR）的错误码前缀
+const engineErrorPrefix = "300"
+
+// InfoCodeMeta 错误码说明
+type InfoCodeMeta struct {
+	Code        InfoCode // 错误码
+	Name        string   // 高德文档中的错误名称（如 INVALID_USER_KEY）
+	Description string   // 中文说明
+	Category    Category // 错误类别
+	Retryable   bool     // 是否属于临时性错误（稍后重试可能成功）
+}
+
+// infoCodes 高德 infocode 对照表
+var infoCodes = map[InfoCode]InfoCodeMeta{
+	InfoCodeOK:                            {InfoCodeOK, "OK", "请求正常", CategoryOK, false},
+	InfoCodeInvalidUserKey:                {InfoCodeInvalidUserKey, "INVALID_USER_KEY", "key不正确或过期", CategoryAuth, false},
+	InfoCodeServiceNotAvailable:           {InfoCodeServiceNotAvailable, "SERVICE_NOT_AVAILABLE", "没有权限使用相应的服务或者请求接口的路径拼写错误", CategoryAuth, false},
+	InfoCodeDailyQueryOverLimit:           {InfoCodeDailyQueryOverLimit, "DAILY_QUERY_OVER_LIMIT", "访问已超出日访问量", CategoryQuota, false},
+	InfoCodeAccessTooFrequent:             {InfoCodeAccessTooFrequent, "ACCESS_TOO_FREQUENT", "单位时间内访问过于频繁", CategoryQuota, true},
+	InfoCodeInvalidUserIP:                 {InfoCodeInvalidUserIP, "INVALID_USER_IP", "IP白名单出错，发送请求的服务器IP不在IP白名单内", CategoryAuth, false},
+	InfoCodeInvalidUserDomain:             {InfoCodeInvalidUserDomain, "INVALID_USER_DOMAIN", "绑定域名无效", CategoryAuth, false},
+	InfoCodeInvalidUserSignature:          {InfoCodeInvalidUserSignature, "INVALID_USER_SIGNATURE", "数字签名未通过验证", CategoryAuth, false},
+	InfoCodeInvalidUserScode:              {InfoCodeInvalidUserScode, "INVALID_USER_SCODE", "MD5安全码未通过验证", CategoryAuth, false},
+	InfoCodeUserKeyPlatNoMatch:            {InfoCodeUserKeyPlatNoMatch, "USERKEY_PLAT_NOMATCH", "请求key与绑定平台不符", CategoryAuth, false},
+	InfoCodeIPQueryOverLimit:              {InfoCodeIPQueryOverLimit, "IP_QUERY_OVER_LIMIT", "IP访问超限", CategoryQuota, false},
+	InfoCodeNotSupportHTTPS:               {InfoCodeNotSupportHTTPS, "NOT_SUPPORT_HTTPS", "服务不支持https请求", CategoryParameter, false},
+	InfoCodeInsufficientPrivileges:        {InfoCodeInsufficientPrivileges, "INSUFFICIENT_PRIVILEGES", "权限不足，服务请求被拒绝", CategoryAuth, false},
+	InfoCodeUserKeyRecycled:               {InfoCodeUserKeyRecycled, "USER_KEY_RECYCLED", "Key被删除", CategoryAuth, false},
+	InfoCodeQPSHasExceededTheLimit:        {InfoCodeQPSHasExceededTheLimit, "QPS_HAS_EXCEEDED_THE_LIMIT", "云图服务QPS超限", CategoryQuota, true},
+	InfoCodeGatewayTimeout:                {InfoCodeGatewayTimeout, "GATEWAY_TIMEOUT", "受单机QPS限流限制", CategoryQuota, true},
+	InfoCodeServerIsBusy:                  {InfoCodeServerIsBusy, "SERVER_IS_BUSY", "服务器负载过高", CategoryServer, true},
+	InfoCodeResourceUnavailable:           {InfoCodeResourceUnavailable, "RESOURCE_UNAVAILABLE", "所请求的资源不可用", CategoryServer, false},
+	InfoCodeCQPSHasExceededTheLimit:       {InfoCodeCQPSHasExceededTheLimit, "CQPS_HAS_EXCEEDED_THE_LIMIT", "使用的某个服务总QPS超限", CategoryQuota, true},
+	InfoCodeCKQPSHasExceededTheLimit:      {InfoCodeCKQPSHasExceededTheLimit, "CKQPS_HAS_EXCEEDED_THE_LIMIT", "某个Key使用某个服务接口QPS超出限制", CategoryQuota, true},
+	InfoCodeCUQPSHasExceededTheLimit:      {InfoCodeCUQPSHasExceededTheLimit, "CUQPS_HAS_EXCEEDED_THE_LIMIT", "账号使用某个服务接口QPS超出限制", CategoryQuota, true},
+	InfoCodeInvalidRequest:                {InfoCodeInvalidRequest, "INVALID_REQUEST", "账号处于被封禁状态", CategoryAuth, false},
+	InfoCodeAbroadDailyQueryOverLimit:     {InfoCodeAbroadDailyQueryOverLimit, "ABROAD_DAILY_QUERY_OVER_LIMIT", "某一海外服务日调用量超出限制", CategoryQuota, false},
+	InfoCodeNoEffectiveInterface:          {InfoCodeNoEffectiveInterface, "NO_EFFECTIVE_INTERFACE", "请求的接口权限过期", CategoryAuth, false},
+	InfoCodeUserDailyQueryOverLimit:       {InfoCodeUserDailyQueryOverLimit, "USER_DAILY_QUERY_OVER_LIMIT", "账号维度日调用量超出限制", CategoryQuota, false},
+	InfoCodeUserAbroadDailyQueryOverLimit: {InfoCodeUserAbroadDailyQueryOverLimit, "USER_ABROAD_DAILY_QUERY_OVER_LIMIT", "账号维度海外服务日调用量超出限制", CategoryQuota, false},
+	InfoCodeInvalidParams:                 {InfoCodeInvalidParams, "INVALID_PARAMS", "请求参数非法", CategoryParameter, false},
+	InfoCodeMissingRequiredParams:         {InfoCodeMissingRequiredParams, "MISSING_REQUIRED_PARAMS", "缺少必填参数", CategoryParameter, false},
+	InfoCodeIllegalRequest:                {InfoCodeIllegalRequest, "ILLEGAL_REQUEST", "请求协议非法", CategoryParameter, false},
+	InfoCodeUnknownError:                  {InfoCodeUnknownError, "UNKNOWN_ERROR", "其他未知错误", CategoryServer, false},
+	InfoCodeInsufficientAbroadPrivileges:  {InfoCodeInsufficientAbroadPrivileges, "INSUFFICIENT_ABROAD_PRIVILEGES", "查询坐标或规划点（包括起点、终点、途经点）在海外，但没有海外地图权限", CategoryAuth, false},
+	InfoCodeIllegalContent:                {InfoCodeIllegalContent, "ILLEGAL_CONTENT", "查询信息存在非法内容", CategoryParameter, false},
+	InfoCodeOutOfService:                  {InfoCodeOutOfService, "OUT_OF_SERVICE", "规划点（包括起点、终点、途经点）不在中国陆地范围内", CategoryParameter, false},
+	InfoCodeNoRoadsNearby:                 {InfoCodeNoRoadsNearby, "NO_ROADS_NEARBY", "划点（起点、终点、途经点）附近搜不到路", CategoryParameter, false},
+	InfoCodeRouteFail:                     {InfoCodeRouteFail, "ROUTE_FAIL", "路线计算失败，通常是由于道路连通关系导致", CategoryServer, false},
+	InfoCodeOverDirectionRange:            {InfoCodeOverDirectionRange, "OVER_DIRECTION_RANGE", "起点终点距离过长", CategoryParameter, false},
+	InfoCodeQuotaPlanRunOut:               {InfoCodeQuotaPlanRunOut, "QUOTA_PLAN_RUN_OUT", "余额耗尽", CategoryQuota, false},
+	InfoCodeGeofenceMaxCountReached:       {InfoCodeGeofenceMaxCountReached, "GEOFENCE_MAX_COUNT_REACHED", "围栏个数达到上限", CategoryQuota, false},
+	InfoCodeServiceExpired:                {InfoCodeServiceExpired, "SERVICE_EXPIRED", "购买服务到期", CategoryAuth, false},
+	InfoCodeAbroadQuotaPlanRunOut:         {InfoCodeAbroadQuotaPlanRunOut, "ABROAD_QUOTA_PLAN_RUN_OUT", "海外服务余额耗尽", CategoryQuota, false},
+}
+
+// LookupInfoCode 查询错误码说明（300** 引擎错误按前缀匹配）
+func LookupInfoCode(code string) (InfoCodeMeta, bool) {
+	if meta, ok := infoCodes[InfoCode(code)]; ok {
+		return meta, true
+	}
+	if len(code) == 5 && strings.HasPrefix(code, engineErrorPrefix) {
+		return InfoCodeMeta{
+			Code:        InfoCode(code),
+			Name:        "ENGINE_RESPONSE_DATA_ERROR",
+			Description: "服务响应失败",
+			Category:    CategoryServer,
+		}, true
+	}
+	return InfoCodeMeta{}, false
+}
+
+// CategoryOf 返回错误码所属类别，未收录的错误码返回 CategoryUnknown
+func CategoryOf(code string) Category {
+	if meta, ok := LookupInfoCode(code); ok {
+		return meta.Category
+	}
+	return CategoryUnknown
+}
+
+// RetryableInfoCodes 返回所有临时性错误码（升序），可用于配置重试策略
+func RetryableInfoCodes() []string {
+	var codes []string
+	for code, meta := range infoCodes {
+		if meta.Retryable {
+			codes = append(codes, string(code))
+		}
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// Category 返回 API 错误所属类别
+func (e *APIError) Category() Category { return CategoryOf(e.Code) }
+
+// Meta 返回 API 错误的错误码说明
+func (e *APIError) Meta() (InfoCodeMeta, bool) { return LookupInfoCode(e.Code) }
+
+// Is 支持 errors.Is 按错误码比较：errors.Is(err, &APIError{Code: "10001"})
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	return ok && t.Code == e.Code
+}
+
+// Classify 返回任意错误的类别（支持 errors.As 解包 RetryError 等包装错误）
+func Classify(err error) Category {
+	if err == nil {
+		return CategoryOK
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Category()
+	}
+	var quotaErr QuotaExceededError
+	if errors.As(err, &quotaErr) {
+		return CategoryQuota
+	}
+	var configErr InvalidConfigError
+	if errors.As(err, &configErr) {
+		return CategoryParameter
+	}
+	var netErr NetworkError
+	var statusErr *HTTPStatusError
+	var canceledErr *CanceledError
+	if errors.As(err, &netErr) || errors.As(err, &statusErr) || errors.As(err, &canceledErr) {
+		return CategoryNetwork
+	}
+	var parseErr ParseError
+	if errors.As(err, &parseErr) {
+		return CategoryServer
+	}
+	return CategoryUnknown
+}
+
+// IsQuotaExceeded 是否为配额或 QPS 超限（含客户端配额耗尽）
+func IsQuotaExceeded(err error) bool { return Classify(err) == CategoryQuota }
+
+// IsInvalidKey 是否为 Key 本身无效（10001 Key不正确、10009 与绑定平台不符、10013 Key被删除）
+func IsInvalidKey(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch InfoCode(apiErr.Code) {
+	case InfoCodeInvalidUserKey, InfoCodeUserKeyPlatNoMatch, InfoCodeUserKeyRecycled:
+		return true
+	}
+	return false
+}
+
+// IsAuthError 是否为 Key/签名/权限相关错误（包含 IsInvalidKey 的情形）
+func IsAuthError(err error) bool { return Classify(err) == CategoryAuth }
+
+// IsParameterError 是否为请求参数错误
+func IsParameterError(err error) bool { return Classify(err) == CategoryParameter }
+
+// IsRetryable 是否为临时性错误（网络错误、429/5xx、QPS 超限、服务繁忙等），ctx 取消不视为可重试
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	var canceledErr *CanceledError
+	if errors.As(err, &canceledErr) {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		meta, ok := apiErr.Meta()
+		return ok && meta.Retryable
+	}
+	var statusErr *HTTPStatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests || statusErr.StatusCode >= http.StatusInternalServerError
+	}
+	var netErr NetworkError
+	return errors.As(err, &netErr)
+}
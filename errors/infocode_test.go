@@ -0,0 +1,100 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// 测试错误码分类
+func TestCategoryOf(t *testing.T) {
+	cases := map[string]Category{
+		"10000": CategoryOK,
+		"10001": CategoryAuth,
+		"10007": CategoryAuth,
+		"10003": CategoryQuota,
+		"10021": CategoryQuota,
+		"10044": CategoryQuota,
+		"20000": CategoryParameter,
+		"20803": CategoryParameter,
+		"10016": CategoryServer,
+		"30001": CategoryServer, // 300** 引擎错误按前缀匹配
+		"99999": CategoryUnknown,
+		"300":   CategoryUnknown,
+	}
+	for code, expected := range cases {
+		if got := CategoryOf(code); got != expected {
+			t.Errorf("错误码 %s 分类错误，期望：%s，实际：%s", code, expected, got)
+		}
+	}
+}
+
+// 测试判断函数支持包装错误
+func TestPredicates(t *testing.T) {
+	quota := fmt.Errorf("wrapped: %w", NewRetryError(3, NewAPIError("10021", "CUQPS_HAS_EXCEEDED_THE_LIMIT")))
+	if !IsQuotaExceeded(quota) || !IsRetryable(quota) || IsInvalidKey(quota) {
+		t.Errorf("QPS超限判断错误：%v", quota)
+	}
+
+	invalidKey := NewRetryError(1, NewAPIError("10001", "INVALID_USER_KEY"))
+	if !IsInvalidKey(invalidKey) || IsRetryable(invalidKey) || IsQuotaExceeded(invalidKey) {
+		t.Errorf("Key无效判断错误：%v", invalidKey)
+	}
+	signature := NewAPIError("10007", "INVALID_USER_SIGNATURE")
+	if IsInvalidKey(signature) || !IsAuthError(signature) || !IsAuthError(invalidKey) {
+		t.Errorf("签名错误不应视为Key无效：%v", signature)
+	}
+	if !IsInvalidKey(NewAPIError("10009", "USERKEY_PLAT_NOMATCH")) || !IsInvalidKey(NewAPIError("10013", "USER_KEY_RECYCLED")) {
+		t.Error("10009、10013 应视为Key无效")
+	}
+	dailyQuota := NewAPIError("10003", "DAILY_QUERY_OVER_LIMIT")
+	if !IsQuotaExceeded(dailyQuota) || IsRetryable(dailyQuota) {
+		t.Errorf("日配额耗尽不应重试：%v", dailyQuota)
+	}
+
+	if !IsQuotaExceeded(NewQuotaExceededError("local")) {
+		t.Error("客户端配额耗尽应视为配额错误")
+	}
+	if !IsParameterError(NewAPIError("20001", "MISSING_REQUIRED_PARAMS")) || !IsParameterError(NewInvalidConfigError("x")) {
+		t.Error("参数错误判断错误")
+	}
+	if !IsRetryable(NewNetworkError("timeout")) || !IsRetryable(NewHTTPStatusError(503)) || IsRetryable(NewHTTPStatusError(404)) {
+		t.Error("网络错误判断错误")
+	}
+	if IsRetryable(NewCanceledError(context.Canceled)) || IsRetryable(nil) {
+		t.Error("ctx取消不应重试")
+	}
+	if Classify(NewCanceledError(context.Canceled)) != CategoryNetwork || Classify(NewParseError("x")) != CategoryServer {
+		t.Error("错误分类错误")
+	}
+}
+
+// 测试errors.Is按错误码比较
+func TestAPIErrorIs(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", NewAPIError("10001", "INVALID_USER_KEY"))
+	if !errors.Is(err, &APIError{Code: string(InfoCodeInvalidUserKey)}) {
+		t.Error("errors.Is 应按错误码匹配")
+	}
+	if errors.Is(err, &APIError{Code: string(InfoCodeDailyQueryOverLimit)}) {
+		t.Error("不同错误码不应匹配")
+	}
+}
+
+// 测试错误码说明和可重试列表
+func TestLookupInfoCode(t *testing.T) {
+	meta, ok := LookupInfoCode("10021")
+	if !ok || meta.Name != "CUQPS_HAS_EXCEEDED_THE_LIMIT" || !meta.Retryable {
+		t.Errorf("错误码说明错误：%+v", meta)
+	}
+	codes := RetryableInfoCodes()
+	for _, code := range []string{"10004", "10019", "10020", "10021"} {
+		if !slices.Contains(codes, code) {
+			t.Errorf("可重试错误码缺少 %s", code)
+		}
+	}
+	if slices.Contains(codes, "10001") || slices.Contains(codes, "10003") || !slices.IsSorted(codes) {
+		t.Errorf("可重试错误码列表错误：%v", codes)
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/enneket/amap"
 	"github.com/enneket/amap/api/direction/v1/bicycling"
+	amapType "github.com/enneket/amap/types"
 )
 
 func main() {
@@ -22,8 +23,8 @@ func main() {
 
 	// 骑行路径规划V1示例
 	req := &bicycling.BicyclingRequest{
-		Origin:      "116.481028,39.989643",
-		Destination: "116.514203,39.905409",
+		Origin:      amapType.LngLat{Lng: 116.481028, Lat: 39.989643},
+		Destination: amapType.LngLat{Lng: 116.514203, Lat: 39.905409},
 	}
 
 	resp, err := client.Bicycling(req)
@@ -34,8 +35,8 @@ func main() {
 	fmt.Println("=== 骑行路径规划V1结果 ===")
 	for i, path := range resp.Route.Paths {
 		fmt.Printf("路径 %d:\n", i+1)
-		fmt.Printf("距离: %d 米\n", path.Distance)
-		fmt.Printf("耗时: %d 秒\n", path.Duration)
+		fmt.Printf("距离: %s 米\n", path.Distance)
+		fmt.Printf("耗时: %s 秒\n", path.Duration)
 		fmt.Printf("路段数量: %d\n", len(path.Steps))
 		fmt.Println()
 	}
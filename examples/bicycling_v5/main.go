@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/enneket/amap"
+	"github.com/enneket/amap/api/direction"
+	"github.com/enneket/amap/api/direction/v5/bicycling"
+	amapType "github.com/enneket/amap/types"
+)
+
+func main() {
+	// 创建配置
+	config := amap.NewConfig("your_amap_api_key")
+	config.Timeout = 30 * time.Second
+
+	// 初始化客户端
+	client, err := amap.NewClient(config)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// 骑行路径规划V5示例
+	req := &bicycling.BicyclingRequestV5{
+		Origin:      amapType.LngLat{Lng: 116.481028, Lat: 39.989643},
+		Destination: amapType.LngLat{Lng: 116.434446, Lat: 39.90816},
+		ShowFields:  direction.ShowFields{direction.ShowFieldPolyline},
+	}
+
+	resp, err := client.BicyclingV5(req)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("=== 骑行路径规划V5结果 ===")
+	for i, path := range resp.Route.Paths {
+		fmt.Printf("路径 %d:\n", i+1)
+		metrics := path.Metrics()
+		distance, err := metrics.DistanceMeters()
+		if err != nil {
+			log.Fatal(err)
+		}
+		duration, err := metrics.TravelTime()
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("距离: %.0f 米\n", distance)
+		fmt.Printf("耗时: %s\n", duration)
+		fmt.Printf("路段数量: %d\n", len(path.Steps))
+		fmt.Println()
+	}
+}
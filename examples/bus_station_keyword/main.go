@@ -34,7 +34,7 @@ func main() {
 	}
 
 	fmt.Println("=== 公交站关键字查询结果 ===")
-	fmt.Printf("总结果数: %d\n", resp.Count)
+	fmt.Printf("总结果数: %s\n", resp.Count)
 	for i, station := range resp.Stations {
 		fmt.Printf("公交站 %d:\n", i+1)
 		fmt.Printf("名称: %s\n", station.Name)
@@ -7,6 +7,7 @@ import (
 
 	"github.com/enneket/amap"
 	"github.com/enneket/amap/api/traffic_situation/circle"
+	amapType "github.com/enneket/amap/types"
 )
 
 func main() {
@@ -22,7 +23,7 @@ func main() {
 
 	// 圆形区域内交通态势查询示例
 	req := &circle.CircleTrafficRequest{
-		Center: "116.481028,39.989643",
+		Center: amapType.LngLat{Lng: 116.481028, Lat: 39.989643},
 		Radius: "5000",
 		Level:  "2",
 	}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/enneket/amap"
 	"github.com/enneket/amap/api/distance"
+	amapType "github.com/enneket/amap/types"
 )
 
 func main() {
@@ -22,8 +23,8 @@ func main() {
 
 	// 距离测量示例
 	req := &distance.DistanceRequest{
-		Origins:      "116.481028,39.989643|116.455087,39.990464",
-		Destination:  "116.514203,39.905409",
+		Origins:      amapType.LngLatList{{Lng: 116.481028, Lat: 39.989643}, {Lng: 116.455087, Lat: 39.990464}},
+		Destination:  amapType.LngLat{Lng: 116.514203, Lat: 39.905409},
 		Type:         0,
 	}
 
@@ -35,7 +36,7 @@ func main() {
 	fmt.Println("=== 距离测量结果 ===")
 	for i, element := range resp.Results {
 		fmt.Printf("结果 %d:\n", i+1)
-		fmt.Printf("距离: %d 米\n", element.Distance)
+		fmt.Printf("距离: %s 米\n", element.Distance)
 		fmt.Println()
 	}
 }
@@ -31,5 +31,5 @@ func main() {
 	}
 
 	fmt.Println("=== 硬件定位V5结果 ===")
-	fmt.Printf("精度: %d 米\n", resp.Accuracy)
+	fmt.Printf("精度: %v 米\n", resp.Accuracy)
 }
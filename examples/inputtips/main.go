@@ -33,7 +33,7 @@ func main() {
 	}
 
 	fmt.Println("=== 输入提示结果 ===")
-	fmt.Printf("结果数量: %d\n", resp.Count)
+	fmt.Printf("结果数量: %s\n", resp.Count)
 	for i, tip := range resp.Tips {
 		fmt.Printf("提示 %d:\n", i+1)
 		fmt.Printf("名称: %s\n", tip.Name)
@@ -36,5 +36,5 @@ func main() {
 	fmt.Printf("城市: %s\n", resp.City)
 	fmt.Printf("区域: %s\n", resp.District)
 	fmt.Printf("ISP: %s\n", resp.ISP)
-	fmt.Printf("经纬度: %s\n", resp.Location)
+	fmt.Printf("经纬度: %s\n", resp.Center)
 }
@@ -34,6 +34,6 @@ func main() {
 	fmt.Printf("省份: %s\n", resp.Province)
 	fmt.Printf("城市: %s\n", resp.City)
 	fmt.Printf("区: %s\n", resp.District)
-	fmt.Printf("经纬度: %s\n", resp.Location)
+	fmt.Printf("经纬度: %s\n", resp.Center)
 	fmt.Printf("ISP: %s\n", resp.ISP)
 }
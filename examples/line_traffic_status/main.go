@@ -7,6 +7,7 @@ import (
 
 	"github.com/enneket/amap"
 	"github.com/enneket/amap/api/traffic_situation/line"
+	amapType "github.com/enneket/amap/types"
 )
 
 func main() {
@@ -22,7 +23,7 @@ func main() {
 
 	// 指定线路交通态势查询示例
 	req := &line.LineTrafficRequest{
-		Path:   "116.481028,39.989643;116.514203,39.905409",
+		Path:   amapType.LngLatList{{Lng: 116.481028, Lat: 39.989643}, {Lng: 116.514203, Lat: 39.905409}},
 		Level:  "5",
 	}
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/enneket/amap"
 	"github.com/enneket/amap/api/place/v3/around"
+	amapType "github.com/enneket/amap/types"
 )
 
 func main() {
@@ -22,7 +23,7 @@ func main() {
 
 	// POI周边搜索V3示例
 	req := &around.AroundSearchRequest{
-		Location: "116.481028,39.989643",
+		Location: amapType.LngLat{Lng: 116.481028, Lat: 39.989643},
 		Radius:   1000,
 		Offset:   10,
 		Page:     1,
@@ -34,7 +35,7 @@ func main() {
 	}
 
 	fmt.Println("=== POI周边搜索V3结果 ===")
-	fmt.Printf("总结果数: %d\n", resp.Count)
+	fmt.Printf("总结果数: %s\n", resp.Count)
 	for i, poi := range resp.Pois {
 		fmt.Printf("POI %d:\n", i+1)
 		fmt.Printf("名称: %s\n", poi.Name)
@@ -42,7 +43,7 @@ func main() {
 		fmt.Printf("经纬度: %s\n", poi.Location)
 		fmt.Printf("电话: %s\n", poi.Tel)
 		fmt.Printf("类别: %s\n", poi.Type)
-		fmt.Printf("距离中心点: %d 米\n", poi.Distance)
+		fmt.Printf("距离中心点: %s 米\n", poi.Distance)
 		fmt.Println()
 	}
 }
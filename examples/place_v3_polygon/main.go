@@ -7,6 +7,7 @@ import (
 
 	"github.com/enneket/amap"
 	"github.com/enneket/amap/api/place/v3/polygon"
+	amapType "github.com/enneket/amap/types"
 )
 
 func main() {
@@ -22,7 +23,7 @@ func main() {
 
 	// POI多边形搜索V3示例
 	req := &polygon.PolygonSearchRequest{
-		Polygon:  "116.405467,39.907761;116.475098,39.907761;116.475098,39.940931;116.405467,39.940931;116.405467,39.907761",
+		Polygon:  amapType.LngLatList{{Lng: 116.405467, Lat: 39.907761}, {Lng: 116.475098, Lat: 39.907761}, {Lng: 116.475098, Lat: 39.940931}, {Lng: 116.405467, Lat: 39.940931}, {Lng: 116.405467, Lat: 39.907761}},
 		Offset:   10,
 		Page:     1,
 	}
@@ -33,7 +34,7 @@ func main() {
 	}
 
 	fmt.Println("=== POI多边形搜索V3结果 ===")
-	fmt.Printf("总结果数: %d\n", resp.Count)
+	fmt.Printf("总结果数: %s\n", resp.Count)
 	for i, poi := range resp.Pois {
 		fmt.Printf("POI %d:\n", i+1)
 		fmt.Printf("名称: %s\n", poi.Name)
@@ -34,7 +34,7 @@ func main() {
 	}
 
 	fmt.Println("=== POI文本搜索V3结果 ===")
-	fmt.Printf("总结果数: %d\n", resp.Count)
+	fmt.Printf("总结果数: %s\n", resp.Count)
 	for i, poi := range resp.Pois {
 		fmt.Printf("POI %d:\n", i+1)
 		fmt.Printf("名称: %s\n", poi.Name)
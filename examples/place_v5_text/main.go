@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/enneket/amap"
+	"github.com/enneket/amap/api/place"
 	"github.com/enneket/amap/api/place/v5/text"
 )
 
@@ -22,10 +23,12 @@ func main() {
 
 	// POI文本搜索V5示例
 	req := &text.TextSearchRequest{
-		Keyword: "美食",
-		City:     "北京",
-		Offset:   "10",
-		Page:     "1",
+		Keyword:    "美食",
+		Region:     "北京",
+		CityLimit:  true,
+		ShowFields: place.ShowFields{place.ShowFieldBusiness},
+		PageSize:   10,
+		PageNum:    1,
 	}
 
 	resp, err := client.PlaceV5Text(req)
@@ -34,7 +37,7 @@ func main() {
 	}
 
 	fmt.Println("=== POI文本搜索V5结果 ===")
-	fmt.Printf("总结果数: %d\n", resp.Count)
+	fmt.Printf("总结果数: %s\n", resp.Count)
 	for i, poi := range resp.Pois {
 		fmt.Printf("POI %d:\n", i+1)
 		fmt.Printf("名称: %s\n", poi.Name)
@@ -42,7 +45,9 @@ func main() {
 		fmt.Printf("经纬度: %s\n", poi.Location)
 		fmt.Printf("电话: %s\n", poi.Tel)
 		fmt.Printf("类别: %s\n", poi.Type)
-		fmt.Printf("评分: %s\n", poi.BizExt.Rating)
+		if poi.Business != nil {
+			fmt.Printf("评分: %s\n", poi.Business.Rating)
+		}
 		fmt.Println()
 	}
 }
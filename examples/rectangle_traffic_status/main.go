@@ -7,6 +7,7 @@ import (
 
 	"github.com/enneket/amap"
 	"github.com/enneket/amap/api/traffic_situation/rectangle"
+	amapType "github.com/enneket/amap/types"
 )
 
 func main() {
@@ -22,7 +23,7 @@ func main() {
 
 	// 矩形区域内交通态势查询示例
 	req := &rectangle.RectangleTrafficRequest{
-		Rectangle: "116.351147,39.966309,116.357136,39.968722",
+		Rectangle: amapType.Bounds{SouthWest: amapType.LngLat{Lng: 116.351147, Lat: 39.966309}, NorthEast: amapType.LngLat{Lng: 116.357136, Lat: 39.968722}},
 		Level:     "2",
 	}
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/enneket/amap"
 	"github.com/enneket/amap/api/re_geo_code"
+	amapType "github.com/enneket/amap/types"
 )
 
 func main() {
@@ -22,7 +23,7 @@ func main() {
 
 	// 逆地理编码示例
 	req := &re_geo_code.ReGeocodeRequest{
-		Location:   "116.481028,39.989643",
+		Location:   amapType.LngLat{Lng: 116.481028, Lat: 39.989643},
 		Radius:     1000,
 		Extensions: "all",
 	}
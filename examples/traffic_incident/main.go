@@ -7,6 +7,7 @@ import (
 
 	"github.com/enneket/amap"
 	"github.com/enneket/amap/api/traffic_incident"
+	amapType "github.com/enneket/amap/types"
 )
 
 func main() {
@@ -24,7 +25,7 @@ func main() {
 	req := &traffic_incident.TrafficIncidentRequest{
 		Level:  "0",
 		Type:   "0",
-		Rectangle: "116.351147,39.966309,116.357136,39.968722",
+		Rectangle: amapType.Bounds{SouthWest: amapType.LngLat{Lng: 116.351147, Lat: 39.966309}, NorthEast: amapType.LngLat{Lng: 116.357136, Lat: 39.968722}},
 	}
 
 	resp, err := client.TrafficIncident(req)
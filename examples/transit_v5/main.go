@@ -0,0 +1,67 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/enneket/amap"
+	"github.com/enneket/amap/api/direction"
+	"github.com/enneket/amap/api/direction/v5/transit"
+	amapType "github.com/enneket/amap/types"
+)
+
+func main() {
+	// 创建配置
+	config := amap.NewConfig("your_amap_api_key")
+	config.Timeout = 30 * time.Second
+
+	// 初始化客户端
+	client, err := amap.NewClient(config)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// 公交路径规划V5示例
+	req := &transit.TransitRequestV5{
+		Origin:           amapType.LngLat{Lng: 116.466485, Lat: 39.995197},
+		Destination:      amapType.LngLat{Lng: 116.46424, Lat: 40.020642},
+		City1:            "010",
+		City2:            "010",
+		AlternativeRoute: 3,
+		ShowFields:       direction.ShowFields{direction.ShowFieldCost},
+	}
+
+	resp, err := client.TransitV5(req)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("=== 公交路径规划V5结果 ===")
+	for i, plan := range resp.Route.Transits {
+		fmt.Printf("方案 %d:\n", i+1)
+		metrics := plan.Metrics()
+		duration, err := metrics.TravelTime()
+		if err != nil {
+			log.Fatal(err)
+		}
+		fare, err := metrics.FareFen()
+		if err != nil {
+			log.Fatal(err)
+		}
+		walkingDistance, err := metrics.WalkingDistanceMeters()
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Printf("耗时: %s\n", duration)
+		fmt.Printf("票价: %.2f 元\n", float64(fare)/100)
+		fmt.Printf("步行距离: %.0f 米\n", walkingDistance)
+		for _, segment := range plan.Segments {
+			if segment.Bus != nil && len(segment.Bus.Buslines) > 0 {
+				busline := segment.Bus.Buslines[0]
+				fmt.Printf("  乘坐 %s：%s → %s\n", busline.Name, busline.DepartureStop.Name, busline.ArrivalStop.Name)
+			}
+		}
+		fmt.Println()
+	}
+}
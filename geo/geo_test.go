@@ -0,0 +1,113 @@
+package geo
+
+import (
+	"math"
+	"testing"
+
+	amapType "github.com/enneket/amap/types"
+)
+
+// 测试拼接路段坐标时去除首尾重合点
+func TestConcatPolylines(t *testing.T) {
+	points, err := ConcatPolylines("116.1,39.1;116.2,39.1", "", "116.2,39.1;116.3,39.1;")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if points.String() != "116.1,39.1;116.2,39.1;116.3,39.1" {
+		t.Errorf("拼接结果错误：%s", points)
+	}
+
+	if _, err := ConcatPolylines("116.1,39.1;bad"); err == nil {
+		t.Error("非法坐标应返回错误")
+	}
+
+	whole, _ := PathPoints("116.1,39.1;116.5,39.5", "116.1,39.1;116.2,39.1")
+	if len(whole) != 2 {
+		t.Errorf("应优先使用整条路径的 polyline：%s", whole)
+	}
+}
+
+// 测试距离、长度和沿线取点
+func TestMeasure(t *testing.T) {
+	// 赤道上经度相差 1 度约 111.32 公里
+	line := amapType.LngLatList{{Lng: 0, Lat: 0}, {Lng: 1, Lat: 0}, {Lng: 2, Lat: 0}}
+	degree := EarthRadius * math.Pi / 180
+	if got := Length(line); math.Abs(got-2*degree) > 1e-6 {
+		t.Errorf("长度错误：%f", got)
+	}
+
+	p, ok := PointAt(line, 1.5*degree)
+	if !ok || math.Abs(p.Lng-1.5) > 1e-9 || p.Lat != 0 {
+		t.Errorf("沿线取点错误：%v", p)
+	}
+	if p, _ := PointAt(line, -1); p != line[0] {
+		t.Errorf("负距离应返回起点：%v", p)
+	}
+	if p, _ := PointAt(line, 10*degree); p != line[2] {
+		t.Errorf("超出长度应返回终点：%v", p)
+	}
+	if _, ok := PointAt(nil, 1); ok {
+		t.Error("空折线应返回 false")
+	}
+
+	// 北京天安门到上海外滩约 1067 公里
+	d := Distance(amapType.LngLat{Lng: 116.397428, Lat: 39.90923}, amapType.LngLat{Lng: 121.490317, Lat: 31.241701})
+	if d < 1060e3 || d > 1075e3 {
+		t.Errorf("距离错误：%f", d)
+	}
+}
+
+// 测试行政区边界解析和点包含判断
+func TestMultiPolygon(t *testing.T) {
+	// 外环 + 内环（洞）+ 独立的岛
+	m, err := DecodeMultiPolygon("0,0;10,0;10,10;0,10|4,4;6,4;6,6;4,6|20,20;21,20;21,21")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(m) != 3 {
+		t.Fatalf("区域数错误：%d", len(m))
+	}
+	cases := map[amapType.LngLat]bool{
+		{Lng: 1, Lat: 1}:       true,
+		{Lng: 5, Lat: 5}:       false, // 洞内
+		{Lng: 20.8, Lat: 20.2}: true,
+		{Lng: 15, Lat: 15}:     false,
+	}
+	for p, expected := range cases {
+		if m.Contains(p) != expected {
+			t.Errorf("坐标 %s 包含判断错误", p)
+		}
+	}
+	if b := m.Bounds(); b.String() != "0,0;21,21" {
+		t.Errorf("外包矩形错误：%s", b)
+	}
+	if b := BoundingBox(m[1]); b.String() != "4,4;6,6" {
+		t.Errorf("外包矩形错误：%s", b)
+	}
+}
+
+// 测试区域与矩形相交判断
+func TestIntersectsBounds(t *testing.T) {
+	m, _ := DecodeMultiPolygon("0,0;10,0;10,10;0,10|4,4;6,4;6,6;4,6")
+	bounds := func(s string) amapType.Bounds {
+		b, err := amapType.ParseBounds(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	cases := map[string]bool{
+		"1,1;2,2":       true,  // 在区域内
+		"-5,-5;15,15":   true,  // 包含整个区域
+		"-1,2;1,3":      true,  // 与边界相交
+		"4.5,4.5;5,5":   false, // 在洞内
+		"11,11;12,12":   false, // 在区域外
+		"-2,-2;20,-1":   false,
+		"3.5,4.5;4.5,5": true, // 跨越洞的边界
+	}
+	for s, expected := range cases {
+		if m.IntersectsBounds(bounds(s)) != expected {
+			t.Errorf("矩形 %s 相交判断错误", s)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package geo
+
+import (
+	"math"
+
+	amapType "github.com/enneket/amap/types"
+)
+
+// EarthRadius 地球半径（米，与高德 JS API 的距离计算一致）
+const EarthRadius = 6378137.0
+
+// Distance 两点间的球面距离（米，haversine 公式）
+func Distance(a, b amapType.LngLat) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLat, dLng := lat2-lat1, radians(b.Lng-a.Lng)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Length 折线总长度（米）
+func Length(points []amapType.LngLat) float64 {
+	total := 0.0
+	for i := 1; i < len(points); i++ {
+		total += Distance(points[i-1], points[i])
+	}
+	return total
+}
+
+// BoundingBox 包含所有坐标的最小矩形
+func BoundingBox(points []amapType.LngLat) amapType.Bounds {
+	return amapType.BoundsOf(points)
+}
+
+// PointAt 沿折线从起点行进 distance 米处的坐标（线段内线性插值）
+// distance 小于 0 返回起点，超过总长度返回终点；空折线返回 false
+func PointAt(points []amapType.LngLat, distance float64) (amapType.LngLat, bool) {
+	if len(points) == 0 {
+		return amapType.LngLat{}, false
+	}
+	if distance <= 0 {
+		return points[0], true
+	}
+	for i := 1; i < len(points); i++ {
+		seg := Distance(points[i-1], points[i])
+		if distance <= seg {
+			ratio := distance / seg
+			a, b := points[i-1], points[i]
+			return amapType.LngLat{Lng: a.Lng + (b.Lng-a.Lng)*ratio, Lat: a.Lat + (b.Lat-a.Lat)*ratio}, true
+		}
+		distance -= seg
+	}
+	return points[len(points)-1], true
+}
+
+// radians 角度转弧度
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
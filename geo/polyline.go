@@ -0,0 +1,149 @@
+// Package geo 提供高德坐标串的解析与几何计算（路线 polyline、行政区边界、长度、外包矩形、沿线取点等）
+package geo
+
+import (
+	"strings"
+
+	amapType "github.com/enneket/amap/types"
+)
+
+// DecodePolyline 解析 "经度,纬度;经度,纬度" 格式的坐标串（如路线、路段的 polyline）
+func DecodePolyline(polyline string) (amapType.LngLatList, error) {
+	return amapType.ParseLngLatList(polyline, ";")
+}
+
+// ConcatPolylines 依次解析并拼接多段坐标串（如各路段的 polyline），相邻段首尾重合的点只保留一个
+func ConcatPolylines(polylines ...string) (amapType.LngLatList, error) {
+	var result amapType.LngLatList
+	for _, polyline := range polylines {
+		points, err := DecodePolyline(polyline)
+		if err != nil {
+			return nil, err
+		}
+		result = Join(result, points)
+	}
+	return result, nil
+}
+
+// Join 拼接多段坐标，相邻段首尾重合的点只保留一个
+func Join(lines ...amapType.LngLatList) amapType.LngLatList {
+	var result amapType.LngLatList
+	for _, line := range lines {
+		if len(line) == 0 {
+			continue
+		}
+		if len(result) > 0 && result[len(result)-1] == line[0] {
+			line = line[1:]
+		}
+		result = append(result, line...)
+	}
+	return result
+}
+
+// PathPoints 路径坐标：优先解析整条路径的 polyline，未返回时拼接各路段的 polyline
+func PathPoints(polyline string, steps ...string) (amapType.LngLatList, error) {
+	if strings.TrimSpace(polyline) != "" {
+		return DecodePolyline(polyline)
+	}
+	return ConcatPolylines(steps...)
+}
+
+// MultiPolygon 多个多边形（如行政区边界，每个元素为一个闭合环）
+type MultiPolygon []amapType.LngLatList
+
+// DecodeMultiPolygon 解析行政区边界：各区域以 "|" 分隔，区域内坐标以 ";" 分隔
+func DecodeMultiPolygon(polyline string) (MultiPolygon, error) {
+	var result MultiPolygon
+	for _, part := range strings.Split(polyline, "|") {
+		ring, err := DecodePolyline(part)
+		if err != nil {
+			return nil, err
+		}
+		if len(ring) > 0 {
+			result = append(result, ring)
+		}
+	}
+	return result, nil
+}
+
+// Bounds 包含所有区域的最小矩形
+func (m MultiPolygon) Bounds() amapType.Bounds {
+	var points []amapType.LngLat
+	for _, ring := range m {
+		points = append(points, ring...)
+	}
+	return amapType.BoundsOf(points)
+}
+
+// Contains 坐标是否在区域内（奇偶规则，被偶数个环包含的点视为在洞内）
+func (m MultiPolygon) Contains(p amapType.LngLat) bool {
+	inside := false
+	for _, ring := range m {
+		if ringContains(ring, p) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// ringContains 射线法判断坐标是否在环内
+func ringContains(ring amapType.LngLatList, p amapType.LngLat) bool {
+	inside := false
+	for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+		a, b := ring[i], ring[j]
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) &&
+			p.Lng < (b.Lng-a.Lng)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lng {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// IntersectsBounds 区域是否与矩形相交（矩形在区域内、区域在矩形内或边界相交；矩形完全位于洞内时不相交）
+func (m MultiPolygon) IntersectsBounds(b amapType.Bounds) bool {
+	corners := []amapType.LngLat{
+		b.SouthWest,
+		{Lng: b.NorthEast.Lng, Lat: b.SouthWest.Lat},
+		b.NorthEast,
+		{Lng: b.SouthWest.Lng, Lat: b.NorthEast.Lat},
+	}
+	for _, corner := range corners {
+		if m.Contains(corner) {
+			return true
+		}
+	}
+	for _, ring := range m {
+		for i, j := 0, len(ring)-1; i < len(ring); j, i = i, i+1 {
+			if b.Contains(ring[i]) {
+				return true
+			}
+			for k := range corners {
+				if segmentsIntersect(ring[j], ring[i], corners[k], corners[(k+1)%len(corners)]) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// segmentsIntersect 线段 ab 与 cd 是否相交（含端点接触）
+func segmentsIntersect(a, b, c, d amapType.LngLat) bool {
+	d1, d2 := cross(c, d, a), cross(c, d, b)
+	d3, d4 := cross(a, b, c), cross(a, b, d)
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+	return (d1 == 0 && onSegment(c, d, a)) || (d2 == 0 && onSegment(c, d, b)) ||
+		(d3 == 0 && onSegment(a, b, c)) || (d4 == 0 && onSegment(a, b, d))
+}
+
+// cross 向量 ab 与 ap 的叉积（判断 p 在 ab 的哪一侧）
+func cross(a, b, p amapType.LngLat) float64 {
+	return (b.Lng-a.Lng)*(p.Lat-a.Lat) - (b.Lat-a.Lat)*(p.Lng-a.Lng)
+}
+
+// onSegment 与 ab 共线的点 p 是否在线段 ab 上
+func onSegment(a, b, p amapType.LngLat) bool {
+	return amapType.BoundsOf([]amapType.LngLat{a, b}).Contains(p)
+}
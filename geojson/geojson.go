@@ -0,0 +1,152 @@
+// Package geojson 将高德返回的坐标导出为 GeoJSON（RFC 7946），便于在 Mapbox、Leaflet 等前端直接渲染
+// 高德坐标为 GCJ-02，可通过 WithWGS84 在导出时转换为 WGS-84
+package geojson
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/enneket/amap/geo"
+	amapType "github.com/enneket/amap/types"
+	"github.com/enneket/amap/utils"
+)
+
+// GeoJSON 类型名称
+const (
+	TypeFeature           = "Feature"
+	TypeFeatureCollection = "FeatureCollection"
+	TypePoint             = "Point"
+	TypeLineString        = "LineString"
+	TypeMultiPolygon      = "MultiPolygon"
+)
+
+// Properties 要素属性
+type Properties map[string]any
+
+// Geometry 几何对象（Coordinates 为 [经度,纬度] 及其嵌套数组）
+type Geometry struct {
+	Type        string `json:"type"`
+	Coordinates any    `json:"coordinates"`
+}
+
+// Feature 要素
+type Feature struct {
+	Type       string     `json:"type"`
+	Geometry   *Geometry  `json:"geometry"`
+	Properties Properties `json:"properties"`
+}
+
+// FeatureCollection 要素集合
+type FeatureCollection struct {
+	Type     string     `json:"type"`
+	Features []*Feature `json:"features"`
+}
+
+// Option 导出选项
+type Option func(*options)
+
+type options struct {
+	wgs84 bool
+}
+
+// WithWGS84 导出时将 GCJ-02 坐标转换为 WGS-84（使用 utils.GCJ02ToWGS84，境外坐标不转换）
+func WithWGS84() Option {
+	return func(o *options) {
+		o.wgs84 = true
+	}
+}
+
+// NewFeature 创建要素（props 为 nil 时序列化为空对象）
+func NewFeature(geometry *Geometry, props Properties) *Feature {
+	if props == nil {
+		props = Properties{}
+	}
+	return &Feature{Type: TypeFeature, Geometry: geometry, Properties: props}
+}
+
+// NewFeatureCollection 创建要素集合
+func NewFeatureCollection(features ...*Feature) *FeatureCollection {
+	if features == nil {
+		features = []*Feature{}
+	}
+	return &FeatureCollection{Type: TypeFeatureCollection, Features: features}
+}
+
+// Add 追加要素
+func (fc *FeatureCollection) Add(features ...*Feature) {
+	fc.Features = append(fc.Features, features...)
+}
+
+// Point 创建点
+func Point(p amapType.LngLat, opts ...Option) *Geometry {
+	o := newOptions(opts)
+	return &Geometry{Type: TypePoint, Coordinates: o.position(p)}
+}
+
+// LineString 创建线
+func LineString(points amapType.LngLatList, opts ...Option) *Geometry {
+	o := newOptions(opts)
+	return &Geometry{Type: TypeLineString, Coordinates: o.positions(points)}
+}
+
+// MultiPolygon 创建多面（高德行政区边界的每个区域作为一个多边形，未闭合的环自动闭合）
+func MultiPolygon(m geo.MultiPolygon, opts ...Option) *Geometry {
+	o := newOptions(opts)
+	polygons := make([][][][]float64, 0, len(m))
+	for _, ring := range m {
+		if len(ring) > 0 && ring[0] != ring[len(ring)-1] {
+			ring = append(ring[:len(ring):len(ring)], ring[0])
+		}
+		polygons = append(polygons, [][][]float64{o.positions(ring)})
+	}
+	return &Geometry{Type: TypeMultiPolygon, Coordinates: polygons}
+}
+
+// RouteFeature 创建路线要素（LineString，distance/duration 转换为数字属性，单位为米和秒）
+func RouteFeature(points amapType.LngLatList, distance, duration string, opts ...Option) *Feature {
+	return NewFeature(LineString(points, opts...), Properties{
+		"distance": number(distance),
+		"duration": number(duration),
+	})
+}
+
+// newOptions 应用导出选项
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// position 转换为 [经度,纬度]（保留 6 位小数）
+func (o *options) position(p amapType.LngLat) []float64 {
+	if o.wgs84 {
+		c := utils.GCJ02ToWGS84(utils.Coordinate{Lng: p.Lng, Lat: p.Lat})
+		p = amapType.LngLat{Lng: c.Lng, Lat: c.Lat}
+	}
+	return []float64{round6(p.Lng), round6(p.Lat)}
+}
+
+// positions 转换坐标串
+func (o *options) positions(points amapType.LngLatList) [][]float64 {
+	result := make([][]float64, len(points))
+	for i, p := range points {
+		result[i] = o.position(p)
+	}
+	return result
+}
+
+// round6 四舍五入到小数点后 6 位（与高德坐标精度一致）
+func round6(v float64) float64 {
+	return math.Round(v*1e6) / 1e6
+}
+
+// number 将高德的数字字符串转换为数字，无法解析时返回 nil（序列化为 null）
+func number(s string) any {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return nil
+	}
+	return v
+}
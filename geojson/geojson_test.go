@@ -0,0 +1,49 @@
+package geojson
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/enneket/amap/geo"
+	amapType "github.com/enneket/amap/types"
+)
+
+// 测试几何对象的序列化和多边形自动闭合
+func TestGeometry(t *testing.T) {
+	line, _ := amapType.ParseLngLatList("116.1,39.1;116.2,39.2", ";")
+	fc := NewFeatureCollection(RouteFeature(line, "1200", "300"))
+	fc.Add(NewFeature(Point(line[0]), nil))
+	data, err := json.Marshal(fc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"type":"FeatureCollection","features":[` +
+		`{"type":"Feature","geometry":{"type":"LineString","coordinates":[[116.1,39.1],[116.2,39.2]]},"properties":{"distance":1200,"duration":300}},` +
+		`{"type":"Feature","geometry":{"type":"Point","coordinates":[116.1,39.1]},"properties":{}}]}`
+	if string(data) != expected {
+		t.Errorf("序列化结果错误：%s", data)
+	}
+
+	m, _ := geo.DecodeMultiPolygon("0,0;1,0;1,1|2,2;3,2;3,3;2,2")
+	polygons := MultiPolygon(m).Coordinates.([][][][]float64)
+	if len(polygons) != 2 || len(polygons[0][0]) != 4 || len(polygons[1][0]) != 4 {
+		t.Errorf("多边形应自动闭合：%v", polygons)
+	}
+	if len(m[0]) != 3 {
+		t.Error("闭合多边形不应修改原坐标串")
+	}
+}
+
+// 测试导出时转换为 WGS-84
+func TestWithWGS84(t *testing.T) {
+	gcj := amapType.LngLat{Lng: 116.397428, Lat: 39.90923}
+	wgs := Point(gcj, WithWGS84()).Coordinates.([]float64)
+	// 北京地区 GCJ-02 与 WGS-84 偏移约 0.006 度
+	if math.Abs(wgs[0]-gcj.Lng+0.0062) > 0.001 || math.Abs(wgs[1]-gcj.Lat+0.0014) > 0.001 {
+		t.Errorf("转换结果错误：%v", wgs)
+	}
+	if TrafficColor(99) != TrafficColor(TrafficUnknown) {
+		t.Error("未知路况应返回灰色")
+	}
+}
@@ -0,0 +1,36 @@
+package geojson
+
+// 交通态势路况状态（高德交通态势接口 roads[].status）
+const (
+	TrafficUnknown   = 0 // 未知
+	TrafficSmooth    = 1 // 畅通
+	TrafficSlow      = 2 // 缓行
+	TrafficCongested = 3 // 拥堵
+	TrafficBlocked   = 4 // 严重拥堵
+)
+
+// trafficColors 路况状态对应的线颜色（与高德地图路况配色一致）
+var trafficColors = map[int]string{
+	TrafficUnknown:   "#8C8C8C",
+	TrafficSmooth:    "#34B000",
+	TrafficSlow:      "#FECB00",
+	TrafficCongested: "#DF0100",
+	TrafficBlocked:   "#8E0E0B",
+}
+
+// TrafficColor 路况状态对应的线颜色（未知状态返回灰色）
+func TrafficColor(status int) string {
+	if color, ok := trafficColors[status]; ok {
+		return color
+	}
+	return trafficColors[TrafficUnknown]
+}
+
+// TrafficStyle 路况线样式属性（simplestyle 规范的 stroke/stroke-width，可直接用于 Mapbox/Leaflet 样式）
+func TrafficStyle(status int) Properties {
+	return Properties{
+		"status":       status,
+		"stroke":       TrafficColor(status),
+		"stroke-width": 4,
+	}
+}
@@ -0,0 +1,224 @@
+package amap
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	amapErr "github.com/enneket/amap/errors"
+)
+
+// KeyPair 一组高德 API Key 及其安全密钥
+type KeyPair struct {
+	Key         string // 高德 API Key
+	SecurityKey string // 该 Key 对应的安全密钥（可选，为空时不签名）
+	DailyQuota  int64  // 该 Key 的每日配额（可选，用于按剩余配额选择 Key）
+}
+
+// KeySelection Key 选择策略
+type KeySelection int
+
+const (
+	KeySelectRoundRobin    KeySelection = iota // 轮询（默认）
+	KeySelectMostRemaining                     // 优先选择当日剩余配额最多的 Key
+)
+
+// KeyStatus Key 池中单个 Key 的状态
+type KeyStatus struct {
+	Key            string    // API Key
+	Used           int64     // 当日已发出的请求数（北京时间自然日）
+	DailyQuota     int64     // 每日配额（0 表示未配置）
+	Disabled       bool      // 是否因 Key 无效等原因被永久停用
+	ExhaustedUntil time.Time // 配额耗尽时的恢复时间（零值表示可用）
+	LastInfoCode   string    // 最近一次导致停用/耗尽的 infocode
+}
+
+// keyDisableCodes 表示 Key 本身不可用的 infocode（停用该 Key）
+var keyDisableCodes = []amapErr.InfoCode{
+	amapErr.InfoCodeInvalidUserKey,
+	amapErr.InfoCodeInvalidUserSignature,
+	amapErr.InfoCodeUserKeyPlatNoMatch,
+	amapErr.InfoCodeUserKeyRecycled,
+}
+
+// keyExhaustCodes 表示 Key 当日配额耗尽的 infocode（次日恢复）
+var keyExhaustCodes = []amapErr.InfoCode{
+	amapErr.InfoCodeDailyQueryOverLimit,
+	amapErr.InfoCodeUserDailyQueryOverLimit,
+	amapErr.InfoCodeUserAbroadDailyQueryOverLimit,
+	amapErr.InfoCodeQuotaPlanRunOut,
+}
+
+// pooledKey Key 池内部状态
+type pooledKey struct {
+	KeyPair
+	used           int64
+	date           string
+	disabled       bool
+	exhaustedUntil time.Time
+	lastInfoCode   string
+}
+
+// keyPool 多 Key 池：按策略选择 Key，并在 Key 无效/配额耗尽时切换
+type keyPool struct {
+	mu        sync.Mutex
+	keys      []*pooledKey
+	next      int
+	selection KeySelection
+	now       func() time.Time
+}
+
+// newKeyPool 根据配置创建 Key 池（Config.Key 在前，Config.Keys 依次追加，重复的 Key 只保留一个）
+func newKeyPool(cfg *Config) *keyPool {
+	pool := &keyPool{selection: cfg.KeySelection, now: time.Now}
+	add := func(pair KeyPair) {
+		if pair.Key == "" || slices.ContainsFunc(pool.keys, func(k *pooledKey) bool { return k.Key == pair.Key }) {
+			return
+		}
+		pool.keys = append(pool.keys, &pooledKey{KeyPair: pair})
+	}
+	add(KeyPair{Key: cfg.Key, SecurityKey: cfg.SecurityKey})
+	for _, pair := range cfg.Keys {
+		add(pair)
+	}
+	return pool
+}
+
+// pick 选择一个可用的 Key（跳过 tried 中本次请求已失败的 Key）
+// 只有一个 Key 时总是返回它，保持与单 Key 配置一致的行为
+func (p *keyPool) pick(tried []string) (KeyPair, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.now()
+	today := now.In(quotaZone).Format(time.DateOnly)
+	if len(p.keys) == 1 {
+		p.keys[0].use(today)
+		return p.keys[0].KeyPair, nil
+	}
+
+	var best *pooledKey
+	bestIndex := -1
+	for i := range p.keys {
+		index := (p.next + i) % len(p.keys)
+		k := p.keys[index]
+		if k.disabled || now.Before(k.exhaustedUntil) || slices.Contains(tried, k.Key) {
+			continue
+		}
+		if k.date != today {
+			k.date, k.used = today, 0
+		}
+		if k.DailyQuota > 0 && k.used >= k.DailyQuota {
+			continue
+		}
+		if p.selection == KeySelectRoundRobin {
+			best, bestIndex = k, index
+			break
+		}
+		if best == nil || k.remaining() > best.remaining() {
+			best, bestIndex = k, index
+		}
+	}
+	if best == nil {
+		return KeyPair{}, amapErr.NewQuotaExceededError("Key 池中没有可用的 Key")
+	}
+	p.next = (bestIndex + 1) % len(p.keys)
+	best.use(today)
+	return best.KeyPair, nil
+}
+
+// use 记录一次调用（跨天自动重置计数）
+func (k *pooledKey) use(today string) {
+	if k.date != today {
+		k.date, k.used = today, 0
+	}
+	k.used++
+}
+
+// remaining 当日剩余配额（未配置配额视为无限）
+func (k *pooledKey) remaining() int64 {
+	if k.DailyQuota <= 0 {
+		return 1<<63 - 1
+	}
+	return k.DailyQuota - k.used
+}
+
+// report 根据请求结果更新 Key 状态，返回是否应换 Key 重试
+// 客户端限流器的每日配额按 (Key, 服务族) 计数，耗尽（QuotaExceededError）时只为本次请求换 Key，
+// 不暂停该 Key，其他服务族仍可继续使用它
+func (p *keyPool) report(key string, err error) bool {
+	if err == nil {
+		return false
+	}
+	var disable, exhaust, local bool
+	var infoCode string
+	var apiErr *amapErr.APIError
+	var quotaErr amapErr.QuotaExceededError
+	switch {
+	case errors.As(err, &apiErr):
+		infoCode = apiErr.Code
+		disable = slices.Contains(keyDisableCodes, amapErr.InfoCode(apiErr.Code))
+		exhaust = slices.Contains(keyExhaustCodes, amapErr.InfoCode(apiErr.Code))
+	case errors.As(err, &quotaErr):
+		local = true
+	}
+	if !disable && !exhaust && !local {
+		return false
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.keys) == 1 {
+		return false
+	}
+	for _, k := range p.keys {
+		if k.Key != key {
+			continue
+		}
+		switch {
+		case local:
+			// 请求未发出，不计入该 Key 的调用次数
+			if k.used > 0 {
+				k.used--
+			}
+		case disable:
+			k.lastInfoCode = infoCode
+			k.disabled = true
+		default:
+			// 配额次日（北京时间零点）恢复
+			k.lastInfoCode = infoCode
+			y, m, d := p.now().In(quotaZone).Date()
+			k.exhaustedUntil = time.Date(y, m, d+1, 0, 0, 0, 0, quotaZone)
+		}
+	}
+	return true
+}
+
+// status 返回所有 Key 的状态快照
+func (p *keyPool) status() []KeyStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	today := p.now().In(quotaZone).Format(time.DateOnly)
+	result := make([]KeyStatus, 0, len(p.keys))
+	for _, k := range p.keys {
+		status := KeyStatus{
+			Key:          k.Key,
+			DailyQuota:   k.DailyQuota,
+			Disabled:     k.disabled,
+			LastInfoCode: k.lastInfoCode,
+		}
+		if k.date == today {
+			status.Used = k.used
+		}
+		if p.now().Before(k.exhaustedUntil) {
+			status.ExhaustedUntil = k.exhaustedUntil
+		}
+		result = append(result, status)
+	}
+	return result
+}
+
+// KeyStatus 返回 Key 池中所有 Key 的状态
+func (c *Client) KeyStatus() []KeyStatus {
+	return c.keys.status()
+}
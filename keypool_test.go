@@ -0,0 +1,214 @@
+package amap
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	geoCode "github.com/enneket/amap/api/geo_code"
+	amapErr "github.com/enneket/amap/errors"
+	"github.com/enneket/amap/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestKeyPool_FailoverOnInvalidKey 测试Key无效时自动换Key重试，并使用对应的安全密钥签名
+func TestKeyPool_FailoverOnInvalidKey(t *testing.T) {
+	// 1. 创建mock服务器：key_a 无效，key_b 校验签名后返回成功
+	var receivedKeys []string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		receivedKeys = append(receivedKeys, query.Get("key"))
+		if query.Get("key") == "key_a" {
+			_, _ = w.Write([]byte(`{"status":"0","info":"INVALID_USER_KEY","infocode":"10001"}`))
+			return
+		}
+		params := map[string]string{}
+		for k := range query {
+			if k != "sig" {
+				params[k] = query.Get(k)
+			}
+		}
+		if query.Get("sig") != utils.Sign(params, "secret_b") {
+			_, _ = w.Write([]byte(`{"status":"0","info":"INVALID_USER_SIGNATURE","infocode":"10007"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"1","info":"OK","infocode":"10000","count":"0","geocodes":[]}`))
+	}))
+	defer mockServer.Close()
+
+	// 2. 创建Client实例，配置两个Key
+	config := NewConfig("")
+	config.BaseURL = mockServer.URL
+	config.Keys = []KeyPair{
+		{Key: "key_a", SecurityKey: "secret_a"},
+		{Key: "key_b", SecurityKey: "secret_b"},
+	}
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	// 3. 连续执行两次请求
+	_, err = client.GeoCode(&geoCode.GeocodeRequest{Address: "北京市朝阳区"})
+	require.NoError(t, err)
+	_, err = client.GeoCode(&geoCode.GeocodeRequest{Address: "北京市海淀区"})
+	require.NoError(t, err)
+
+	// 4. 验证结果：key_a 被停用后不再使用
+	assert.Equal(t, []string{"key_a", "key_b", "key_b"}, receivedKeys)
+	status := client.KeyStatus()
+	require.Len(t, status, 2)
+	assert.True(t, status[0].Disabled)
+	assert.Equal(t, "10001", status[0].LastInfoCode)
+	assert.False(t, status[1].Disabled)
+	assert.Equal(t, int64(2), status[1].Used)
+}
+
+// TestKeyPool_AllExhausted 测试所有Key配额耗尽时返回最后的API错误
+func TestKeyPool_AllExhausted(t *testing.T) {
+	// 1. 创建mock服务器，始终返回日配额超限
+	requests := 0
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`{"status":"0","info":"USER_DAILY_QUERY_OVER_LIMIT","infocode":"10044"}`))
+	}))
+	defer mockServer.Close()
+
+	// 2. 创建Client实例
+	config := NewConfig("key_a")
+	config.BaseURL = mockServer.URL
+	config.Keys = []KeyPair{{Key: "key_b"}}
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	// 3. 第一次请求尝试所有Key
+	var resp TestResponse
+	err = client.DoRequest(http.MethodGet, "/test/path", nil, &resp)
+	assert.IsType(t, &amapErr.APIError{}, err)
+	assert.Equal(t, 2, requests)
+
+	// 4. 第二次请求不再发出，直接返回配额错误
+	err = client.DoRequest(http.MethodGet, "/test/path", nil, &resp)
+	assert.IsType(t, amapErr.QuotaExceededError(""), err)
+	assert.Equal(t, 2, requests)
+	for _, status := range client.KeyStatus() {
+		assert.False(t, status.ExhaustedUntil.IsZero())
+	}
+}
+
+// TestKeyPool_FailoverOnLocalDailyQuota 测试客户端某服务族每日配额耗尽时换下一个Key，且不影响该Key的其他服务族
+func TestKeyPool_FailoverOnLocalDailyQuota(t *testing.T) {
+	// 1. 创建mock服务器，记录收到的Key
+	var receivedKeys []string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedKeys = append(receivedKeys, r.URL.Query().Get("key"))
+		_, _ = w.Write([]byte(`{"status":"1","info":"OK","infocode":"10000","count":"0","geocodes":[]}`))
+	}))
+	defer mockServer.Close()
+
+	// 2. 创建Client实例：两个Key，地理编码每个Key每日配额 1 次；按剩余配额选择时 key_a 优先
+	config := NewConfig("")
+	config.BaseURL = mockServer.URL
+	config.Keys = []KeyPair{{Key: "key_a", DailyQuota: 100}, {Key: "key_b", DailyQuota: 50}}
+	config.KeySelection = KeySelectMostRemaining
+	config.RateLimits = map[string]RateLimit{ServiceGeocode: {DailyQuota: 1}}
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	// 3. 第一次请求使用 key_a；第二次请求 key_a 本地配额耗尽，自动换 key_b 发送
+	_, err = client.GeoCode(&geoCode.GeocodeRequest{Address: "北京市朝阳区"})
+	require.NoError(t, err)
+	_, err = client.GeoCode(&geoCode.GeocodeRequest{Address: "北京市海淀区"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"key_a", "key_b"}, receivedKeys)
+
+	// 4. 第三次请求：两个Key本地配额均已耗尽，请求不再发出
+	_, err = client.GeoCode(&geoCode.GeocodeRequest{Address: "北京市东城区"})
+	assert.True(t, amapErr.IsQuotaExceeded(err))
+	assert.Len(t, receivedKeys, 2)
+
+	// 5. 其他服务族不受影响，仍使用 key_a 发送
+	var resp TestResponse
+	require.NoError(t, client.DoRequest(http.MethodGet, "/v3/weather/weatherInfo", nil, &resp))
+	assert.Equal(t, []string{"key_a", "key_b", "key_a"}, receivedKeys)
+
+	// 6. 验证结果：Key 未被暂停或停用，未发出的请求不计入调用次数
+	for _, status := range client.KeyStatus() {
+		assert.True(t, status.ExhaustedUntil.IsZero())
+		assert.False(t, status.Disabled)
+	}
+	assert.Equal(t, int64(2), client.KeyStatus()[0].Used)
+	assert.Equal(t, int64(1), client.KeyStatus()[1].Used)
+}
+
+// TestKeyPool_SingleKeyNotDisabled 测试单Key配置时不停用Key
+func TestKeyPool_SingleKeyNotDisabled(t *testing.T) {
+	// 1. 创建mock服务器，返回Key无效
+	requests := 0
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`{"status":"0","info":"INVALID_USER_KEY","infocode":"10001"}`))
+	}))
+	defer mockServer.Close()
+
+	// 2. 创建Client实例
+	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	// 3. 执行两次请求，均应发出
+	var resp TestResponse
+	assert.IsType(t, &amapErr.APIError{}, client.DoRequest(http.MethodGet, "/test/path", nil, &resp))
+	assert.IsType(t, &amapErr.APIError{}, client.DoRequest(http.MethodGet, "/test/path", nil, &resp))
+	assert.Equal(t, 2, requests)
+	assert.False(t, client.KeyStatus()[0].Disabled)
+}
+
+// TestKeyPool_Selection 测试轮询和按剩余配额选择
+func TestKeyPool_Selection(t *testing.T) {
+	// 1. 轮询
+	pool := newKeyPool(&Config{Keys: []KeyPair{{Key: "a"}, {Key: "b"}, {Key: "c"}}})
+	var picked []string
+	for i := 0; i < 4; i++ {
+		key, err := pool.pick(nil)
+		require.NoError(t, err)
+		picked = append(picked, key.Key)
+	}
+	assert.Equal(t, []string{"a", "b", "c", "a"}, picked)
+
+	// 2. 按剩余配额
+	pool = newKeyPool(&Config{
+		KeySelection: KeySelectMostRemaining,
+		Keys:         []KeyPair{{Key: "a", DailyQuota: 2}, {Key: "b", DailyQuota: 3}},
+	})
+	picked = nil
+	for i := 0; i < 5; i++ {
+		key, err := pool.pick(nil)
+		require.NoError(t, err)
+		picked = append(picked, key.Key)
+	}
+	assert.Equal(t, []string{"b", "a", "b", "a", "b"}, picked)
+	_, err := pool.pick(nil)
+	assert.IsType(t, amapErr.QuotaExceededError(""), err)
+}
+
+// TestKeyPool_ExhaustedRecoversNextDay 测试配额耗尽的Key在次日恢复
+func TestKeyPool_ExhaustedRecoversNextDay(t *testing.T) {
+	now := time.Date(2024, 5, 1, 15, 0, 0, 0, quotaZone)
+	pool := newKeyPool(&Config{Keys: []KeyPair{{Key: "a"}, {Key: "b"}}})
+	pool.now = func() time.Time { return now }
+
+	assert.True(t, pool.report("a", amapErr.NewAPIError("10003", "DAILY_QUERY_OVER_LIMIT")))
+	key, err := pool.pick(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "b", key.Key)
+	key, err = pool.pick(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "b", key.Key)
+
+	now = time.Date(2024, 5, 2, 0, 0, 1, 0, quotaZone)
+	key, err = pool.pick(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "a", key.Key)
+}
@@ -0,0 +1,62 @@
+package amap
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	amapType "github.com/enneket/amap/types"
+	"github.com/enneket/amap/utils"
+)
+
+// Call 一次发往高德的请求（重试、换 Key 时每次尝试各对应一个 Call）
+type Call struct {
+	Service string            // 服务族（如 geocode、direction，见 ServiceOf）
+	Method  string            // HTTP 方法（GET/POST）
+	URL     string            // 请求地址（不含查询参数）
+	Params  map[string]string // 最终请求参数（含 key、timestamp、sig），修改后需调用 Resign 重新签名
+
+	securityKey string // 当前 Key 的安全密钥
+}
+
+// Path 返回请求路径（不含域名，如 /v3/geocode/geo）
+func (c *Call) Path() string {
+	if parsed, err := url.Parse(c.URL); err == nil {
+		return parsed.Path
+	}
+	return c.URL
+}
+
+// Resign 中间件修改 Params 后重新计算签名（当前 Key 未配置安全密钥时不签名）
+func (c *Call) Resign() {
+	delete(c.Params, "sig")
+	if c.securityKey != "" {
+		c.Params["sig"] = utils.Sign(c.Params, c.securityKey)
+	}
+}
+
+// Handler 处理一次请求，返回解析后的基础响应（RawJSON 为完整响应，用于解析业务数据）
+type Handler func(ctx context.Context, call *Call) (*amapType.BaseResponse, error)
+
+// Middleware 中间件：包裹下一个 Handler，可在请求前后记录日志、统计指标、修改参数，
+// 也可以不调用 next 直接返回合成的响应（如故障注入、本地 mock）
+type Middleware func(next Handler) Handler
+
+// Use 追加中间件（先添加的在外层），需在并发使用 Client 之前调用
+func (c *Client) Use(middlewares ...Middleware) {
+	c.middlewares = append(c.middlewares, middlewares...)
+	handler := Handler(c.roundTrip)
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		handler = c.middlewares[i](handler)
+	}
+	c.handler = handler
+}
+
+// NewSyntheticResponse 根据 JSON 构建合成响应，供中间件短路返回（body 须包含 status/info/infocode）
+func NewSyntheticResponse(body string) (*amapType.BaseResponse, error) {
+	resp, _, err := amapType.ReadBaseResponse(strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
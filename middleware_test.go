@@ -0,0 +1,130 @@
+package amap
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	geoCode "github.com/enneket/amap/api/geo_code"
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+	"github.com/enneket/amap/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMiddleware_Order 测试中间件执行顺序及可见的请求信息
+func TestMiddleware_Order(t *testing.T) {
+	// 1. 创建mock服务器
+	mockServer := mockResponse(http.StatusOK, `{"status":"1","info":"OK","infocode":"10000","count":"0","geocodes":[]}`)
+	defer mockServer.Close()
+
+	// 2. 创建Client实例，Config 中的中间件在外层，Use 追加的在内层
+	var trace []string
+	record := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(ctx context.Context, call *Call) (*amapType.BaseResponse, error) {
+				trace = append(trace, name+":before:"+call.Service+":"+call.Path()+":"+call.Params["address"])
+				resp, err := next(ctx, call)
+				trace = append(trace, name+":after:"+resp.InfoCode)
+				return resp, err
+			}
+		}
+	}
+	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
+	config.Middlewares = []Middleware{record("outer")}
+	client, err := NewClient(config)
+	require.NoError(t, err)
+	client.Use(record("inner"))
+
+	// 3. 执行请求
+	_, err = client.GeoCode(&geoCode.GeocodeRequest{Address: "北京"})
+
+	// 4. 验证结果
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"outer:before:geocode:/v3/geocode/geo:北京",
+		"inner:before:geocode:/v3/geocode/geo:北京",
+		"inner:after:10000",
+		"outer:after:10000",
+	}, trace)
+}
+
+// TestMiddleware_ShortCircuit 测试中间件直接返回合成响应，不发出HTTP请求
+func TestMiddleware_ShortCircuit(t *testing.T) {
+	// 1. 创建Client实例，BaseURL 指向不可达地址
+	config := NewConfig("test_key")
+	config.BaseURL = "http://127.0.0.1:1"
+	client, err := NewClient(config)
+	require.NoError(t, err)
+	client.Use(func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*amapType.BaseResponse, error) {
+			return NewSyntheticResponse(`{"status":"1","info":"OK","infocode":"10000","count":"1","geocodes":[{"location":"116.1,39.1"}]}`)
+		}
+	})
+
+	// 2. 执行请求
+	resp, err := client.GeoCode(&geoCode.GeocodeRequest{Address: "北京"})
+
+	// 3. 验证结果
+	require.NoError(t, err)
+	assert.EqualValues(t, "116.1,39.1", resp.Geocodes[0].Location)
+}
+
+// TestMiddleware_FaultInjection 测试中间件注入API错误
+func TestMiddleware_FaultInjection(t *testing.T) {
+	config := NewConfig("test_key")
+	config.BaseURL = "http://127.0.0.1:1"
+	client, err := NewClient(config)
+	require.NoError(t, err)
+	client.Use(func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*amapType.BaseResponse, error) {
+			return NewSyntheticResponse(`{"status":"0","info":"SERVER_IS_BUSY","infocode":"10016"}`)
+		}
+	})
+
+	_, err = client.GeoCode(&geoCode.GeocodeRequest{Address: "北京"})
+	assert.True(t, amapErr.IsRetryable(err))
+	assert.Contains(t, err.Error(), "/v3/geocode/geo")
+}
+
+// TestMiddleware_ModifyParamsAndResign 测试中间件修改参数并重新签名
+func TestMiddleware_ModifyParamsAndResign(t *testing.T) {
+	// 1. 创建mock服务器，校验签名
+	var valid bool
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		params := map[string]string{}
+		for k := range query {
+			if k != "sig" {
+				params[k] = query.Get(k)
+			}
+		}
+		valid = query.Get("trace") == "abc" && query.Get("sig") == utils.Sign(params, "secret")
+		_, _ = w.Write([]byte(`{"status":"1","info":"OK","infocode":"10000"}`))
+	}))
+	defer mockServer.Close()
+
+	// 2. 创建Client实例
+	config := NewConfig("test_key")
+	config.SecurityKey = "secret"
+	config.BaseURL = mockServer.URL
+	client, err := NewClient(config)
+	require.NoError(t, err)
+	client.Use(func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*amapType.BaseResponse, error) {
+			call.Params["trace"] = "abc"
+			call.Resign()
+			return next(ctx, call)
+		}
+	})
+
+	// 3. 执行请求
+	var resp TestResponse
+	require.NoError(t, client.DoRequest(http.MethodGet, "/test/path", nil, &resp))
+
+	// 4. 验证结果
+	assert.True(t, valid)
+}
@@ -0,0 +1,177 @@
+package amap
+
+import (
+	"context"
+	"iter"
+
+	placev3around "github.com/enneket/amap/api/place/v3/around"
+	placev3polygon "github.com/enneket/amap/api/place/v3/polygon"
+	placev3text "github.com/enneket/amap/api/place/v3/text"
+	placev5around "github.com/enneket/amap/api/place/v5/around"
+	placev5polygon "github.com/enneket/amap/api/place/v5/polygon"
+	placev5text "github.com/enneket/amap/api/place/v5/text"
+	amapType "github.com/enneket/amap/types"
+)
+
+const (
+	// DefaultPageSize 自动翻页时默认的每页条数（高德建议不超过 25）
+	DefaultPageSize = 25
+	// MaxPlacePages 高德POI搜索允许的最大页码，超出后不再返回结果
+	MaxPlacePages = 100
+)
+
+// PageOptions 自动翻页选项（nil 表示使用默认值）
+type PageOptions struct {
+	PageSize   int // 每页条数（可选，默认 25）
+	MaxResults int // 最多返回的POI数（可选，<=0 表示不限制）
+}
+
+// pageFetcher 获取第 page 页（从 1 开始），返回本页结果和高德返回的结果总数（无法解析时为 0）
+type pageFetcher[T any] func(ctx context.Context, page, size int) (items []T, count int, err error)
+
+// paginate 逐页请求并逐条返回结果，直到满足以下任一条件：
+// 本页结果不足一页、已返回 count 条、达到最大页码、达到 MaxResults；按 id 去除跨页重复的结果（空 ID 不去重）
+func paginate[T any](ctx context.Context, opts *PageOptions, fetch pageFetcher[T], id func(*T) string) iter.Seq2[T, error] {
+	size, limit := DefaultPageSize, 0
+	if opts != nil {
+		if opts.PageSize > 0 {
+			size = opts.PageSize
+		}
+		limit = opts.MaxResults
+	}
+	return func(yield func(T, error) bool) {
+		seen := map[string]bool{}
+		returned, fetched := 0, 0
+		for page := 1; page <= MaxPlacePages; page++ {
+			items, count, err := fetch(ctx, page, size)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			fetched += len(items)
+			for i := range items {
+				if key := id(&items[i]); key != "" {
+					if seen[key] {
+						continue
+					}
+					seen[key] = true
+				}
+				if !yield(items[i], nil) {
+					return
+				}
+				returned++
+				if limit > 0 && returned >= limit {
+					return
+				}
+			}
+			if len(items) < size || (count > 0 && fetched >= count) {
+				return
+			}
+		}
+	}
+}
+
+// Collect 将自动翻页迭代器的结果收集为切片，遇到错误时返回已收集的结果和错误
+func Collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var items []T
+	for item, err := range seq {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// atoiCount 解析高德返回的结果总数（无法解析时为 0）
+func atoiCount(s amapType.FlexString) int {
+	n, _ := s.Int()
+	return n
+}
+
+// PlaceV3TextIter 自动翻页的POI文本搜索（v3），req 中的 Page/Offset 由迭代器设置，不修改 req
+func (c *Client) PlaceV3TextIter(ctx context.Context, req *placev3text.TextSearchRequest, opts *PageOptions) iter.Seq2[placev3text.PoiItem, error] {
+	return paginate(ctx, opts, func(ctx context.Context, page, size int) ([]placev3text.PoiItem, int, error) {
+		r := *req
+		r.Page, r.Offset = page, size
+		resp, err := c.PlaceV3TextCtx(ctx, &r)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Pois, atoiCount(resp.Count), nil
+	}, func(item *placev3text.PoiItem) string { return item.ID.String() })
+}
+
+// PlaceV3AroundIter 自动翻页的POI周边搜索（v3），req 中的 Page/Offset 由迭代器设置，不修改 req
+func (c *Client) PlaceV3AroundIter(ctx context.Context, req *placev3around.AroundSearchRequest, opts *PageOptions) iter.Seq2[placev3around.PoiItem, error] {
+	return paginate(ctx, opts, func(ctx context.Context, page, size int) ([]placev3around.PoiItem, int, error) {
+		r := *req
+		r.Page, r.Offset = page, size
+		resp, err := c.PlaceV3AroundCtx(ctx, &r)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Pois, atoiCount(resp.Count), nil
+	}, func(item *placev3around.PoiItem) string { return item.ID.String() })
+}
+
+// PlaceV3PolygonIter 自动翻页的POI多边形搜索（v3），req 中的 Page/Offset 由迭代器设置，不修改 req
+func (c *Client) PlaceV3PolygonIter(ctx context.Context, req *placev3polygon.PolygonSearchRequest, opts *PageOptions) iter.Seq2[placev3polygon.PoiItem, error] {
+	return paginate(ctx, opts, func(ctx context.Context, page, size int) ([]placev3polygon.PoiItem, int, error) {
+		r := *req
+		r.Page, r.Offset = page, size
+		resp, err := c.PlaceV3PolygonCtx(ctx, &r)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Pois, atoiCount(resp.Count), nil
+	}, func(item *placev3polygon.PoiItem) string { return item.ID.String() })
+}
+
+// PlaceV5TextIter 自动翻页的POI文本搜索（v5），req 中的 PageNum/PageSize 由迭代器设置，不修改 req
+func (c *Client) PlaceV5TextIter(ctx context.Context, req *placev5text.TextSearchRequest, opts *PageOptions) iter.Seq2[placev5text.PoiItem, error] {
+	return paginate(ctx, opts, func(ctx context.Context, page, size int) ([]placev5text.PoiItem, int, error) {
+		r := *req
+		r.PageNum, r.PageSize = page, size
+		resp, err := c.PlaceV5TextCtx(ctx, &r)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Pois, 0, nil // v5 的 count 为本页条数，只能依据结果不足一页判断结束
+	}, func(item *placev5text.PoiItem) string { return item.ID.String() })
+}
+
+// PlaceV5AroundIter 自动翻页的POI周边搜索（v5），req 中的 PageNum/PageSize 由迭代器设置，不修改 req
+func (c *Client) PlaceV5AroundIter(ctx context.Context, req *placev5around.AroundSearchRequest, opts *PageOptions) iter.Seq2[placev5around.PoiItem, error] {
+	return paginate(ctx, opts, func(ctx context.Context, page, size int) ([]placev5around.PoiItem, int, error) {
+		r := *req
+		r.PageNum, r.PageSize = page, size
+		resp, err := c.PlaceV5AroundCtx(ctx, &r)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Pois, 0, nil // v5 的 count 为本页条数，只能依据结果不足一页判断结束
+	}, func(item *placev5around.PoiItem) string { return item.ID.String() })
+}
+
+// PlaceV5PolygonIter 自动翻页的POI多边形搜索（v5），req 中的 PageNum/PageSize 由迭代器设置，不修改 req
+func (c *Client) PlaceV5PolygonIter(ctx context.Context, req *placev5polygon.PolygonSearchRequest, opts *PageOptions) iter.Seq2[placev5polygon.PoiItem, error] {
+	return paginate(ctx, opts, c.placeV5PolygonPages(req), placeV5PolygonID)
+}
+
+// placeV5PolygonPages POI多边形搜索（v5）的分页请求函数（PlaceV5PolygonIter 和 CrawlPOIs 共用）
+func (c *Client) placeV5PolygonPages(req *placev5polygon.PolygonSearchRequest) pageFetcher[placev5polygon.PoiItem] {
+	return func(ctx context.Context, page, size int) ([]placev5polygon.PoiItem, int, error) {
+		r := *req
+		r.PageNum, r.PageSize = page, size
+		resp, err := c.PlaceV5PolygonCtx(ctx, &r)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.Pois, 0, nil // v5 的 count 为本页条数，只能依据结果不足一页判断结束
+	}
+}
+
+// placeV5PolygonID POI多边形搜索（v5）结果的去重键
+func placeV5PolygonID(item *placev5polygon.PoiItem) string { return item.ID.String() }
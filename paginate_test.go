@@ -0,0 +1,115 @@
+package amap
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/enneket/amap/amaptest"
+	placev3text "github.com/enneket/amap/api/place/v3/text"
+	placev5text "github.com/enneket/amap/api/place/v5/text"
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// placePagesFixture 按 page/offset（v3）或 page_num/page_size（v5）分页返回 total 个POI，第二页起每页第一个POI与上一页最后一个重复
+func placePagesFixture(total int) amaptest.FixtureFunc {
+	return func(params url.Values) string {
+		page, size := pageParams(params)
+		start, end := (page-1)*size, min(page*size, total)
+		if page > 1 {
+			start--
+		}
+		var items []string
+		for i := start; i < end; i++ {
+			items = append(items, fmt.Sprintf(`{"id":"B%03d","name":"POI%d","location":"116.%d,39.9"}`, i, i, i))
+		}
+		return fmt.Sprintf(`{"status":"1","info":"OK","infocode":"10000","count":"%d","pois":[%s]}`, total, strings.Join(items, ","))
+	}
+}
+
+// pageParams 读取请求的页码和每页条数（兼容 v3、v5 的参数名）
+func pageParams(params url.Values) (page, size int) {
+	if params.Has("page_num") {
+		page, _ = strconv.Atoi(params.Get("page_num"))
+		size, _ = strconv.Atoi(params.Get("page_size"))
+		return page, size
+	}
+	page, _ = strconv.Atoi(params.Get("page"))
+	size, _ = strconv.Atoi(params.Get("offset"))
+	return page, size
+}
+
+// TestPlaceV5TextIter_AllPages 测试自动翻页直到结果不足一页，并去除跨页重复的POI
+func TestPlaceV5TextIter_AllPages(t *testing.T) {
+	// 1. 创建假服务器，共 45 个POI
+	client, srv := newFakeClient(t)
+	srv.SetFixtureFunc(amaptest.PathPlaceV5Text, placePagesFixture(45))
+	req := &placev5text.TextSearchRequest{Keyword: "咖啡"}
+
+	// 2. 收集所有结果
+	pois, err := Collect(client.PlaceV5TextIter(context.Background(), req, &PageOptions{PageSize: 20}))
+
+	// 3. 验证结果：45 个不重复的POI，请求 3 页，原请求未被修改
+	require.NoError(t, err)
+	require.Len(t, pois, 45)
+	for i, poi := range pois {
+		assert.EqualValues(t, fmt.Sprintf("B%03d", i), poi.ID)
+	}
+	assert.Equal(t, 3, srv.Count(amaptest.PathPlaceV5Text))
+	assert.Zero(t, req.PageNum)
+}
+
+// TestPlaceV3TextIter_MaxResultsAndBreak 测试最大结果数和提前退出时不再请求后续页
+func TestPlaceV3TextIter_MaxResultsAndBreak(t *testing.T) {
+	// 1. 创建假服务器，共 200 个POI
+	client, srv := newFakeClient(t)
+	srv.SetFixtureFunc(amaptest.PathPlaceText, placePagesFixture(200))
+	req := &placev3text.TextSearchRequest{Keyword: "咖啡"}
+
+	// 2. 最多返回 30 个
+	pois, err := Collect(client.PlaceV3TextIter(context.Background(), req, &PageOptions{MaxResults: 30}))
+	require.NoError(t, err)
+	assert.Len(t, pois, 30)
+	assert.Equal(t, 2, srv.Count(amaptest.PathPlaceText))
+
+	// 3. 提前退出循环
+	srv.Reset()
+	srv.SetFixtureFunc(amaptest.PathPlaceText, placePagesFixture(200))
+	for poi, err := range client.PlaceV3TextIter(context.Background(), req, nil) {
+		require.NoError(t, err)
+		if poi.ID == "B005" {
+			break
+		}
+	}
+	assert.Equal(t, 1, srv.Count(amaptest.PathPlaceText))
+}
+
+// TestPlaceV5TextIter_Error 测试请求失败时返回已收集的结果和错误
+func TestPlaceV5TextIter_Error(t *testing.T) {
+	// 1. 创建假服务器，通过中间件让第二页返回配额超限
+	client, srv := newFakeClient(t)
+	srv.SetFixtureFunc(amaptest.PathPlaceV5Text, placePagesFixture(100))
+	calls := 0
+	client.Use(func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*amapType.BaseResponse, error) {
+			calls++
+			if calls == 2 {
+				return nil, amapErr.NewAPIError(string(amapErr.InfoCodeDailyQueryOverLimit), "DAILY_QUERY_OVER_LIMIT")
+			}
+			return next(ctx, call)
+		}
+	})
+
+	// 2. 收集结果
+	pois, err := Collect(client.PlaceV5TextIter(context.Background(), &placev5text.TextSearchRequest{Keyword: "咖啡"}, nil))
+
+	// 3. 验证返回第一页结果和配额错误
+	assert.True(t, amapErr.IsQuotaExceeded(err))
+	assert.Len(t, pois, 25)
+}
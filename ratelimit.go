@@ -0,0 +1,176 @@
+package amap
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	amapErr "github.com/enneket/amap/errors"
+)
+
+// RateLimit 单个服务族的客户端限流配置（按 API Key 独立计数）
+type RateLimit struct {
+	QPS        float64 // 每秒请求数上限（<=0 表示不限速）
+	Burst      int     // 突发容量（默认为 QPS 向上取整，至少 1）
+	DailyQuota int64   // 每日调用配额（<=0 表示不限），超出后直接返回 QuotaExceededError
+}
+
+// QuotaUsage 进程内已消耗的每日配额统计
+type QuotaUsage struct {
+	Key     string // API Key
+	Service string // 服务族（如 geocode、direction）
+	Date    string // 统计日期（北京时间，格式 2006-01-02）
+	Used    int64  // 当日已发出的请求数
+	Limit   int64  // 当日配额（0 表示未配置配额）
+}
+
+// quotaZone 高德配额按北京时间自然日重置
+var quotaZone = time.FixedZone("CST", 8*3600)
+
+// rateLimiter 按 (Key, 服务族) 维护令牌桶和每日计数
+type rateLimiter struct {
+	limits map[string]RateLimit
+	now    func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+	usage   map[string]*QuotaUsage
+}
+
+// newRateLimiter 创建限流器（未配置任何限流时返回 nil，不做计数）
+func newRateLimiter(limits map[string]RateLimit) *rateLimiter {
+	if len(limits) == 0 {
+		return nil
+	}
+	return &rateLimiter{
+		limits:  limits,
+		now:     time.Now,
+		buckets: make(map[string]*tokenBucket),
+		usage:   make(map[string]*QuotaUsage),
+	}
+}
+
+// limitOf 返回服务族的限流配置，未单独配置时使用 ServiceDefault
+func (l *rateLimiter) limitOf(service string) RateLimit {
+	if limit, ok := l.limits[service]; ok {
+		return limit
+	}
+	return l.limits[ServiceDefault]
+}
+
+// wait 扣减每日配额并等待令牌（ctx 取消时返回 CanceledError，并退还已预占的配额）
+func (l *rateLimiter) wait(ctx context.Context, key, service string) error {
+	// 未配置限流的服务不限速，但仍计入配额统计
+	limit := l.limitOf(service)
+	id := key + "|" + service
+
+	// 1. 在同一把锁内检查并预占每日配额，避免并发请求同时通过检查后超额
+	l.mu.Lock()
+	usage := l.usageLocked(id, key, service, limit)
+	if limit.DailyQuota > 0 && usage.Used >= limit.DailyQuota {
+		l.mu.Unlock()
+		return amapErr.NewQuotaExceededError(fmt.Sprintf("服务 %s 今日配额 %d 次已用完", service, limit.DailyQuota))
+	}
+	usage.Used++
+	var bucket *tokenBucket
+	if limit.QPS > 0 {
+		var ok bool
+		if bucket, ok = l.buckets[id]; !ok {
+			bucket = newTokenBucket(limit.QPS, limit.Burst, l.now())
+			l.buckets[id] = bucket
+		}
+	}
+	l.mu.Unlock()
+
+	// 2. 等待 QPS 令牌，失败时退还预占的配额（跨天后计数已重置，不再退还）
+	if bucket != nil {
+		if err := bucket.wait(ctx, l.now); err != nil {
+			l.mu.Lock()
+			if l.usage[id] == usage && usage.Used > 0 {
+				usage.Used--
+			}
+			l.mu.Unlock()
+			return err
+		}
+	}
+	return nil
+}
+
+// usageLocked 返回当日的计数项（跨天自动重置），调用方需持有锁
+func (l *rateLimiter) usageLocked(id, key, service string, limit RateLimit) *QuotaUsage {
+	today := l.now().In(quotaZone).Format(time.DateOnly)
+	usage, ok := l.usage[id]
+	if !ok || usage.Date != today {
+		usage = &QuotaUsage{Key: key, Service: service, Date: today}
+		l.usage[id] = usage
+	}
+	usage.Limit = max(limit.DailyQuota, 0)
+	return usage
+}
+
+// snapshot 返回当日配额统计快照（按 Key、服务族排序）
+func (l *rateLimiter) snapshot() []QuotaUsage {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	today := l.now().In(quotaZone).Format(time.DateOnly)
+	result := make([]QuotaUsage, 0, len(l.usage))
+	for _, usage := range l.usage {
+		if usage.Date == today {
+			result = append(result, *usage)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Key != result[j].Key {
+			return result[i].Key < result[j].Key
+		}
+		return result[i].Service < result[j].Service
+	})
+	return result
+}
+
+// tokenBucket 令牌桶（按 rate 匀速补充，容量为 burst）
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(qps float64, burst int, now time.Time) *tokenBucket {
+	if burst <= 0 {
+		burst = int(math.Max(1, math.Ceil(qps)))
+	}
+	return &tokenBucket{rate: qps, burst: float64(burst), tokens: float64(burst), last: now}
+}
+
+// wait 获取一个令牌，不足时等待补充
+func (b *tokenBucket) wait(ctx context.Context, now func() time.Time) error {
+	for {
+		b.mu.Lock()
+		current := now()
+		b.tokens = math.Min(b.burst, b.tokens+current.Sub(b.last).Seconds()*b.rate)
+		b.last = current
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		}
+		delay := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+		b.mu.Unlock()
+		if err := sleepCtx(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// QuotaUsage 返回进程内当日已消耗的配额统计（未配置 RateLimits 时返回 nil）
+func (c *Client) QuotaUsage() []QuotaUsage {
+	if c.limiter == nil {
+		return nil
+	}
+	return c.limiter.snapshot()
+}
@@ -0,0 +1,168 @@
+package amap
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	geoCode "github.com/enneket/amap/api/geo_code"
+	amapErr "github.com/enneket/amap/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestServiceOf 测试根据路径识别服务族
+func TestServiceOf(t *testing.T) {
+	cases := map[string]string{
+		"https://restapi.amap.com/v3/geocode/geo":              ServiceGeocode,
+		"/v3/geocode/regeo":                                    ServiceGeocode,
+		"https://restapi.amap.com/v3/direction/distance":       ServiceDistance,
+		"https://restapi.amap.com/v3/direction/v2/driving":     ServiceDirection,
+		"https://restapi.amap.com/v3/v4/etd/driving":           ServiceDirection,
+		"https://restapi.amap.com/v3/v5/place/text":            ServicePlace,
+		"https://restapi.amap.com/v3/assistant/inputtips":      ServiceInputtips,
+		"https://restapi.amap.com/v3/config/district":          ServiceDistrict,
+		"https://restapi.amap.com/v3/weather/weatherInfo":      ServiceWeather,
+		"https://restapi.amap.com/v3/v3/traffic/status/circle": ServiceTraffic,
+		"https://restapi.amap.com/v3/bus/lineid":               ServiceBus,
+		"https://restapi.amap.com/v3/v5/ip":                    ServiceIP,
+		"https://restapi.amap.com/v3/convert":                  ServiceConvert,
+		"https://restapi.amap.com/v3/grasproad":                ServiceGraspRoad,
+		"https://restapi.amap.com/v3/position/v1/hardware":     ServicePosition,
+		"/test/path": "",
+	}
+	for path, expected := range cases {
+		assert.Equal(t, expected, ServiceOf(path), path)
+	}
+}
+
+// TestRateLimit_QPS 测试按服务族的QPS限流
+func TestRateLimit_QPS(t *testing.T) {
+	// 1. 创建mock服务器
+	mockServer := mockResponse(http.StatusOK, `{"status":"1","info":"OK","infocode":"10000","count":"0","geocodes":[]}`)
+	defer mockServer.Close()
+
+	// 2. 创建Client实例，地理编码限速 20 QPS（突发 1）
+	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
+	config.RateLimits = map[string]RateLimit{ServiceGeocode: {QPS: 20, Burst: 1}}
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	// 3. 连续发出 5 次请求，至少需要 4 个令牌补充间隔（200ms）
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		_, err := client.GeoCode(&geoCode.GeocodeRequest{Address: "北京市朝阳区"})
+		require.NoError(t, err)
+	}
+
+	// 4. 验证结果
+	assert.GreaterOrEqual(t, time.Since(start), 180*time.Millisecond)
+	usage := client.QuotaUsage()
+	require.Len(t, usage, 1)
+	assert.Equal(t, "test_key", usage[0].Key)
+	assert.Equal(t, ServiceGeocode, usage[0].Service)
+	assert.Equal(t, int64(5), usage[0].Used)
+}
+
+// TestRateLimit_DailyQuota 测试每日配额耗尽后不再发出请求
+func TestRateLimit_DailyQuota(t *testing.T) {
+	// 1. 创建mock服务器，记录请求次数
+	requests := 0
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`{"status":"1","info":"OK","infocode":"10000"}`))
+	}))
+	defer mockServer.Close()
+
+	// 2. 创建Client实例，默认配额 2 次/天
+	config := NewConfig("test_key")
+	config.BaseURL = mockServer.URL
+	config.RateLimits = map[string]RateLimit{ServiceDefault: {DailyQuota: 2}}
+	client, err := NewClient(config)
+	require.NoError(t, err)
+
+	// 3. 执行 3 次请求
+	var resp TestResponse
+	require.NoError(t, client.DoRequest(http.MethodGet, "/v3/weather/weatherInfo", nil, &resp))
+	require.NoError(t, client.DoRequest(http.MethodGet, "/v3/weather/weatherInfo", nil, &resp))
+	err = client.DoRequest(http.MethodGet, "/v3/weather/weatherInfo", nil, &resp)
+
+	// 4. 验证结果
+	assert.IsType(t, amapErr.QuotaExceededError(""), err)
+	assert.Equal(t, 2, requests)
+	usage := client.QuotaUsage()
+	require.Len(t, usage, 1)
+	assert.Equal(t, int64(2), usage[0].Used)
+	assert.Equal(t, int64(2), usage[0].Limit)
+}
+
+// TestRateLimit_RespectsContext 测试等待令牌期间ctx取消
+func TestRateLimit_RespectsContext(t *testing.T) {
+	limiter := newRateLimiter(map[string]RateLimit{ServiceGeocode: {QPS: 0.1, Burst: 1}})
+	require.NoError(t, limiter.wait(context.Background(), "k", ServiceGeocode))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	err := limiter.wait(ctx, "k", ServiceGeocode)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+	// 不同 Key 的令牌桶互不影响
+	assert.NoError(t, limiter.wait(context.Background(), "other", ServiceGeocode))
+}
+
+// TestRateLimit_DailyReset 测试跨天后配额计数重置
+func TestRateLimit_DailyReset(t *testing.T) {
+	now := time.Date(2024, 5, 1, 23, 59, 0, 0, quotaZone)
+	limiter := newRateLimiter(map[string]RateLimit{ServiceDefault: {DailyQuota: 1}})
+	limiter.now = func() time.Time { return now }
+
+	require.NoError(t, limiter.wait(context.Background(), "k", ServicePlace))
+	assert.Error(t, limiter.wait(context.Background(), "k", ServicePlace))
+
+	now = now.Add(2 * time.Minute)
+	assert.NoError(t, limiter.wait(context.Background(), "k", ServicePlace))
+	assert.Equal(t, "2024-05-02", limiter.snapshot()[0].Date)
+}
+
+// TestRateLimit_DailyQuotaConcurrent 测试并发请求不会超出每日配额
+func TestRateLimit_DailyQuotaConcurrent(t *testing.T) {
+	limiter := newRateLimiter(map[string]RateLimit{ServiceDefault: {QPS: 1000, Burst: 1, DailyQuota: 5}})
+
+	// 1. 并发发起 20 次调用
+	var passed atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if limiter.wait(context.Background(), "k", ServicePlace) == nil {
+				passed.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// 2. 只有配额内的调用通过
+	assert.Equal(t, int64(5), passed.Load())
+	assert.Equal(t, int64(5), limiter.snapshot()[0].Used)
+}
+
+// TestRateLimit_ReleaseOnCancel 测试等待令牌失败时退还预占的配额
+func TestRateLimit_ReleaseOnCancel(t *testing.T) {
+	limiter := newRateLimiter(map[string]RateLimit{ServiceGeocode: {QPS: 0.1, Burst: 1, DailyQuota: 2}})
+	require.NoError(t, limiter.wait(context.Background(), "k", ServiceGeocode))
+
+	// 1. 等待令牌期间 ctx 超时
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	require.Error(t, limiter.wait(ctx, "k", ServiceGeocode))
+
+	// 2. 超时的调用不计入配额
+	assert.Equal(t, int64(1), limiter.snapshot()[0].Used)
+}
@@ -0,0 +1,267 @@
+// Package recorder 提供录制/回放 HTTP 请求的传输层，用于在无网络、无真实 Key 的环境下离线测试 Client
+//
+// 录制时请求与响应按「路径 + 规范化参数」保存为 JSON 文件（key、sig、timestamp 不写入文件），
+// 回放时按同样的规则匹配，因此录制时使用的 Key 和签名不会泄露，回放时也无需配置真实 Key。
+package recorder
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Mode 录制器工作模式
+type Mode int
+
+const (
+	ModeReplay         Mode = iota // 只回放：未找到录制时返回错误，不发出真实请求
+	ModeRecord                     // 只录制：总是发出真实请求并覆盖已有录制
+	ModeReplayOrRecord             // 优先回放，未找到录制时发出真实请求并录制
+)
+
+// EnvRecord 控制 ModeFromEnv 的环境变量名
+const EnvRecord = "AMAP_RECORD"
+
+// ScrubbedParams 不参与匹配、不写入录制文件的参数
+var ScrubbedParams = []string{"key", "sig", "timestamp"}
+
+// Interaction 一次录制的请求/响应
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest 录制的请求（参数已去除 ScrubbedParams）
+type RecordedRequest struct {
+	Method string            `json:"method"`
+	Path   string            `json:"path"`
+	Params map[string]string `json:"params"`
+}
+
+// RecordedResponse 录制的响应
+type RecordedResponse struct {
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        string `json:"body"`
+}
+
+// Recorder 录制/回放传输层（实现 http.RoundTripper，可设置到 amap.Config.Transport）
+type Recorder struct {
+	dir  string
+	mode Mode
+	next http.RoundTripper
+	mu   sync.Mutex // 保护录制文件写入
+}
+
+// New 创建录制器：dir 为录制文件目录，next 为真实请求使用的传输层（nil 时使用 http.DefaultTransport）
+func New(dir string, mode Mode, next http.RoundTripper) *Recorder {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &Recorder{dir: dir, mode: mode, next: next}
+}
+
+// ModeFromEnv 根据环境变量 AMAP_RECORD 选择模式：
+// "1"/"record" 为 ModeRecord，"auto" 为 ModeReplayOrRecord，其余为 ModeReplay
+func ModeFromEnv() Mode {
+	switch strings.ToLower(os.Getenv(EnvRecord)) {
+	case "1", "true", "record":
+		return ModeRecord
+	case "auto":
+		return ModeReplayOrRecord
+	default:
+		return ModeReplay
+	}
+}
+
+// RoundTrip 实现 http.RoundTripper
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	// 1. 读取请求参数（GET 取查询参数，POST 取表单请求体）
+	recorded, out, err := readRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	file := r.path(recorded)
+	// 2. 回放
+	if r.mode != ModeRecord {
+		interaction, err := load(file)
+		if err == nil {
+			if out.Body != nil {
+				out.Body.Close()
+			}
+			return interaction.Response.toHTTP(req), nil
+		}
+		if r.mode == ModeReplay || !os.IsNotExist(err) {
+			return nil, fmt.Errorf("recorder: 未找到录制 %s %s?%s（%s）: %w",
+				recorded.Method, recorded.Path, Normalize(recorded.Params), file, err)
+		}
+	}
+	// 3. 发出真实请求并录制
+	resp, err := r.next.RoundTrip(out)
+	if err != nil {
+		return nil, err
+	}
+	resp.Request = req
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	interaction := Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode:  resp.StatusCode,
+			ContentType: resp.Header.Get("Content-Type"),
+			Body:        string(body),
+		},
+	}
+	if err := r.save(file, interaction); err != nil {
+		return nil, fmt.Errorf("recorder: 写入录制失败: %w", err)
+	}
+	return resp, nil
+}
+
+// path 录制文件路径：<dir>/<请求路径>/<规范化参数摘要>.json
+func (r *Recorder) path(req RecordedRequest) string {
+	sum := sha256.Sum256([]byte(req.Method + " " + Normalize(req.Params)))
+	dir := strings.Trim(strings.ReplaceAll(req.Path, "/", "_"), "_")
+	if dir == "" {
+		dir = "root"
+	}
+	return filepath.Join(r.dir, dir, hex.EncodeToString(sum[:8])+".json")
+}
+
+// save 写入录制文件（先写临时文件再重命名）
+func (r *Recorder) save(file string, interaction Interaction) error {
+	data, err := json.MarshalIndent(interaction, "", "  ")
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return err
+	}
+	tmp := file + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, file)
+}
+
+// load 读取录制文件
+func load(file string) (*Interaction, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var interaction Interaction
+	if err := json.Unmarshal(data, &interaction); err != nil {
+		return nil, err
+	}
+	return &interaction, nil
+}
+
+// Normalize 规范化请求参数：去除 ScrubbedParams 和空值，按参数名排序编码
+func Normalize(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k, v := range params {
+		if v != "" && !scrubbed(k) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, url.QueryEscape(k)+"="+url.QueryEscape(params[k]))
+	}
+	return strings.Join(parts, "&")
+}
+
+// scrubbed 判断参数是否需要去除
+func scrubbed(name string) bool {
+	for _, s := range ScrubbedParams {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+// readRequest 提取请求方法、路径和参数，并返回用于发出真实请求的 *http.Request
+// 按 http.RoundTripper 的约定不修改调用方的请求：POST 请求体优先通过 GetBody 读取副本，
+// 否则读取后写入 req 的克隆中
+func readRequest(req *http.Request) (RecordedRequest, *http.Request, error) {
+	values := req.URL.Query()
+	out := req
+	if req.Body != nil && req.Body != http.NoBody && req.Method != http.MethodGet {
+		var body []byte
+		var err error
+		if req.GetBody != nil {
+			body, err = readBody(req.GetBody)
+		} else {
+			body, err = io.ReadAll(req.Body)
+			req.Body.Close()
+			out = req.Clone(req.Context())
+			out.Body = io.NopCloser(bytes.NewReader(body))
+			out.GetBody = func() (io.ReadCloser, error) { return io.NopCloser(bytes.NewReader(body)), nil }
+		}
+		if err != nil {
+			return RecordedRequest{}, nil, err
+		}
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return RecordedRequest{}, nil, fmt.Errorf("recorder: 无法解析请求体: %w", err)
+		}
+		for k, v := range form {
+			values[k] = v
+		}
+	}
+	params := make(map[string]string, len(values))
+	for k := range values {
+		if v := values.Get(k); v != "" && !scrubbed(k) {
+			params[k] = v
+		}
+	}
+	return RecordedRequest{Method: req.Method, Path: req.URL.Path, Params: params}, out, nil
+}
+
+// readBody 通过 GetBody 读取请求体副本
+func readBody(getBody func() (io.ReadCloser, error)) ([]byte, error) {
+	rc, err := getBody()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+// toHTTP 将录制的响应转换为 http.Response
+func (r RecordedResponse) toHTTP(req *http.Request) *http.Response {
+	header := http.Header{}
+	if r.ContentType != "" {
+		header.Set("Content-Type", r.ContentType)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
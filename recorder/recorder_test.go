@@ -0,0 +1,135 @@
+package recorder
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// 测试录制后离线回放，且录制文件不包含 key、sig、timestamp
+func TestRecordThenReplay(t *testing.T) {
+	dir := t.TempDir()
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"1","info":"OK","infocode":"10000","address":"` + r.URL.Query().Get("address") + `"}`))
+	}))
+
+	// 1. 录制
+	client := &http.Client{Transport: New(dir, ModeRecord, nil)}
+	body := get(t, client, server.URL+"/v3/geocode/geo?address=beijing&key=secret_key&sig=abc&timestamp=1")
+	if !strings.Contains(body, `"address":"beijing"`) {
+		t.Fatalf("录制时响应错误：%s", body)
+	}
+	server.Close()
+
+	files, _ := filepath.Glob(filepath.Join(dir, "v3_geocode_geo", "*.json"))
+	if len(files) != 1 {
+		t.Fatalf("录制文件数错误：%v", files)
+	}
+	data, _ := os.ReadFile(files[0])
+	for _, secret := range []string{"secret_key", "sig", "timestamp"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("录制文件不应包含 %s：%s", secret, data)
+		}
+	}
+
+	// 2. 回放（服务器已关闭，key、sig、timestamp 不同也能匹配）
+	client = &http.Client{Transport: New(dir, ModeReplay, nil)}
+	replayed := get(t, client, server.URL+"/v3/geocode/geo?timestamp=2&address=beijing&key=other&sig=def")
+	if replayed != body || requests != 1 {
+		t.Errorf("回放响应错误：%s（请求数 %d）", replayed, requests)
+	}
+
+	// 3. 参数不同则未命中
+	if _, err := client.Get(server.URL + "/v3/geocode/geo?address=shanghai"); err == nil || !strings.Contains(err.Error(), "未找到录制") {
+		t.Errorf("未录制的请求应返回错误：%v", err)
+	}
+}
+
+// 测试优先回放、缺失时录制，POST 表单参数参与匹配
+func TestReplayOrRecordPost(t *testing.T) {
+	dir := t.TempDir()
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_ = r.ParseForm()
+		_, _ = w.Write([]byte(`{"status":"1","locations":"` + r.PostForm.Get("locations") + `"}`))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: New(dir, ModeReplayOrRecord, nil)}
+	post := func(locations string) string {
+		resp, err := client.Post(server.URL+"/v3/assistant/coordinate/convert", "application/x-www-form-urlencoded",
+			strings.NewReader("key=k&locations="+locations))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		data, _ := io.ReadAll(resp.Body)
+		return string(data)
+	}
+	first := post("1,2")
+	if post("1,2") != first || requests != 1 {
+		t.Errorf("重复请求应回放，请求数：%d", requests)
+	}
+	if !strings.Contains(post("3,4"), "3,4") || requests != 2 {
+		t.Errorf("新参数应录制，请求数：%d", requests)
+	}
+}
+
+// 测试 RoundTrip 不修改调用方的请求（请求体没有 GetBody 时也能正确转发）
+func TestRoundTripKeepsRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		_, _ = w.Write([]byte(`{"status":"1","locations":"` + r.PostForm.Get("locations") + `"}`))
+	}))
+	defer server.Close()
+
+	body := io.NopCloser(strings.NewReader("key=k&locations=5,6"))
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/v3/assistant/coordinate/convert", body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := New(t.TempDir(), ModeRecord, nil).RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	data, _ := io.ReadAll(resp.Body)
+	if !strings.Contains(string(data), "5,6") {
+		t.Errorf("请求体未转发：%s", data)
+	}
+	if req.Body != body || resp.Request != req {
+		t.Error("RoundTrip 不应修改调用方的请求")
+	}
+}
+
+// 测试参数规范化
+func TestNormalize(t *testing.T) {
+	got := Normalize(map[string]string{"b": "2", "a": "x y", "key": "k", "sig": "s", "timestamp": "1", "empty": ""})
+	if got != "a=x+y&b=2" {
+		t.Errorf("规范化结果错误：%s", got)
+	}
+}
+
+// get 发出 GET 请求并返回响应体
+func get(t *testing.T, client *http.Client, url string) string {
+	t.Helper()
+	resp, err := client.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
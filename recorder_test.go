@@ -0,0 +1,43 @@
+package amap
+
+import (
+	"net/http"
+	"testing"
+
+	geoCode "github.com/enneket/amap/api/geo_code"
+	"github.com/enneket/amap/recorder"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestConfig_TransportRecordReplay 测试通过 Config.Transport 录制后离线回放
+func TestConfig_TransportRecordReplay(t *testing.T) {
+	// 1. 创建mock服务器
+	dir := t.TempDir()
+	mockServer := mockResponse(http.StatusOK, `{"status":"1","info":"OK","infocode":"10000","count":"1","geocodes":[{"formatted_address":"北京市朝阳区","location":"116.443,39.921"}]}`)
+
+	// 2. 使用真实Key和安全密钥录制
+	config := NewConfig("real_key")
+	config.SecurityKey = "real_secret"
+	config.BaseURL = mockServer.URL
+	config.Transport = recorder.New(dir, recorder.ModeRecord, nil)
+	client, err := NewClient(config)
+	require.NoError(t, err)
+	recorded, err := client.GeoCode(&geoCode.GeocodeRequest{Address: "北京市朝阳区"})
+	require.NoError(t, err)
+	mockServer.Close()
+
+	// 3. 关闭服务器后使用其他Key回放
+	config = NewConfig("fake_key")
+	config.BaseURL = mockServer.URL
+	config.Transport = recorder.New(dir, recorder.ModeReplay, nil)
+	client, err = NewClient(config)
+	require.NoError(t, err)
+	replayed, err := client.GeoCode(&geoCode.GeocodeRequest{Address: "北京市朝阳区"})
+
+	// 4. 验证结果
+	require.NoError(t, err)
+	assert.Equal(t, recorded.Geocodes, replayed.Geocodes)
+	_, err = client.GeoCode(&geoCode.GeocodeRequest{Address: "上海市"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,24 @@
+package amap
+
+import "context"
+
+// Request 高德接口请求：api 包下的各请求参数结构体均实现该接口
+// 自定义的请求类型实现该接口后，也可以通过 Do 调用本库尚未封装的接口
+type Request interface {
+	Endpoint() string            // 接口名称（Endpoints 中的 Name）；未登记的接口可返回路径（如 /v3/xxx），以 GET 请求发送
+	Validate() error             // 发送前校验参数（参数错误返回 InvalidConfigError）
+	ToParams() map[string]string // 业务参数（Key、签名等公共参数由 Client 添加）
+}
+
+// Do 通用请求入口：校验参数 → 转换为请求参数 → 按接口表发送请求 → 解析为 Resp
+// Client 的各个接口方法均由 Do 实现，如 Do[geoCode.GeoCodeResponse](ctx, client, req)
+func Do[Resp any](ctx context.Context, c *Client, req Request) (*Resp, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	var resp Resp
+	if err := c.callEndpoint(ctx, req.Endpoint(), req.ToParams(), &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
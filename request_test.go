@@ -0,0 +1,175 @@
+package amap
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/enneket/amap/amaptest"
+	busLineID "github.com/enneket/amap/api/bus/line_id"
+	busLineKeyword "github.com/enneket/amap/api/bus/line_keyword"
+	busStationID "github.com/enneket/amap/api/bus/station_id"
+	busStationKeyword "github.com/enneket/amap/api/bus/station_keyword"
+	convert "github.com/enneket/amap/api/convert"
+	bicyclingV1 "github.com/enneket/amap/api/direction/v1/bicycling"
+	busV1 "github.com/enneket/amap/api/direction/v1/bus"
+	drivingV1 "github.com/enneket/amap/api/direction/v1/driving"
+	walkingV1 "github.com/enneket/amap/api/direction/v1/walking"
+	bicyclingV2 "github.com/enneket/amap/api/direction/v2/bicycling"
+	busV2 "github.com/enneket/amap/api/direction/v2/bus"
+	drivingV2 "github.com/enneket/amap/api/direction/v2/driving"
+	electricV2 "github.com/enneket/amap/api/direction/v2/electric"
+	walkingV2 "github.com/enneket/amap/api/direction/v2/walking"
+	bicyclingV5 "github.com/enneket/amap/api/direction/v5/bicycling"
+	drivingV5 "github.com/enneket/amap/api/direction/v5/driving"
+	electrobikeV5 "github.com/enneket/amap/api/direction/v5/electrobike"
+	transitV5 "github.com/enneket/amap/api/direction/v5/transit"
+	walkingV5 "github.com/enneket/amap/api/direction/v5/walking"
+	distance "github.com/enneket/amap/api/distance"
+	district "github.com/enneket/amap/api/district"
+	etdDrivingV4 "github.com/enneket/amap/api/etd/v4/driving"
+	geoCode "github.com/enneket/amap/api/geo_code"
+	grasproad "github.com/enneket/amap/api/grasproad"
+	inputtips "github.com/enneket/amap/api/input_tips"
+	ipV3 "github.com/enneket/amap/api/ip/v3"
+	ipV5 "github.com/enneket/amap/api/ip/v5"
+	placev3aoi "github.com/enneket/amap/api/place/v3/aoi"
+	placev3around "github.com/enneket/amap/api/place/v3/around"
+	placev3id "github.com/enneket/amap/api/place/v3/id"
+	placev3polygon "github.com/enneket/amap/api/place/v3/polygon"
+	placev3text "github.com/enneket/amap/api/place/v3/text"
+	placev5aoi "github.com/enneket/amap/api/place/v5/aoi"
+	placev5around "github.com/enneket/amap/api/place/v5/around"
+	placev5id "github.com/enneket/amap/api/place/v5/id"
+	placev5polygon "github.com/enneket/amap/api/place/v5/polygon"
+	placev5text "github.com/enneket/amap/api/place/v5/text"
+	positionV1 "github.com/enneket/amap/api/position/v1"
+	positionV5 "github.com/enneket/amap/api/position/v5"
+	reGeoCode "github.com/enneket/amap/api/re_geo_code"
+	trafficIncident "github.com/enneket/amap/api/traffic_incident"
+	circle "github.com/enneket/amap/api/traffic_situation/circle"
+	line "github.com/enneket/amap/api/traffic_situation/line"
+	rectangle "github.com/enneket/amap/api/traffic_situation/rectangle"
+	"github.com/enneket/amap/api/weatherinfo"
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRequest_Endpoints 测试 api 包的全部请求类型都实现 Request，且接口名称均已登记
+func TestRequest_Endpoints(t *testing.T) {
+	requests := []Request{
+		&geoCode.GeocodeRequest{}, &geoCode.BatchGeocodeRequest{},
+		&reGeoCode.ReGeocodeRequest{}, &reGeoCode.BatchReGeocodeRequest{},
+		&walkingV1.WalkingRequest{}, &drivingV1.DrivingRequest{}, &bicyclingV1.BicyclingRequest{}, &busV1.BusRequest{},
+		&walkingV2.WalkingRequestV2{}, &drivingV2.DrivingRequestV2{}, &bicyclingV2.BicyclingRequestV2{},
+		&busV2.BusRequestV2{}, &electricV2.ElectricRequestV2{},
+		&drivingV5.DrivingRequestV5{}, &walkingV5.WalkingRequestV5{}, &bicyclingV5.BicyclingRequestV5{},
+		&electrobikeV5.ElectrobikeRequestV5{}, &transitV5.TransitRequestV5{},
+		&etdDrivingV4.ETDDrivingRequestV4{}, &distance.DistanceRequest{}, &district.DistrictRequest{},
+		&trafficIncident.TrafficIncidentRequest{}, &line.LineTrafficRequest{}, &circle.CircleTrafficRequest{},
+		&rectangle.RectangleTrafficRequest{}, &ipV3.IPConfigRequest{}, &ipV5.IPConfigRequest{},
+		&convert.ConvertRequest{}, &grasproad.GraspRoadRequest{},
+		&placev3id.IDRequest{}, &placev3text.TextSearchRequest{}, &placev3around.AroundSearchRequest{},
+		&placev3polygon.PolygonSearchRequest{}, &placev3aoi.AOISearchRequest{},
+		&placev5id.IDRequest{}, &placev5text.TextSearchRequest{}, &placev5around.AroundSearchRequest{},
+		&placev5polygon.PolygonSearchRequest{}, &placev5aoi.AOISearchRequest{},
+		&inputtips.InputtipsRequest{}, &weatherinfo.WeatherinfoRequest{},
+		&positionV1.HardwarePositionRequest{}, &positionV5.HardwarePositionRequest{},
+		&busStationID.StationIDRequest{}, &busStationKeyword.StationKeywordRequest{},
+		&busLineID.LineIDRequest{}, &busLineKeyword.LineKeywordRequest{},
+	}
+	covered := map[string]bool{}
+	for _, req := range requests {
+		_, ok := LookupEndpoint(req.Endpoint())
+		assert.True(t, ok, "未登记的接口：%s", req.Endpoint())
+		covered[req.Endpoint()] = true
+	}
+	assert.Len(t, covered, len(Endpoints()))
+}
+
+// echoRequest 本库未封装的接口（模拟第三方自定义请求类型）
+type echoRequest struct {
+	Word string
+}
+
+func (req *echoRequest) Endpoint() string { return "/v3/custom/echo" }
+
+func (req *echoRequest) Validate() error {
+	if req.Word == "" {
+		return amapErr.NewInvalidConfigError("echo：word参数不能为空")
+	}
+	return nil
+}
+
+func (req *echoRequest) ToParams() map[string]string {
+	return map[string]string{"word": req.Word}
+}
+
+// echoResponse 自定义接口的响应
+type echoResponse struct {
+	amapType.BaseResponse
+	Word string `json:"word"`
+}
+
+// TestDo 测试通用请求入口：登记的接口、自定义接口和参数校验
+func TestDo(t *testing.T) {
+	// 1. 创建假服务器和Client
+	client, srv := newFakeClient(t)
+	srv.SetFixtureFunc("/v3/custom/echo", func(params url.Values) string {
+		return `{"status":"1","info":"OK","infocode":"10000","word":"` + params.Get("word") + `"}`
+	})
+
+	// 2. 登记的接口：与 Client 方法等价
+	resp, err := Do[geoCode.GeoCodeResponse](context.Background(), client, &geoCode.GeocodeRequest{Address: "北京市朝阳区望京SOHO"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.Geocodes)
+	assert.Equal(t, 1, srv.Count(amaptest.PathGeocode))
+
+	// 3. 未登记的接口：按路径以 GET 请求发送
+	echo, err := Do[echoResponse](context.Background(), client, &echoRequest{Word: "hello"})
+	require.NoError(t, err)
+	assert.Equal(t, "hello", echo.Word)
+
+	// 4. 参数校验失败时不发送请求
+	_, err = Do[echoResponse](context.Background(), client, &echoRequest{})
+	var configErr amapErr.InvalidConfigError
+	assert.True(t, errors.As(err, &configErr))
+	_, err = Do[geoCode.GeoCodeResponse](context.Background(), client, &reGeoCode.ReGeocodeRequest{Location: amapType.LngLat{Lng: 200, Lat: 39}})
+	assert.Error(t, err)
+	assert.Equal(t, 2, srv.Count(""))
+}
+
+// TestRequest_CoordinateParams 测试坐标类型参数按各接口要求的格式发送
+func TestRequest_CoordinateParams(t *testing.T) {
+	a, b := amapType.LngLat{Lng: 116.3, Lat: 39.9}, amapType.LngLat{Lng: 116.4, Lat: 39.95}
+	bounds := amapType.Bounds{SouthWest: a, NorthEast: b}
+
+	// 1. 坐标列表与矩形的分隔符
+	assert.Equal(t, "116.3,39.9|116.4,39.95", (&distance.DistanceRequest{Origins: amapType.LngLatList{a, b}, Destination: b}).ToParams()["origins"])
+	assert.Equal(t, "116.3,39.9;116.4,39.95", (&line.LineTrafficRequest{Path: amapType.LngLatList{a, b}}).ToParams()["path"])
+	assert.Equal(t, "116.3,39.9|116.4,39.95", (&placev3polygon.PolygonSearchRequest{Polygon: amapType.LngLatList{a, b}}).ToParams()["polygon"])
+	assert.Equal(t, "116.3,39.9;116.4,39.95", (&rectangle.RectangleTrafficRequest{Rectangle: bounds}).ToParams()["rectangle"])
+	assert.Equal(t, "116.3,39.9,116.4,39.95", (&trafficIncident.TrafficIncidentRequest{Rectangle: bounds}).ToParams()["rectangle"])
+	assert.Equal(t, "116.3,39.9,116.4,39.95", (&drivingV2.DrivingRequestV2{Origin: a, Destination: b, AvoidArea: bounds}).ToParams()["avoid_area"])
+	params := (&drivingV1.DrivingRequest{
+		Origin:        a,
+		Destination:   b,
+		Waypoints:     amapType.LngLatList{a, b},
+		AvoidPolygons: []amapType.LngLatList{{a, b, a}, {b, a, b}},
+	}).ToParams()
+	assert.Equal(t, "116.3,39.9;116.4,39.95", params["waypoints"])
+	assert.Equal(t, "116.3,39.9;116.4,39.95;116.3,39.9|116.4,39.95;116.3,39.9;116.4,39.95", params["avoidpolygons"])
+
+	// 2. 可选坐标为零值时不发送
+	assert.NotContains(t, (&inputtips.InputtipsRequest{Keywords: "肯德基"}).ToParams(), "location")
+	assert.NotContains(t, (&placev5text.TextSearchRequest{Keyword: "肯德基"}).ToParams(), "origin")
+	assert.NotContains(t, (&placev3text.TextSearchRequest{Keyword: "肯德基"}).ToParams(), "rectangle")
+
+	// 3. 超出范围的坐标校验失败
+	assert.Error(t, (&circle.CircleTrafficRequest{Center: amapType.LngLat{Lng: 200, Lat: 39.9}, Radius: "1000"}).Validate())
+	assert.Error(t, (&placev5around.AroundSearchRequest{Location: a, Origin: amapType.LngLat{Lng: 116.3, Lat: 95}}).Validate())
+	assert.Error(t, (&etdDrivingV4.ETDDrivingRequestV4{Origin: a, Destination: b, DepartureTime: "2024-05-01 08:00", Waypoints: amapType.LngLatList{{Lng: 190, Lat: 39}}}).Validate())
+}
@@ -0,0 +1,108 @@
+package amap
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"time"
+
+	amapErr "github.com/enneket/amap/errors"
+)
+
+// RetryPolicy 重试策略（作用于 DoRequest，对所有 API 方法统一生效）
+// 重试间隔按指数退避计算：BaseDelay * 2^(n-1)，不超过 MaxDelay，并叠加 ±Jitter 比例的随机抖动
+type RetryPolicy struct {
+	MaxAttempts         int           // 最大尝试次数（含首次，<=1 表示不重试）
+	BaseDelay           time.Duration // 首次重试前的等待时间（默认 200ms）
+	MaxDelay            time.Duration // 单次等待上限（默认 5s）
+	Jitter              float64       // 抖动比例（0~1，如 0.2 表示 ±20%）
+	RetryNetworkErrors  bool          // 是否重试网络错误（连接失败、超时等）
+	RetryableInfoCodes  []string      // 可重试的高德 infocode（如 10004、10021）
+	RetryableHTTPStatus []int         // 可重试的 HTTP 状态码（如 429、503）
+}
+
+// DefaultRetryPolicy 创建默认重试策略：最多 3 次尝试，重试网络错误、临时性 infocode（见 errors.RetryableInfoCodes）及 429/5xx 网关错误
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:        3,
+		BaseDelay:          200 * time.Millisecond,
+		MaxDelay:           5 * time.Second,
+		Jitter:             0.2,
+		RetryNetworkErrors: true,
+		RetryableInfoCodes: amapErr.RetryableInfoCodes(),
+		RetryableHTTPStatus: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// maxAttempts 返回有效的最大尝试次数（至少 1 次）
+func (p *RetryPolicy) maxAttempts() int {
+	if p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// backoff 计算第 attempt 次失败后的等待时间
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	base := p.BaseDelay
+	if base <= 0 {
+		base = 200 * time.Millisecond
+	}
+	maxDelay := p.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = 5 * time.Second
+	}
+	delay := base
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	if p.Jitter > 0 {
+		// 在 [1-Jitter, 1+Jitter] 区间内随机缩放
+		delay = time.Duration(float64(delay) * (1 + p.Jitter*(2*rand.Float64()-1)))
+	}
+	return delay
+}
+
+// shouldRetry 判断错误是否可重试（ctx 取消、参数错误、解析错误均不重试）
+func (p *RetryPolicy) shouldRetry(err error) bool {
+	var apiErr *amapErr.APIError
+	if errors.As(err, &apiErr) {
+		return slices.Contains(p.RetryableInfoCodes, apiErr.Code)
+	}
+	var statusErr *amapErr.HTTPStatusError
+	if errors.As(err, &statusErr) {
+		return p.retryableStatus(statusErr.StatusCode)
+	}
+	var netErr amapErr.NetworkError
+	if errors.As(err, &netErr) {
+		return p.RetryNetworkErrors
+	}
+	return false
+}
+
+// retryableStatus 判断 HTTP 状态码是否可重试
+func (p *RetryPolicy) retryableStatus(code int) bool {
+	return slices.Contains(p.RetryableHTTPStatus, code)
+}
+
+// sleepCtx 等待指定时间，期间 ctx 取消则返回 CanceledError
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return amapErr.NewCanceledError(ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,139 @@
+package amap
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	amapErr "github.com/enneket/amap/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRetryTestClient 创建使用快速退避重试策略的Client
+func newRetryTestClient(t *testing.T, baseURL string, maxAttempts int) *Client {
+	config := NewConfig("test_key")
+	config.BaseURL = baseURL
+	config.Retry = DefaultRetryPolicy()
+	config.Retry.MaxAttempts = maxAttempts
+	config.Retry.BaseDelay = time.Millisecond
+	config.Retry.MaxDelay = 5 * time.Millisecond
+	client, err := NewClient(config)
+	require.NoError(t, err)
+	return client
+}
+
+// TestRetry_InfoCodeThenSuccess 测试QPS超限infocode重试后成功
+func TestRetry_InfoCodeThenSuccess(t *testing.T) {
+	// 1. 创建mock服务器，前两次返回QPS超限
+	var calls int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			_, _ = w.Write([]byte(`{"status":"0","info":"CUQPS_HAS_EXCEEDED_THE_LIMIT","infocode":"10021"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"1","info":"OK","infocode":"10000","result":"ok"}`))
+	}))
+	defer mockServer.Close()
+
+	// 2. 执行请求
+	client := newRetryTestClient(t, mockServer.URL, 3)
+	var resp TestResponse
+	err := client.DoRequest(http.MethodGet, "/test/path", nil, &resp)
+
+	// 3. 验证结果
+	assert.NoError(t, err)
+	assert.Equal(t, "ok", resp.Result)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+// TestRetry_Exhausted 测试重试耗尽后返回RetryError并携带尝试次数
+func TestRetry_Exhausted(t *testing.T) {
+	// 1. 创建mock服务器，始终返回503
+	var calls int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer mockServer.Close()
+
+	// 2. 执行请求
+	client := newRetryTestClient(t, mockServer.URL, 4)
+	var resp TestResponse
+	err := client.DoRequest(http.MethodGet, "/test/path", nil, &resp)
+
+	// 3. 验证结果
+	var retryErr *amapErr.RetryError
+	require.True(t, errors.As(err, &retryErr))
+	assert.Equal(t, 4, retryErr.Attempts)
+	var statusErr *amapErr.HTTPStatusError
+	require.True(t, errors.As(err, &statusErr))
+	assert.Equal(t, http.StatusServiceUnavailable, statusErr.StatusCode)
+	assert.Equal(t, int32(4), atomic.LoadInt32(&calls))
+}
+
+// TestRetry_NonRetryableInfoCode 测试不可重试的infocode只尝试一次
+func TestRetry_NonRetryableInfoCode(t *testing.T) {
+	// 1. 创建mock服务器，返回Key无效
+	var calls int32
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		_, _ = w.Write([]byte(`{"status":"0","info":"INVALID_USER_KEY","infocode":"10001"}`))
+	}))
+	defer mockServer.Close()
+
+	// 2. 执行请求
+	client := newRetryTestClient(t, mockServer.URL, 3)
+	var resp TestResponse
+	err := client.DoRequest(http.MethodGet, "/test/path", nil, &resp)
+
+	// 3. 验证结果
+	var retryErr *amapErr.RetryError
+	require.True(t, errors.As(err, &retryErr))
+	assert.Equal(t, 1, retryErr.Attempts)
+	var apiErr *amapErr.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "10001", apiErr.Code)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+// TestRetry_CanceledDuringBackoff 测试退避等待期间ctx取消
+func TestRetry_CanceledDuringBackoff(t *testing.T) {
+	// 1. 创建mock服务器，始终返回QPS超限
+	mockServer := mockResponse(http.StatusOK, `{"status":"0","info":"ACCESS_TOO_FREQUENT","infocode":"10004"}`)
+	defer mockServer.Close()
+
+	// 2. 使用较长的退避时间，并在退避期间取消ctx
+	client := newRetryTestClient(t, mockServer.URL, 3)
+	client.config.Retry.BaseDelay = time.Second
+	client.config.Retry.MaxDelay = time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	var resp TestResponse
+	err := client.DoRequestCtx(ctx, http.MethodGet, "/test/path", nil, &resp)
+
+	// 3. 验证结果
+	var retryErr *amapErr.RetryError
+	require.True(t, errors.As(err, &retryErr))
+	assert.Equal(t, 1, retryErr.Attempts)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+// TestRetryPolicy_Backoff 测试指数退避上限
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := &RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	assert.Equal(t, 100*time.Millisecond, policy.backoff(1))
+	assert.Equal(t, 200*time.Millisecond, policy.backoff(2))
+	assert.Equal(t, 800*time.Millisecond, policy.backoff(4))
+	assert.Equal(t, time.Second, policy.backoff(10))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 20; i++ {
+		d := policy.backoff(1)
+		assert.True(t, d >= 50*time.Millisecond && d <= 150*time.Millisecond)
+	}
+}
@@ -0,0 +1,60 @@
+package amap
+
+import (
+	"net/url"
+	"strings"
+)
+
+// 服务族名称（用于按服务配置限流、配额等，与高德控制台的服务划分一致）
+const (
+	ServiceGeocode   = "geocode"   // 地理编码/逆地理编码
+	ServiceDirection = "direction" // 路径规划（含未来驾车）
+	ServiceDistance  = "distance"  // 距离测量
+	ServicePlace     = "place"     // POI 搜索
+	ServiceInputtips = "inputtips" // 输入提示
+	ServiceDistrict  = "district"  // 行政区查询
+	ServiceWeather   = "weather"   // 天气查询
+	ServiceTraffic   = "traffic"   // 交通态势/交通事件
+	ServiceBus       = "bus"       // 公交站点/线路查询
+	ServiceIP        = "ip"        // IP 定位
+	ServiceConvert   = "convert"   // 坐标转换
+	ServiceGraspRoad = "grasproad" // 轨迹纠偏
+	ServicePosition  = "position"  // 硬件定位
+	ServiceDefault   = "*"         // 未单独配置的服务使用的默认项
+)
+
+// serviceRules 路径片段 → 服务族（按顺序匹配，更具体的规则在前）
+var serviceRules = []struct {
+	fragment string
+	service  string
+}{
+	{"/direction/distance", ServiceDistance},
+	{"/direction/", ServiceDirection},
+	{"/etd/", ServiceDirection},
+	{"/geocode/", ServiceGeocode},
+	{"/place/", ServicePlace},
+	{"/assistant/inputtips", ServiceInputtips},
+	{"/config/district", ServiceDistrict},
+	{"/weather/", ServiceWeather},
+	{"/traffic/", ServiceTraffic},
+	{"/bus/", ServiceBus},
+	{"/convert", ServiceConvert},
+	{"/grasproad", ServiceGraspRoad},
+	{"/position/", ServicePosition},
+}
+
+// ServiceOf 根据请求路径（完整 URL 或相对路径）识别所属服务族，无法识别时返回空字符串
+func ServiceOf(path string) string {
+	if parsed, err := url.Parse(path); err == nil && parsed.Path != "" {
+		path = parsed.Path
+	}
+	for _, rule := range serviceRules {
+		if strings.Contains(path, rule.fragment) {
+			return rule.service
+		}
+	}
+	if strings.HasSuffix(path, "/ip") {
+		return ServiceIP
+	}
+	return ""
+}
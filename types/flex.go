@@ -0,0 +1,170 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	amapErr "github.com/enneket/amap/errors"
+)
+
+// 高德接口的返回值类型并不稳定：空字符串字段常返回 []，数字字段有时是字符串、有时是数字。
+// 以下类型兼容这些写法，响应结构体使用它们避免 json.Unmarshal 在个别响应上失败。
+
+// FlexString 兼容字符串、数字、布尔、null 和数组的字符串字段（null 和 [] 解析为空字符串，非空数组以 ";" 拼接）
+type FlexString string
+
+// UnmarshalJSON 兼容高德的多种写法
+func (s *FlexString) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		*s = ""
+		return nil
+	}
+	switch data[0] {
+	case '"':
+		var v string
+		if err := json.Unmarshal(data, &v); err != nil {
+			return amapErr.NewParseError("字符串字段格式错误：" + string(data))
+		}
+		*s = FlexString(v)
+	case '[':
+		var items []FlexString
+		if err := json.Unmarshal(data, &items); err != nil {
+			return amapErr.NewParseError("字符串字段格式错误：" + string(data))
+		}
+		parts := make([]string, 0, len(items))
+		for _, item := range items {
+			if item != "" {
+				parts = append(parts, string(item))
+			}
+		}
+		*s = FlexString(strings.Join(parts, ";"))
+	case '{':
+		if string(data) != "{}" {
+			return amapErr.NewParseError("字符串字段不能为对象：" + string(data))
+		}
+		*s = ""
+	case 'n':
+		*s = ""
+	default: // 数字、布尔
+		*s = FlexString(data)
+	}
+	return nil
+}
+
+// String 返回字符串值
+func (s FlexString) String() string {
+	return string(s)
+}
+
+// Int 解析为整数（空字符串为 0，小数截断取整）
+func (s FlexString) Int() (int, error) {
+	f, err := s.Float64()
+	return int(f), err
+}
+
+// Float64 解析为浮点数（空字符串为 0）
+func (s FlexString) Float64() (float64, error) {
+	v := strings.TrimSpace(string(s))
+	if v == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, amapErr.NewParseError("数字格式错误：" + strconv.Quote(v))
+	}
+	return f, nil
+}
+
+// FlexInt 兼容数字、数字字符串、空字符串、null 和 [] 的整数字段（后三者解析为 0，小数截断取整）
+type FlexInt int
+
+// UnmarshalJSON 兼容高德的多种写法
+func (n *FlexInt) UnmarshalJSON(data []byte) error {
+	var s FlexString
+	if err := s.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	v, err := s.Int()
+	if err != nil {
+		return err
+	}
+	*n = FlexInt(v)
+	return nil
+}
+
+// Int 返回整数值
+func (n FlexInt) Int() int {
+	return int(n)
+}
+
+// FlexFloat 兼容数字、数字字符串、空字符串、null 和 [] 的浮点数字段（后三者解析为 0）
+type FlexFloat float64
+
+// UnmarshalJSON 兼容高德的多种写法
+func (f *FlexFloat) UnmarshalJSON(data []byte) error {
+	var s FlexString
+	if err := s.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	v, err := s.Float64()
+	if err != nil {
+		return err
+	}
+	*f = FlexFloat(v)
+	return nil
+}
+
+// Float64 返回浮点数值
+func (f FlexFloat) Float64() float64 {
+	return float64(f)
+}
+
+// FlexStringList 兼容数组、字符串、null 的字符串列表字段（空字符串、null 和 [] 解析为 nil，非空字符串解析为单个元素）
+type FlexStringList []string
+
+// UnmarshalJSON 兼容高德的多种写法
+func (l *FlexStringList) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '[' {
+		var s FlexString
+		if err := s.UnmarshalJSON(data); err != nil {
+			return err
+		}
+		*l = nil
+		if s != "" {
+			*l = FlexStringList{string(s)}
+		}
+		return nil
+	}
+	var items []FlexString
+	if err := json.Unmarshal(data, &items); err != nil {
+		return amapErr.NewParseError("字符串列表字段格式错误：" + string(data))
+	}
+	list := make(FlexStringList, 0, len(items))
+	for _, item := range items {
+		if item != "" {
+			list = append(list, string(item))
+		}
+	}
+	if len(list) == 0 {
+		list = nil
+	}
+	*l = list
+	return nil
+}
+
+// First 第一个元素（列表为空时为空字符串）
+func (l FlexStringList) First() string {
+	if len(l) == 0 {
+		return ""
+	}
+	return l[0]
+}
+
+// String 以 "," 拼接各元素
+func (l FlexStringList) String() string {
+	return strings.Join(l, ",")
+}
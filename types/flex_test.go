@@ -0,0 +1,48 @@
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// 测试 Flex 类型兼容高德的 []、数字、字符串等多种写法
+func TestFlexJSON(t *testing.T) {
+	var item struct {
+		Name     FlexString     `json:"name"`
+		Tel      FlexString     `json:"tel"`
+		Empty    FlexString     `json:"empty"`
+		Number   FlexString     `json:"number"`
+		Count    FlexInt        `json:"count"`
+		Blank    FlexInt        `json:"blank"`
+		Rating   FlexFloat      `json:"rating"`
+		Cost     FlexFloat      `json:"cost"`
+		City     FlexStringList `json:"city"`
+		Province FlexStringList `json:"province"`
+		None     FlexStringList `json:"none"`
+	}
+	data := `{"name":"测试","tel":["010-1",[],"010-2"],"empty":[],"number":120,"count":"25","blank":"",
+		"rating":"4.5","cost":[],"city":[],"province":"北京市","none":null}`
+	if err := json.Unmarshal([]byte(data), &item); err != nil {
+		t.Fatal(err)
+	}
+	if item.Name != "测试" || item.Tel != "010-1;010-2" || item.Empty != "" || item.Number != "120" {
+		t.Errorf("字符串解析结果错误：%+v", item)
+	}
+	if item.Count.Int() != 25 || item.Blank != 0 || item.Rating.Float64() != 4.5 || item.Cost != 0 {
+		t.Errorf("数字解析结果错误：%+v", item)
+	}
+	if item.City != nil || !reflect.DeepEqual(item.Province, FlexStringList{"北京市"}) || item.None != nil {
+		t.Errorf("列表解析结果错误：%+v", item)
+	}
+	if n, err := item.Number.Int(); err != nil || n != 120 {
+		t.Errorf("Int() 结果错误：%d, %v", n, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"count":"abc"}`), &item); err == nil {
+		t.Error("非数字应解析失败")
+	}
+	if err := json.Unmarshal([]byte(`{"name":{"a":1}}`), &item); err == nil {
+		t.Error("对象应解析失败")
+	}
+}
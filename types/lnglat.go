@@ -0,0 +1,273 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	amapErr "github.com/enneket/amap/errors"
+)
+
+// LngLat 经纬度坐标（高德字符串格式为 "经度,纬度"，小数点后最多 6 位）
+type LngLat struct {
+	Lng float64 // 经度（-180~180）
+	Lat float64 // 纬度（-90~90）
+}
+
+// ParseLngLat 解析 "经度,纬度" 格式的坐标，并校验经纬度范围
+func ParseLngLat(s string) (LngLat, error) {
+	lngStr, latStr, ok := strings.Cut(strings.TrimSpace(s), ",")
+	if !ok {
+		return LngLat{}, amapErr.NewInvalidConfigError(fmt.Sprintf("坐标格式错误，应为\"经度,纬度\"：%q", s))
+	}
+	lng, err1 := strconv.ParseFloat(strings.TrimSpace(lngStr), 64)
+	lat, err2 := strconv.ParseFloat(strings.TrimSpace(latStr), 64)
+	if err1 != nil || err2 != nil {
+		return LngLat{}, amapErr.NewInvalidConfigError(fmt.Sprintf("坐标格式错误，经纬度应为数字：%q", s))
+	}
+	p := LngLat{Lng: lng, Lat: lat}
+	if err := p.Validate(); err != nil {
+		return LngLat{}, err
+	}
+	return p, nil
+}
+
+// Validate 校验经纬度范围
+func (p LngLat) Validate() error {
+	if math.IsNaN(p.Lng) || p.Lng < -180 || p.Lng > 180 {
+		return amapErr.NewInvalidConfigError(fmt.Sprintf("经度超出范围（-180~180）：%v", p.Lng))
+	}
+	if math.IsNaN(p.Lat) || p.Lat < -90 || p.Lat > 90 {
+		return amapErr.NewInvalidConfigError(fmt.Sprintf("纬度超出范围（-90~90）：%v", p.Lat))
+	}
+	return nil
+}
+
+// IsZero 是否为零值（高德未返回坐标时解析为零值）
+func (p LngLat) IsZero() bool {
+	return p.Lng == 0 && p.Lat == 0
+}
+
+// String 格式化为 "经度,纬度"（四舍五入到小数点后 6 位，去除末尾的 0）
+func (p LngLat) String() string {
+	return formatCoord(p.Lng) + "," + formatCoord(p.Lat)
+}
+
+// MarshalJSON 序列化为高德字符串格式
+func (p LngLat) MarshalJSON() ([]byte, error) {
+	if p.IsZero() {
+		return []byte(`""`), nil
+	}
+	return json.Marshal(p.String())
+}
+
+// UnmarshalJSON 从高德字符串格式解析（空字符串、[] 和 null 解析为零值）
+func (p *LngLat) UnmarshalJSON(data []byte) error {
+	s, ok, err := unmarshalCoordString(data)
+	if err != nil || !ok {
+		*p = LngLat{}
+		return err
+	}
+	parsed, err := ParseLngLat(s)
+	if err != nil {
+		return amapErr.NewParseError(err.Error())
+	}
+	*p = parsed
+	return nil
+}
+
+// LngLatList 坐标串（高德格式为 "经度,纬度;经度,纬度"，如路线 polyline）
+type LngLatList []LngLat
+
+// ParseLngLatList 解析以 sep 分隔的坐标串（sep 为 ";" 或 "|"，空字符串返回空列表）
+func ParseLngLatList(s, sep string) (LngLatList, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	parts := strings.Split(s, sep)
+	list := make(LngLatList, 0, len(parts))
+	for _, part := range parts {
+		if part == "" {
+			continue
+		}
+		p, err := ParseLngLat(part)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, p)
+	}
+	return list, nil
+}
+
+// IsLngLat 校验 "经度,纬度" 格式及经纬度范围
+func IsLngLat(s string) bool {
+	_, err := ParseLngLat(s)
+	return err == nil
+}
+
+// IsLngLatList 校验以 sep 分隔的坐标列表（至少一个坐标）
+func IsLngLatList(s, sep string) bool {
+	list, err := ParseLngLatList(s, sep)
+	return err == nil && len(list) > 0
+}
+
+// Join 以 sep 连接为高德坐标串
+func (l LngLatList) Join(sep string) string {
+	parts := make([]string, len(l))
+	for i, p := range l {
+		parts[i] = p.String()
+	}
+	return strings.Join(parts, sep)
+}
+
+// String 格式化为 "经度,纬度;经度,纬度"
+func (l LngLatList) String() string {
+	return l.Join(";")
+}
+
+// MarshalJSON 序列化为高德坐标串
+func (l LngLatList) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.String())
+}
+
+// UnmarshalJSON 从高德坐标串解析（空字符串、[] 和 null 解析为空列表）
+func (l *LngLatList) UnmarshalJSON(data []byte) error {
+	s, ok, err := unmarshalCoordString(data)
+	if err != nil || !ok {
+		*l = nil
+		return err
+	}
+	parsed, err := ParseLngLatList(s, ";")
+	if err != nil {
+		return amapErr.NewParseError(err.Error())
+	}
+	*l = parsed
+	return nil
+}
+
+// Bounds 矩形范围（高德格式为 "左下经度,左下纬度;右上经度,右上纬度"）
+type Bounds struct {
+	SouthWest LngLat // 左下角
+	NorthEast LngLat // 右上角
+}
+
+// ParseBounds 解析 "左下经度,左下纬度;右上经度,右上纬度" 格式的矩形
+func ParseBounds(s string) (Bounds, error) {
+	list, err := ParseLngLatList(s, ";")
+	if err != nil {
+		return Bounds{}, err
+	}
+	if len(list) != 2 {
+		return Bounds{}, amapErr.NewInvalidConfigError(fmt.Sprintf("矩形格式错误，应为\"左下经度,左下纬度;右上经度,右上纬度\"：%q", s))
+	}
+	b := Bounds{SouthWest: list[0], NorthEast: list[1]}
+	if err := b.Validate(); err != nil {
+		return Bounds{}, err
+	}
+	return b, nil
+}
+
+// BoundsOf 计算包含所有坐标的最小矩形（空列表返回零值）
+func BoundsOf(points []LngLat) Bounds {
+	if len(points) == 0 {
+		return Bounds{}
+	}
+	b := Bounds{SouthWest: points[0], NorthEast: points[0]}
+	for _, p := range points[1:] {
+		b = b.Extend(p)
+	}
+	return b
+}
+
+// Validate 校验两个角的坐标范围，且左下角不大于右上角
+func (b Bounds) Validate() error {
+	if err := b.SouthWest.Validate(); err != nil {
+		return err
+	}
+	if err := b.NorthEast.Validate(); err != nil {
+		return err
+	}
+	if b.SouthWest.Lng > b.NorthEast.Lng || b.SouthWest.Lat > b.NorthEast.Lat {
+		return amapErr.NewInvalidConfigError(fmt.Sprintf("矩形左下角应小于右上角：%s", b))
+	}
+	return nil
+}
+
+// IsZero 是否为零值（两个角均未设置）
+func (b Bounds) IsZero() bool {
+	return b.SouthWest.IsZero() && b.NorthEast.IsZero()
+}
+
+// Contains 坐标是否在矩形内（含边界）
+func (b Bounds) Contains(p LngLat) bool {
+	return p.Lng >= b.SouthWest.Lng && p.Lng <= b.NorthEast.Lng &&
+		p.Lat >= b.SouthWest.Lat && p.Lat <= b.NorthEast.Lat
+}
+
+// Extend 返回扩展到包含 p 的矩形
+func (b Bounds) Extend(p LngLat) Bounds {
+	b.SouthWest.Lng = math.Min(b.SouthWest.Lng, p.Lng)
+	b.SouthWest.Lat = math.Min(b.SouthWest.Lat, p.Lat)
+	b.NorthEast.Lng = math.Max(b.NorthEast.Lng, p.Lng)
+	b.NorthEast.Lat = math.Max(b.NorthEast.Lat, p.Lat)
+	return b
+}
+
+// Center 矩形中心点
+func (b Bounds) Center() LngLat {
+	return LngLat{Lng: (b.SouthWest.Lng + b.NorthEast.Lng) / 2, Lat: (b.SouthWest.Lat + b.NorthEast.Lat) / 2}
+}
+
+// Join 以 sep 连接两个角的坐标（部分接口的矩形参数以 "," 连接）
+func (b Bounds) Join(sep string) string {
+	return b.SouthWest.String() + sep + b.NorthEast.String()
+}
+
+// String 格式化为 "左下经度,左下纬度;右上经度,右上纬度"
+func (b Bounds) String() string {
+	return b.Join(";")
+}
+
+// MarshalJSON 序列化为高德矩形格式（零值序列化为空字符串，与 LngLat 一致）
+func (b Bounds) MarshalJSON() ([]byte, error) {
+	if b.IsZero() {
+		return []byte(`""`), nil
+	}
+	return json.Marshal(b.String())
+}
+
+// UnmarshalJSON 从高德矩形格式解析（空字符串、[] 和 null 解析为零值）
+func (b *Bounds) UnmarshalJSON(data []byte) error {
+	s, ok, err := unmarshalCoordString(data)
+	if err != nil || !ok {
+		*b = Bounds{}
+		return err
+	}
+	parsed, err := ParseBounds(s)
+	if err != nil {
+		return amapErr.NewParseError(err.Error())
+	}
+	*b = parsed
+	return nil
+}
+
+// formatCoord 四舍五入到小数点后 6 位并去除末尾的 0
+func formatCoord(v float64) string {
+	return strconv.FormatFloat(math.Round(v*1e6)/1e6, 'f', -1, 64)
+}
+
+// unmarshalCoordString 解析 JSON 字符串，高德无值时返回的 []、null 和空字符串视为缺失（ok=false）
+func unmarshalCoordString(data []byte) (string, bool, error) {
+	switch strings.TrimSpace(string(data)) {
+	case "null", "[]", `""`:
+		return "", false, nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return "", false, amapErr.NewParseError("坐标应为字符串：" + string(data))
+	}
+	return s, true, nil
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// 测试坐标解析、范围校验和6位小数格式化
+func TestParseLngLat(t *testing.T) {
+	p, err := ParseLngLat(" 116.4812345678, 39.9 ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := p.String(); got != "116.481235,39.9" {
+		t.Errorf("格式化结果错误：%s", got)
+	}
+
+	for _, s := range []string{"", "116.48", "a,b", "181,39", "116,-91", "NaN,39"} {
+		if _, err := ParseLngLat(s); err == nil {
+			t.Errorf("坐标 %q 应解析失败", s)
+		}
+	}
+}
+
+// 测试坐标JSON序列化，兼容高德的 [] 空值
+func TestLngLatJSON(t *testing.T) {
+	var item struct {
+		Location LngLat     `json:"location"`
+		Empty    LngLat     `json:"empty"`
+		Polyline LngLatList `json:"polyline"`
+		Bounds   Bounds     `json:"bounds"`
+	}
+	data := `{"location":"116.397428,39.90923","empty":[],"polyline":"116.1,39.1;116.2,39.2","bounds":"116.1,39.1;116.2,39.2"}`
+	if err := json.Unmarshal([]byte(data), &item); err != nil {
+		t.Fatal(err)
+	}
+	if item.Location != (LngLat{116.397428, 39.90923}) || !item.Empty.IsZero() || len(item.Polyline) != 2 {
+		t.Errorf("解析结果错误：%+v", item)
+	}
+	out, err := json.Marshal(item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"location":"116.397428,39.90923","empty":"","polyline":"116.1,39.1;116.2,39.2","bounds":"116.1,39.1;116.2,39.2"}`
+	if string(out) != expected {
+		t.Errorf("序列化结果错误：%s", out)
+	}
+
+	// 零值的坐标和矩形均序列化为空字符串
+	zero, err := json.Marshal(struct {
+		Location LngLat `json:"location"`
+		Bounds   Bounds `json:"bounds"`
+	}{})
+	if err != nil || string(zero) != `{"location":"","bounds":""}` {
+		t.Errorf("零值序列化结果错误：%s %v", zero, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"location":"999,1"}`), &item); err == nil {
+		t.Error("超出范围的坐标应解析失败")
+	}
+}
+
+// 测试坐标串和矩形
+func TestLngLatListAndBounds(t *testing.T) {
+	list, err := ParseLngLatList("116.1,39.1|116.3,39.0|116.2,39.4", "|")
+	if err != nil || len(list) != 3 {
+		t.Fatalf("解析坐标串失败：%v %v", list, err)
+	}
+	if list.Join("|") != "116.1,39.1|116.3,39|116.2,39.4" {
+		t.Errorf("连接结果错误：%s", list.Join("|"))
+	}
+
+	b := BoundsOf(list)
+	if b.String() != "116.1,39;116.3,39.4" {
+		t.Errorf("外包矩形错误：%s", b)
+	}
+	if !b.Contains(LngLat{116.2, 39.2}) || b.Contains(LngLat{116.4, 39.2}) {
+		t.Error("矩形包含判断错误")
+	}
+	if c := b.Center(); c.String() != "116.2,39.2" {
+		t.Errorf("中心点错误：%s", c)
+	}
+	if _, err := ParseBounds("116.3,39.4;116.1,39.0"); err == nil {
+		t.Error("左下角大于右上角应解析失败")
+	}
+}
+
+// 测试坐标与坐标列表的格式校验
+func TestIsLngLat(t *testing.T) {
+	if !IsLngLat("116.481028,39.989643") || IsLngLat("116.481028") || IsLngLat("") {
+		t.Error("IsLngLat 结果错误")
+	}
+	if !IsLngLatList("116.1,39.1|116.2,39.2", "|") || IsLngLatList("", "|") || IsLngLatList("116.1,39.1|abc", "|") {
+		t.Error("IsLngLatList 结果错误")
+	}
+}
@@ -97,3 +97,70 @@ func GCJ02ToWGS84(gcj Coordinate) Coordinate {
 
 	return wgs
 }
+
+// GCJ02ToBD09 GCJ02（火星坐标系/高德坐标系）转BD09（百度坐标系）
+func GCJ02ToBD09(gcj Coordinate) Coordinate {
+	z := math.Sqrt(gcj.Lng*gcj.Lng+gcj.Lat*gcj.Lat) + 0.00002*math.Sin(gcj.Lat*xPi)
+	theta := math.Atan2(gcj.Lat, gcj.Lng) + 0.000003*math.Cos(gcj.Lng*xPi)
+	return Coordinate{
+		Lng: z*math.Cos(theta) + 0.0065,
+		Lat: z*math.Sin(theta) + 0.006,
+	}
+}
+
+// BD09ToGCJ02 BD09（百度坐标系）转GCJ02（火星坐标系/高德坐标系）
+// 使用近似反算公式（误差约1米），需要更高精度时使用 BD09ToGCJ02Exact
+func BD09ToGCJ02(bd Coordinate) Coordinate {
+	x, y := bd.Lng-0.0065, bd.Lat-0.006
+	z := math.Sqrt(x*x+y*y) - 0.00002*math.Sin(y*xPi)
+	theta := math.Atan2(y, x) - 0.000003*math.Cos(x*xPi)
+	return Coordinate{Lng: z * math.Cos(theta), Lat: z * math.Sin(theta)}
+}
+
+// WGS84ToBD09 WGS84坐标系转BD09（百度坐标系）
+func WGS84ToBD09(wgs Coordinate) Coordinate {
+	return GCJ02ToBD09(WGS84ToGCJ02(wgs))
+}
+
+// BD09ToWGS84 BD09（百度坐标系）转WGS84
+func BD09ToWGS84(bd Coordinate) Coordinate {
+	return GCJ02ToWGS84(BD09ToGCJ02(bd))
+}
+
+// ExactTolerance 精确反算的收敛阈值（度，约0.01毫米）
+const ExactTolerance = 1e-10
+
+// exactMaxIterations 精确反算的最大迭代次数（偏移函数变化平缓，通常5次以内收敛）
+const exactMaxIterations = 30
+
+// GCJ02ToWGS84Exact GCJ02转WGS84，迭代反算至正算结果与输入的误差小于 ExactTolerance
+func GCJ02ToWGS84Exact(gcj Coordinate) Coordinate {
+	if outOfChina(gcj) {
+		return gcj
+	}
+	return invert(WGS84ToGCJ02, gcj, GCJ02ToWGS84(gcj))
+}
+
+// BD09ToGCJ02Exact BD09转GCJ02，迭代反算至正算结果与输入的误差小于 ExactTolerance
+func BD09ToGCJ02Exact(bd Coordinate) Coordinate {
+	return invert(GCJ02ToBD09, bd, BD09ToGCJ02(bd))
+}
+
+// BD09ToWGS84Exact BD09转WGS84（两步均使用精确反算）
+func BD09ToWGS84Exact(bd Coordinate) Coordinate {
+	return GCJ02ToWGS84Exact(BD09ToGCJ02Exact(bd))
+}
+
+// invert 不动点迭代求 forward 的反函数：每次按正算结果与目标的差值修正，直到误差小于 ExactTolerance
+func invert(forward func(Coordinate) Coordinate, target, guess Coordinate) Coordinate {
+	for i := 0; i < exactMaxIterations; i++ {
+		got := forward(guess)
+		dLng, dLat := got.Lng-target.Lng, got.Lat-target.Lat
+		if math.Abs(dLng) < ExactTolerance && math.Abs(dLat) < ExactTolerance {
+			break
+		}
+		guess.Lng -= dLng
+		guess.Lat -= dLat
+	}
+	return guess
+}
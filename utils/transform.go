@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"fmt"
+
+	amapErr "github.com/enneket/amap/errors"
+	amapType "github.com/enneket/amap/types"
+)
+
+// Transform 在 WGS84、GCJ02、BD09 坐标系之间转换（空坐标系视为 GCJ02，与高德接口默认值一致）
+// 以 GCJ02 为中转，反算方向使用精确迭代（GCJ02ToWGS84Exact、BD09ToGCJ02Exact）
+func Transform(c Coordinate, from, to amapType.CoordinateType) (Coordinate, error) {
+	toGCJ, err := toGCJ02Func(from)
+	if err != nil {
+		return Coordinate{}, err
+	}
+	fromGCJ, err := fromGCJ02Func(to)
+	if err != nil {
+		return Coordinate{}, err
+	}
+	if normalizeCoordinateType(from) == normalizeCoordinateType(to) {
+		return c, nil
+	}
+	return fromGCJ(toGCJ(c)), nil
+}
+
+// TransformAll 批量转换坐标，返回新的切片（不修改输入）
+func TransformAll(coords []Coordinate, from, to amapType.CoordinateType) ([]Coordinate, error) {
+	toGCJ, err := toGCJ02Func(from)
+	if err != nil {
+		return nil, err
+	}
+	fromGCJ, err := fromGCJ02Func(to)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]Coordinate, len(coords))
+	if normalizeCoordinateType(from) == normalizeCoordinateType(to) {
+		copy(result, coords)
+		return result, nil
+	}
+	for i, c := range coords {
+		result[i] = fromGCJ(toGCJ(c))
+	}
+	return result, nil
+}
+
+// normalizeCoordinateType 空坐标系视为 GCJ02
+func normalizeCoordinateType(t amapType.CoordinateType) amapType.CoordinateType {
+	if t == "" {
+		return amapType.CoordinateType_GCJ02
+	}
+	return t
+}
+
+// toGCJ02Func 转换到 GCJ02 的函数
+func toGCJ02Func(from amapType.CoordinateType) (func(Coordinate) Coordinate, error) {
+	switch normalizeCoordinateType(from) {
+	case amapType.CoordinateType_GCJ02:
+		return identity, nil
+	case amapType.CoordinateType_WGS84:
+		return WGS84ToGCJ02, nil
+	case amapType.CoordinateType_BD09LL:
+		return BD09ToGCJ02Exact, nil
+	}
+	return nil, unsupportedCoordinateType(from)
+}
+
+// fromGCJ02Func 从 GCJ02 转换的函数
+func fromGCJ02Func(to amapType.CoordinateType) (func(Coordinate) Coordinate, error) {
+	switch normalizeCoordinateType(to) {
+	case amapType.CoordinateType_GCJ02:
+		return identity, nil
+	case amapType.CoordinateType_WGS84:
+		return GCJ02ToWGS84Exact, nil
+	case amapType.CoordinateType_BD09LL:
+		return GCJ02ToBD09, nil
+	}
+	return nil, unsupportedCoordinateType(to)
+}
+
+// identity 不转换
+func identity(c Coordinate) Coordinate {
+	return c
+}
+
+// unsupportedCoordinateType 不支持的坐标系错误
+func unsupportedCoordinateType(t amapType.CoordinateType) error {
+	return amapErr.NewInvalidConfigError(fmt.Sprintf("不支持的坐标系：%q（支持 wgs84、gcj02、bd09ll）", t))
+}
@@ -3,6 +3,8 @@ package utils
 import (
 	"math"
 	"testing"
+
+	amapType "github.com/enneket/amap/types"
 )
 
 // 测试签名方法（可替换为自己的测试用例）
@@ -50,3 +52,64 @@ func TestEncodeParams(t *testing.T) {
 		t.Errorf("参数编码错误，期望：%s，实际：%s", expected, encoded)
 	}
 }
+
+// 测试百度坐标系转换和精确反算
+func TestBD09Transform(t *testing.T) {
+	// 天安门 GCJ02 坐标对应的 BD09 坐标
+	gcj := Coordinate{Lng: 116.397428, Lat: 39.90923}
+	bd := GCJ02ToBD09(gcj)
+	if math.Abs(bd.Lng-116.403801) > 0.000001 || math.Abs(bd.Lat-39.915573) > 0.000001 {
+		t.Errorf("GCJ02转BD09错误：%+v", bd)
+	}
+	if back := BD09ToGCJ02(bd); math.Abs(back.Lng-gcj.Lng) > 0.00002 || math.Abs(back.Lat-gcj.Lat) > 0.00002 {
+		t.Errorf("BD09转GCJ02误差过大：%+v", back)
+	}
+
+	// 精确反算：再次正算应与输入一致
+	for _, exact := range []struct {
+		name    string
+		forward func(Coordinate) Coordinate
+		inverse func(Coordinate) Coordinate
+	}{
+		{"GCJ02ToWGS84Exact", WGS84ToGCJ02, GCJ02ToWGS84Exact},
+		{"BD09ToGCJ02Exact", GCJ02ToBD09, BD09ToGCJ02Exact},
+		{"BD09ToWGS84Exact", WGS84ToBD09, BD09ToWGS84Exact},
+	} {
+		got := exact.forward(exact.inverse(gcj))
+		if math.Abs(got.Lng-gcj.Lng) > 1e-9 || math.Abs(got.Lat-gcj.Lat) > 1e-9 {
+			t.Errorf("%s 精度不足：%+v", exact.name, got)
+		}
+	}
+}
+
+// 测试坐标系转换矩阵
+func TestTransform(t *testing.T) {
+	wgs := Coordinate{Lng: 116.39748, Lat: 39.908823}
+	systems := []amapType.CoordinateType{amapType.CoordinateType_WGS84, amapType.CoordinateType_GCJ02, amapType.CoordinateType_BD09LL}
+	for _, from := range systems {
+		src, _ := Transform(wgs, amapType.CoordinateType_WGS84, from)
+		for _, to := range systems {
+			dst, err := Transform(src, from, to)
+			if err != nil {
+				t.Fatal(err)
+			}
+			back, _ := Transform(dst, to, from)
+			if math.Abs(back.Lng-src.Lng) > 1e-8 || math.Abs(back.Lat-src.Lat) > 1e-8 {
+				t.Errorf("%s -> %s 往返误差过大：%+v %+v", from, to, src, back)
+			}
+		}
+	}
+
+	if got, _ := Transform(wgs, amapType.CoordinateType_WGS84, amapType.CoordinateType_GCJ02); got != WGS84ToGCJ02(wgs) {
+		t.Errorf("WGS84转GCJ02结果错误：%+v", got)
+	}
+	if _, err := Transform(wgs, "mapbar", amapType.CoordinateType_GCJ02); err == nil {
+		t.Error("不支持的坐标系应返回错误")
+	}
+
+	coords := []Coordinate{wgs, {Lng: 2.35, Lat: 48.85}}
+	result, err := TransformAll(coords, amapType.CoordinateType_WGS84, "")
+	if err != nil || len(result) != 2 || result[0] != WGS84ToGCJ02(wgs) || result[1] != coords[1] {
+		t.Errorf("批量转换结果错误：%+v %v", result, err)
+	}
+}